@@ -24,12 +24,12 @@ type blockData struct {
 	TxIDs      TxIDs   `cbor:"3,keyasint"`
 }
 
-func MakeBlock(timestamp time.Time, txIDs TxIDs,
+func MakeBlock(version uint, timestamp time.Time, txIDs TxIDs,
 	lastBlockHash, CommittersHash, stateHash, lastReceiptsHash crypto.Hash,
 	lastCommit *Commit, proposer crypto.Address) Block {
 
 	txIDsHash := txIDs.Hash()
-	header := NewHeader(1, timestamp,
+	header := NewHeader(version, timestamp,
 		txIDsHash, lastBlockHash, CommittersHash, stateHash, lastReceiptsHash, lastCommit.Hash(), proposer)
 
 	b := Block{
@@ -155,7 +155,7 @@ func GenerateTestBlock(proposer *crypto.Address) (*Block, []*tx.Tx) {
 	lastBlockHash := crypto.GenerateTestHash()
 	commit := GenerateTestCommit(lastBlockHash)
 
-	block := MakeBlock(time.Now(), ids,
+	block := MakeBlock(1, time.Now(), ids,
 		lastBlockHash,
 		crypto.GenerateTestHash(),
 		crypto.GenerateTestHash(),
@@ -39,6 +39,17 @@ func (txs TxIDs) Hash() crypto.Hash {
 	return merkle.Root()
 }
 
+// Proof returns the inclusion proof of the transaction against the transactions hash,
+// or nil if the transaction is not in the list
+func (txs TxIDs) Proof(id crypto.Hash) *simpleMerkle.Proof {
+	for i, h := range txs.data.IDs {
+		if h.EqualsTo(id) {
+			return simpleMerkle.NewTreeFromHashes(txs.data.IDs).Proof(i)
+		}
+	}
+	return nil
+}
+
 func (txs TxIDs) IDs() []crypto.Hash {
 	return txs.data.IDs
 }
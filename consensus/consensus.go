@@ -7,9 +7,11 @@ import (
 	"github.com/sasha-s/go-deadlock"
 	"github.com/zarbchain/zarb-go/consensus/hrs"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/message"
 	"github.com/zarbchain/zarb-go/state"
+	"github.com/zarbchain/zarb-go/txpool"
 	"github.com/zarbchain/zarb-go/util"
 	"github.com/zarbchain/zarb-go/validator"
 	"github.com/zarbchain/zarb-go/vote"
@@ -25,6 +27,8 @@ type consensus struct {
 	signer      crypto.Signer
 	isCommitted bool
 	state       state.State
+	txPool      txpool.TxPool
+	evidences   map[crypto.Address]evidence
 	broadcastCh chan *message.Message
 	logger      *logger.Logger
 }
@@ -32,11 +36,14 @@ type consensus struct {
 func NewConsensus(
 	conf *Config,
 	state state.State,
+	txPool txpool.TxPool,
 	signer crypto.Signer,
 	broadcastCh chan *message.Message) (Consensus, error) {
 	cs := &consensus{
 		config:      conf,
 		state:       state,
+		txPool:      txPool,
+		evidences:   make(map[crypto.Address]evidence),
 		valset:      state.ValidatorSet(),
 		broadcastCh: broadcastCh,
 		signer:      signer,
@@ -205,6 +212,8 @@ func (cs *consensus) addVote(v *vote.Vote) error {
 	if err != nil {
 		if v.Signer().EqualsTo(cs.signer.Address()) {
 			cs.logger.Error("Detecting a duplicated vote from ourself. Did you restart the node?")
+		} else if errors.Code(err) == errors.ErrDuplicateVote {
+			cs.saveEvidence(v)
 		}
 
 		return err
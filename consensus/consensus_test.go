@@ -14,6 +14,8 @@ import (
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/message"
 	"github.com/zarbchain/zarb-go/state"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	"github.com/zarbchain/zarb-go/txpool"
 	"github.com/zarbchain/zarb-go/validator"
 	"github.com/zarbchain/zarb-go/vote"
@@ -67,7 +69,7 @@ func newTestConsensus(t *testing.T, valID int) *consensus {
 	st, _ := state.LoadOrNewState(stateConf, genDoc, signers[valID], mockTxPool)
 
 	// TODO: fix me
-	cons1, _ := NewConsensus(consConf, st, signers[valID], ch)
+	cons1, _ := NewConsensus(consConf, st, mockTxPool, signers[valID], ch)
 	cons := cons1.(*consensus)
 	assert.Equal(t, cons.votes.height, 0)
 	assert.Equal(t, hrs.NewHRS(0, 0, hrs.StepTypeNewHeight), cons.hrs)
@@ -302,3 +304,112 @@ func TestConsensusInvalidProposal(t *testing.T) {
 	assert.Nil(t, cons.LastProposal())
 
 }
+
+func TestConsensusDoubleSign(t *testing.T) {
+	cons := newTestConsensus(t, VAL1)
+
+	cons.enterNewHeight(1)
+	p := cons.LastProposal()
+	require.NotNil(t, p)
+
+	v1 := testAddVote(t, cons, vote.VoteTypePrevote, 1, 0, p.Block().Hash(), VAL2, false)
+	v2 := testAddVote(t, cons, vote.VoteTypePrevote, 1, 0, crypto.GenerateTestHash(), VAL2, true)
+
+	e, ok := cons.evidences[signers[VAL2].Address()]
+	require.True(t, ok)
+	assert.Equal(t, e.vote1.Hash(), v1.Hash())
+	assert.Equal(t, e.vote2.Hash(), v2.Hash())
+
+	cons.reportEvidences()
+	assert.Empty(t, cons.evidences)
+
+	trx := mockTxPool.Txs[len(mockTxPool.Txs)-1]
+	assert.Equal(t, trx.PayloadType(), payload.PayloadTypeEvidence)
+	assert.Equal(t, trx.Payload().Signer(), signers[VAL1].Address())
+}
+
+func TestReportEvidencesWithPendingTx(t *testing.T) {
+	logger.InitLogger(logger.TestConfig())
+
+	// Less than one third of the validators can be slashed at each height
+	valSigners := make([]crypto.Signer, 7)
+	vals := make([]*validator.Validator, len(valSigners))
+	for i := range valSigners {
+		_, _, priv := crypto.GenerateTestKeyPair()
+		valSigners[i] = crypto.NewSigner(priv)
+		vals[i] = validator.NewValidator(valSigners[i].PublicKey(), i, 0)
+	}
+	treasury := account.NewAccount(crypto.TreasuryAddress, 0)
+	treasury.AddToBalance(21000000000000)
+	bonderAddr, bonderPub, bonderPriv := crypto.GenerateTestKeyPair()
+	bonder := account.NewAccount(bonderAddr, 1)
+	bonder.AddToBalance(100000000)
+	genDoc := genesis.MakeGenesis("test", time.Now(), []*account.Account{treasury, bonder}, vals, 1)
+
+	ch := make(chan *message.Message, 10)
+	go func() {
+		for {
+			<-ch
+		}
+	}()
+	pool, _ := txpool.NewTxPool(txpool.TestConfig(), ch)
+	st, err := state.LoadOrNewState(state.TestConfig(), genDoc, valSigners[0], pool)
+	require.NoError(t, err)
+	cons1, _ := NewConsensus(TestConfig(), st, pool, valSigners[0], ch)
+	cons := cons1.(*consensus)
+
+	// Offenders should have stake to be slashed
+	for i := 1; i <= 3; i++ {
+		trx := tx.NewBondTx(st.LastBlockHash(), i, bonderAddr, valSigners[i].PublicKey(), 1000000, "", &bonderPub, nil)
+		trx.SetSignature(bonderPriv.Sign(trx.SignBytes()))
+		require.NoError(t, pool.AppendTx(trx))
+	}
+	b := st.ProposeBlock()
+	committers := make([]block.Committer, len(valSigners))
+	sigs := make([]*crypto.Signature, len(valSigners))
+	for i, s := range valSigners {
+		v := vote.NewPrecommit(1, 0, b.Hash(), s.Address())
+		committers[i] = block.Committer{Status: block.CommitSigned, Address: s.Address()}
+		sigs[i] = s.Sign(v.SignBytes())
+	}
+	require.NoError(t, st.ApplyBlock(1, b, *block.NewCommit(0, committers, crypto.Aggregate(sigs))))
+
+	cons.MoveToNewHeight()
+	cons.enterNewHeight(2)
+	doubleSign := func(s crypto.Signer) {
+		for i := 0; i < 2; i++ {
+			v := vote.NewVote(vote.VoteTypePrevote, 2, 0, crypto.GenerateTestHash(), s.Address())
+			s.SignMsg(v)
+			_ = cons.addVote(v)
+		}
+		require.Contains(t, cons.evidences, s.Address())
+	}
+	evidences := func() []*tx.Tx {
+		trxs := make([]*tx.Tx, 0)
+		for _, trx := range pool.AllTransactions() {
+			if trx.PayloadType() == payload.PayloadTypeEvidence {
+				trxs = append(trxs, trx)
+			}
+		}
+		return trxs
+	}
+
+	// The first evidence is pending in the pool, the second one should use the next sequence
+	doubleSign(valSigners[1])
+	cons.reportEvidences()
+	assert.Empty(t, cons.evidences)
+	doubleSign(valSigners[2])
+	cons.reportEvidences()
+	assert.Empty(t, cons.evidences)
+
+	trxs := evidences()
+	require.Equal(t, len(trxs), 2)
+	assert.Equal(t, trxs[0].Sequence(), 1)
+	assert.Equal(t, trxs[1].Sequence(), 2)
+
+	// The third evidence can't be added to the pool at this height, it is kept to be reported later
+	doubleSign(valSigners[3])
+	cons.reportEvidences()
+	assert.Contains(t, cons.evidences, valSigners[3].Address())
+	assert.Equal(t, len(evidences()), 2)
+}
@@ -0,0 +1,63 @@
+package consensus
+
+import (
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/vote"
+)
+
+// evidence is a pair of conflicting votes, signed by the same validator
+type evidence struct {
+	vote1 *vote.Vote
+	vote2 *vote.Vote
+}
+
+func (cs *consensus) saveEvidence(v *vote.Vote) {
+	conflicting := cs.votes.ConflictingVote(v)
+	if conflicting == nil {
+		return
+	}
+
+	// Keep only one evidence per offender
+	if _, ok := cs.evidences[v.Signer()]; ok {
+		return
+	}
+
+	cs.logger.Warn("Double signing detected", "vote1", conflicting, "vote2", v)
+	cs.evidences[v.Signer()] = evidence{
+		vote1: conflicting,
+		vote2: v,
+	}
+}
+
+// reportEvidences puts the saved evidences into the transaction pool.
+// It is called by the proposer before proposing a block.
+// The sequence is taken from the pool, so it counts our pending transactions.
+// An evidence is kept until it is added to the pool, so it can be reported again later.
+func (cs *consensus) reportEvidences() {
+	if len(cs.evidences) == 0 {
+		return
+	}
+
+	reporter := cs.signer.Address()
+	stamp := cs.state.LastBlockHash()
+	pub := cs.signer.PublicKey()
+	for offender, e := range cs.evidences {
+		bs1, _ := e.vote1.MarshalCBOR()
+		bs2, _ := e.vote2.MarshalCBOR()
+		trx := tx.NewEvidenceTx(stamp, 0, reporter, offender, bs1, bs2, "", &pub, nil)
+		seq := cs.txPool.ExpectedSequence(trx)
+		if seq == 0 {
+			// We are not a validator
+			return
+		}
+
+		trx = tx.NewEvidenceTx(stamp, seq, reporter, offender, bs1, bs2, "", &pub, nil)
+		cs.signer.SignMsg(trx)
+
+		if err := cs.txPool.AppendTxAndBroadcast(trx); err != nil {
+			cs.logger.Error("Our evidence transaction is invalid", "tx", trx, "err", err)
+			continue
+		}
+		delete(cs.evidences, offender)
+	}
+}
@@ -68,6 +68,15 @@ func (hvs *HeightVoteSet) AddVote(vote *vote.Vote) (bool, error) {
 	return added, err
 }
 
+// ConflictingVote returns the previous vote which conflicts with the given vote, if any.
+func (hvs *HeightVoteSet) ConflictingVote(vote *vote.Vote) *vote.Vote {
+	voteSet := hvs.voteSet(vote.Round(), vote.VoteType())
+	if voteSet == nil {
+		return nil
+	}
+	return voteSet.ConflictingVote(vote)
+}
+
 func (hvs *HeightVoteSet) Prevotes(round int) *vote.VoteSet {
 	return hvs.voteSet(round, vote.VoteTypePrevote)
 }
@@ -0,0 +1,185 @@
+package crypto
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// MaximumMultiSigKeys is the maximum number of public keys in a multisig account
+const MaximumMultiSigKeys = 32
+
+// popPrefix separates the proof of possession messages from the other signed messages
+var popPrefix = []byte("zarb-multisig-pop:")
+
+// MultiSig is an M-of-N key set that controls an account.
+// Signatures of the participants are aggregated into one signature.
+//
+// Each public key comes with a proof of possession.
+// Without it, a cosigner can choose a rogue key to cancel out the other keys
+// and produce a valid aggregated signature by himself.
+type MultiSig struct {
+	data multiSigData
+}
+
+type multiSigData struct {
+	Threshold  int         `cbor:"1,keyasint"`
+	PublicKeys []PublicKey `cbor:"2,keyasint"`
+	Proofs     []Signature `cbor:"3,keyasint"`
+}
+
+/// ------------
+/// CONSTRUCTORS
+
+// NewMultiSig creates a key set. The order of public keys matters, it changes the address.
+// The proofs should be created by the owners of the keys, using `ProofOfPossession`.
+func NewMultiSig(threshold int, pubs []PublicKey, proofs []Signature) (*MultiSig, error) {
+	ms := &MultiSig{
+		data: multiSigData{
+			Threshold:  threshold,
+			PublicKeys: pubs,
+			Proofs:     proofs,
+		},
+	}
+	if err := ms.SanityCheck(); err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// ProofOfPossession proves that we own the private key of a public key in a key set
+func ProofOfPossession(pv PrivateKey) Signature {
+	pub := pv.PublicKey()
+	return *pv.Sign(popMessage(pub))
+}
+
+func popMessage(pub PublicKey) []byte {
+	return append(append([]byte{}, popPrefix...), pub.RawBytes()...)
+}
+
+/// ----------
+/// ATTRIBUTES
+
+func (ms *MultiSig) Threshold() int          { return ms.data.Threshold }
+func (ms *MultiSig) PublicKeys() []PublicKey { return ms.data.PublicKeys }
+
+// Address returns the account address of the key set.
+// It only depends on the threshold and the public keys.
+func (ms *MultiSig) Address() Address {
+	bs, _ := cbor.Marshal(multiSigData{
+		Threshold:  ms.data.Threshold,
+		PublicKeys: ms.data.PublicKeys,
+	})
+	addr := new(Address)
+	copy(addr.data.Address[:], Hash160(Hash256(bs)))
+	return *addr
+}
+
+// Bitmap returns the signer bitmap for the participating keys, by their indices in the key set
+func (ms *MultiSig) Bitmap(indices ...int) []byte {
+	bitmap := make([]byte, (len(ms.data.PublicKeys)+7)/8)
+	for _, i := range indices {
+		bitmap[i/8] |= 1 << uint(i%8)
+	}
+	return bitmap
+}
+
+/// ----------
+/// MARSHALING
+
+func (ms *MultiSig) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(ms.data)
+}
+
+func (ms *MultiSig) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &ms.data)
+}
+
+func (ms *MultiSig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ms.data)
+}
+
+func (ms *MultiSig) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, &ms.data)
+}
+
+/// -------
+/// METHODS
+
+func (ms *MultiSig) SanityCheck() error {
+	n := len(ms.data.PublicKeys)
+	if n == 0 || n > MaximumMultiSigKeys {
+		return errors.Errorf(errors.ErrInvalidPublicKey, "Invalid number of public keys: %v", n)
+	}
+	if ms.data.Threshold < 1 || ms.data.Threshold > n {
+		return errors.Errorf(errors.ErrInvalidPublicKey, "Invalid threshold: %v", ms.data.Threshold)
+	}
+	if len(ms.data.Proofs) != n {
+		return errors.Errorf(errors.ErrInvalidPublicKey, "Proofs of possession don't match the public keys")
+	}
+	for i, pub := range ms.data.PublicKeys {
+		if err := pub.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidPublicKey, err.Error())
+		}
+		for _, other := range ms.data.PublicKeys[:i] {
+			if pub.EqualsTo(other) {
+				return errors.Errorf(errors.ErrInvalidPublicKey, "Duplicated public key: %v", pub)
+			}
+		}
+		proof := ms.data.Proofs[i]
+		if err := proof.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidSignature, err.Error())
+		}
+		if !pub.Verify(popMessage(pub), &proof) {
+			return errors.Errorf(errors.ErrInvalidSignature, "Invalid proof of possession for %v", pub)
+		}
+	}
+	return nil
+}
+
+// Verify checks the aggregated signature of the participants.
+// The participants are marked in the signer bitmap and they should reach the threshold.
+func (ms *MultiSig) Verify(msg []byte, sig *Signature, bitmap []byte) error {
+	n := len(ms.data.PublicKeys)
+	if len(bitmap) != (n+7)/8 {
+		return errors.Errorf(errors.ErrInvalidSignature, "Invalid signer bitmap")
+	}
+
+	pubs := make([]PublicKey, 0, n)
+	for i := 0; i < len(bitmap)*8; i++ {
+		if bitmap[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		if i >= n {
+			return errors.Errorf(errors.ErrInvalidSignature, "Invalid signer bitmap")
+		}
+		pubs = append(pubs, ms.data.PublicKeys[i])
+	}
+	if len(pubs) < ms.data.Threshold {
+		return errors.Errorf(errors.ErrInvalidSignature, "Not enough signers. expected: %v, got: %v", ms.data.Threshold, len(pubs))
+	}
+	if !VerifyAggregated(*sig, pubs, msg) {
+		return errors.Errorf(errors.ErrInvalidSignature, "Invalid aggregated signature")
+	}
+	return nil
+}
+
+func (ms *MultiSig) Fingerprint() string {
+	return fmt.Sprintf("{%v-of-%v %v}", ms.data.Threshold, len(ms.data.PublicKeys), ms.Address().Fingerprint())
+}
+
+// ---------
+// For tests
+func GenerateTestMultiSig(threshold, n int) (*MultiSig, []PrivateKey) {
+	pubs := make([]PublicKey, n)
+	privs := make([]PrivateKey, n)
+	proofs := make([]Signature, n)
+	for i := 0; i < n; i++ {
+		_, pubs[i], privs[i] = GenerateTestKeyPair()
+		proofs[i] = ProofOfPossession(privs[i])
+	}
+	ms, _ := NewMultiSig(threshold, pubs, proofs)
+	return ms, privs
+}
@@ -0,0 +1,69 @@
+package crypto
+
+import (
+	"testing"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiSigAddress(t *testing.T) {
+	ms1, _ := GenerateTestMultiSig(2, 3)
+	pubs := ms1.PublicKeys()
+	proofs := ms1.data.Proofs
+
+	ms2, err := NewMultiSig(3, pubs, proofs)
+	assert.NoError(t, err)
+	ms3, err := NewMultiSig(2, []PublicKey{pubs[1], pubs[0], pubs[2]}, []Signature{proofs[1], proofs[0], proofs[2]})
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, ms1.Address(), ms2.Address())
+	assert.NotEqual(t, ms1.Address(), ms3.Address())
+	for _, pub := range pubs {
+		assert.NotEqual(t, ms1.Address(), pub.Address())
+	}
+
+	bs, err := cbor.Marshal(ms1)
+	assert.NoError(t, err)
+	ms4 := new(MultiSig)
+	assert.NoError(t, cbor.Unmarshal(bs, ms4))
+	assert.Equal(t, ms1.Address(), ms4.Address())
+	assert.NoError(t, ms4.SanityCheck())
+}
+
+func TestMultiSigSanityCheck(t *testing.T) {
+	ms, privs := GenerateTestMultiSig(2, 3)
+	pubs := ms.PublicKeys()
+	proofs := ms.data.Proofs
+
+	_, err := NewMultiSig(0, pubs, proofs)
+	assert.Error(t, err, "Invalid threshold")
+	_, err = NewMultiSig(4, pubs, proofs)
+	assert.Error(t, err, "Invalid threshold")
+	_, err = NewMultiSig(1, nil, nil)
+	assert.Error(t, err, "No public key")
+	_, err = NewMultiSig(2, pubs, proofs[:2])
+	assert.Error(t, err, "Missed proof")
+	_, err = NewMultiSig(2, []PublicKey{pubs[0], pubs[0]}, []Signature{proofs[0], proofs[0]})
+	assert.Error(t, err, "Duplicated public key")
+	_, err = NewMultiSig(2, pubs, []Signature{proofs[1], proofs[0], proofs[2]})
+	assert.Error(t, err, "Invalid proof")
+	_, err = NewMultiSig(2, pubs, []Signature{*privs[0].Sign([]byte("zarb")), proofs[1], proofs[2]})
+	assert.Error(t, err, "Invalid proof")
+}
+
+func TestMultiSigVerify(t *testing.T) {
+	ms, privs := GenerateTestMultiSig(2, 3)
+	msg := []byte("zarb")
+
+	sig02 := Aggregate([]*Signature{privs[0].Sign(msg), privs[2].Sign(msg)})
+	sig0 := Aggregate([]*Signature{privs[0].Sign(msg)})
+
+	assert.NoError(t, ms.Verify(msg, &sig02, ms.Bitmap(0, 2)))
+	assert.Error(t, ms.Verify(msg, &sig02, ms.Bitmap(0, 1)), "Wrong signers")
+	assert.Error(t, ms.Verify(msg, &sig02, ms.Bitmap(0, 1, 2)), "Wrong signers")
+	assert.Error(t, ms.Verify([]byte("zarb0"), &sig02, ms.Bitmap(0, 2)), "Wrong message")
+	assert.Error(t, ms.Verify(msg, &sig0, ms.Bitmap(0)), "Threshold is not reached")
+	assert.Error(t, ms.Verify(msg, &sig02, []byte{0x05, 0x00}), "Invalid bitmap length")
+	assert.Error(t, ms.Verify(msg, &sig02, []byte{0x0d}), "Out of range signer")
+}
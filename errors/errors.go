@@ -22,28 +22,34 @@ const (
 	ErrInvalidConfig
 	ErrDuplicateVote
 	ErrInsufficientFunds
+	ErrTxPoolLimit
+	ErrUnsupportedVersion
+	ErrInvalidSnapshot
 
 	ErrCount
 )
 
 var messages = map[int]string{
-	ErrNone:              "No error",
-	ErrGeneric:           "Generic error",
-	ErrNetwork:           "Network error",
-	ErrInvalidBlock:      "Invalid block",
-	ErrInvalidAddress:    "Invalid address",
-	ErrInvalidPublicKey:  "Invalid public key",
-	ErrInvalidPrivateKey: "Invalid private key",
-	ErrInvalidSignature:  "Invalid signature",
-	ErrInvalidSequence:   "Invalid sequence",
-	ErrInvalidTx:         "Invalid transaction",
-	ErrInvalidReceipt:    "Invalid receipt",
-	ErrInvalidProposal:   "Invalid proposal",
-	ErrInvalidVote:       "Invalid vote",
-	ErrInvalidMessage:    "Invalid message",
-	ErrInvalidConfig:     "Invalid config",
-	ErrDuplicateVote:     "Duplicate vote",
-	ErrInsufficientFunds: "Insufficient funds",
+	ErrNone:               "No error",
+	ErrGeneric:            "Generic error",
+	ErrNetwork:            "Network error",
+	ErrInvalidBlock:       "Invalid block",
+	ErrInvalidAddress:     "Invalid address",
+	ErrInvalidPublicKey:   "Invalid public key",
+	ErrInvalidPrivateKey:  "Invalid private key",
+	ErrInvalidSignature:   "Invalid signature",
+	ErrInvalidSequence:    "Invalid sequence",
+	ErrInvalidTx:          "Invalid transaction",
+	ErrInvalidReceipt:     "Invalid receipt",
+	ErrInvalidProposal:    "Invalid proposal",
+	ErrInvalidVote:        "Invalid vote",
+	ErrInvalidMessage:     "Invalid message",
+	ErrInvalidConfig:      "Invalid config",
+	ErrDuplicateVote:      "Duplicate vote",
+	ErrInsufficientFunds:  "Insufficient funds",
+	ErrTxPoolLimit:        "Transaction pool limit reached",
+	ErrUnsupportedVersion: "Unsupported protocol version",
+	ErrInvalidSnapshot:    "Invalid snapshot",
 }
 
 type withCode struct {
@@ -0,0 +1,127 @@
+package escrow
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+// Escrow keeps the funds that are locked for a receiver until the unlock height.
+// If the escrow has a deadline, the refund address can take the funds back
+// once the deadline is reached and the receiver hasn't claimed them yet.
+type Escrow struct {
+	data escrowData
+}
+
+type escrowData struct {
+	ID           crypto.Hash     `cbor:"1,keyasint"` // Id of the lock transaction
+	Number       int             `cbor:"2,keyasint"`
+	Sender       crypto.Address  `cbor:"3,keyasint"`
+	Receiver     crypto.Address  `cbor:"4,keyasint"`
+	Amount       int64           `cbor:"5,keyasint"`
+	UnlockHeight int             `cbor:"6,keyasint"`
+	Refund       *crypto.Address `cbor:"7,keyasint,omitempty"`
+	Deadline     int             `cbor:"8,keyasint,omitempty"`
+	Claimed      bool            `cbor:"9,keyasint,omitempty"`
+}
+
+func NewEscrow(id crypto.Hash, number int) *Escrow {
+	return &Escrow{
+		data: escrowData{
+			ID:     id,
+			Number: number,
+		},
+	}
+}
+
+func (e Escrow) ID() crypto.Hash                { return e.data.ID }
+func (e Escrow) Number() int                    { return e.data.Number }
+func (e Escrow) Sender() crypto.Address         { return e.data.Sender }
+func (e Escrow) Receiver() crypto.Address       { return e.data.Receiver }
+func (e Escrow) Amount() int64                  { return e.data.Amount }
+func (e Escrow) UnlockHeight() int              { return e.data.UnlockHeight }
+func (e Escrow) RefundAddress() *crypto.Address { return e.data.Refund }
+func (e Escrow) Deadline() int                  { return e.data.Deadline }
+func (e Escrow) IsClaimed() bool                { return e.data.Claimed }
+
+// Lock locks the funds for the receiver. Refund address is optional and it is ignored if deadline is zero.
+func (e *Escrow) Lock(sender, receiver crypto.Address, amount int64, unlockHeight int, refund *crypto.Address, deadline int) {
+	e.data.Sender = sender
+	e.data.Receiver = receiver
+	e.data.Amount = amount
+	e.data.UnlockHeight = unlockHeight
+	if refund != nil && deadline > 0 {
+		e.data.Refund = refund
+		e.data.Deadline = deadline
+	}
+}
+
+// Claim marks the funds as claimed. The escrow is kept in the state, so the escrow numbers never change.
+func (e *Escrow) Claim() {
+	e.data.Claimed = true
+}
+
+// IsClaimableBy checks if the address can claim the funds at the given height
+func (e *Escrow) IsClaimableBy(addr crypto.Address, height int) bool {
+	if e.data.Claimed {
+		return false
+	}
+	if addr.EqualsTo(e.data.Receiver) &&
+		height >= e.data.UnlockHeight &&
+		(e.data.Deadline == 0 || height < e.data.Deadline) {
+		return true
+	}
+	if e.data.Refund != nil &&
+		addr.EqualsTo(*e.data.Refund) &&
+		height >= e.data.Deadline {
+		return true
+	}
+	return false
+}
+
+func (e *Escrow) Hash() crypto.Hash {
+	bs, err := e.Encode()
+	if err != nil {
+		panic(err)
+	}
+	return crypto.HashH(bs)
+}
+
+func (e *Escrow) Encode() ([]byte, error) {
+	return cbor.Marshal(e.data)
+}
+
+func (e *Escrow) Decode(bs []byte) error {
+	return cbor.Unmarshal(bs, &e.data)
+}
+
+func (e Escrow) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.data)
+}
+
+func (e *Escrow) UnmarshalJSON(bs []byte) error {
+	return json.Unmarshal(bs, &e.data)
+}
+
+func (e Escrow) Fingerprint() string {
+	return fmt.Sprintf("{⌘ %v %v->%v 💸 %v ⏳ %v}",
+		e.ID().Fingerprint(),
+		e.Sender().Fingerprint(),
+		e.Receiver().Fingerprint(),
+		e.Amount(),
+		e.UnlockHeight())
+}
+
+// GenerateTestEscrow generates an escrow for testing purpose
+func GenerateTestEscrow(number int) *Escrow {
+	a1, _, _ := crypto.GenerateTestKeyPair()
+	a2, _, _ := crypto.GenerateTestKeyPair()
+	a3, _, _ := crypto.GenerateTestKeyPair()
+	e := NewEscrow(crypto.GenerateTestHash(), number)
+	unlock := util.RandInt(1000) + 1
+	e.Lock(a1, a2, util.RandInt64(10000000), unlock, &a3, unlock+100)
+	return e
+}
@@ -0,0 +1,62 @@
+package escrow
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+func TestMarshaling(t *testing.T) {
+	e1 := GenerateTestEscrow(1)
+
+	bs, err := e1.Encode()
+	require.NoError(t, err)
+	e2 := new(Escrow)
+	require.NoError(t, e2.Decode(bs))
+	assert.Equal(t, e1, e2)
+	assert.Equal(t, e1.Hash(), e2.Hash())
+
+	js, err := json.Marshal(e1)
+	require.NoError(t, err)
+	e3 := new(Escrow)
+	require.NoError(t, json.Unmarshal(js, e3))
+	assert.Equal(t, e1, e3)
+
+	e1.Claim()
+	assert.NotEqual(t, e1.Hash(), e2.Hash())
+}
+
+func TestIsClaimable(t *testing.T) {
+	sender, _, _ := crypto.GenerateTestKeyPair()
+	receiver, _, _ := crypto.GenerateTestKeyPair()
+	refund, _, _ := crypto.GenerateTestKeyPair()
+
+	t.Run("Without deadline, refund address should be ignored", func(t *testing.T) {
+		e := NewEscrow(crypto.GenerateTestHash(), 0)
+		e.Lock(sender, receiver, 100, 10, &refund, 0)
+		assert.Nil(t, e.RefundAddress())
+
+		assert.False(t, e.IsClaimableBy(receiver, 9))
+		assert.True(t, e.IsClaimableBy(receiver, 10))
+		assert.True(t, e.IsClaimableBy(receiver, 1000))
+		assert.False(t, e.IsClaimableBy(refund, 1000))
+		assert.False(t, e.IsClaimableBy(sender, 1000))
+	})
+
+	t.Run("With deadline", func(t *testing.T) {
+		e := NewEscrow(crypto.GenerateTestHash(), 0)
+		e.Lock(sender, receiver, 100, 10, &refund, 20)
+
+		assert.False(t, e.IsClaimableBy(receiver, 9))
+		assert.True(t, e.IsClaimableBy(receiver, 19))
+		assert.False(t, e.IsClaimableBy(receiver, 20))
+		assert.False(t, e.IsClaimableBy(refund, 19))
+		assert.True(t, e.IsClaimableBy(refund, 20))
+
+		e.Claim()
+		assert.False(t, e.IsClaimableBy(refund, 20))
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/tx/payload"
+	"github.com/zarbchain/zarb-go/util"
 )
 
 type Executor interface {
@@ -15,18 +16,30 @@ type Executor interface {
 type Execution struct {
 	executors      map[payload.PayloadType]Executor
 	sandbox        sandbox.Sandbox
+	recorder       *recorder
 	accumulatedFee int64
+	fee            int64
 }
 
-func NewExecution(sb sandbox.Sandbox) *Execution {
+func NewExecution(sandbox sandbox.Sandbox) *Execution {
+	sb := newRecorder(sandbox)
 	execs := make(map[payload.PayloadType]Executor)
 	execs[payload.PayloadTypeSend] = executor.NewSendExecutor(sb)
 	execs[payload.PayloadTypeBond] = executor.NewBondExecutor(sb)
 	execs[payload.PayloadTypeSortition] = executor.NewSortitionExecutor(sb)
+	execs[payload.PayloadTypeUnbond] = executor.NewUnbondExecutor(sb)
+	execs[payload.PayloadTypeWithdraw] = executor.NewWithdrawExecutor(sb)
+	execs[payload.PayloadTypeEvidence] = executor.NewEvidenceExecutor(sb)
+	execs[payload.PayloadTypeBatchSend] = executor.NewBatchSendExecutor(sb)
+	execs[payload.PayloadTypeLock] = executor.NewLockExecutor(sb)
+	execs[payload.PayloadTypeClaim] = executor.NewClaimExecutor(sb)
+	execs[payload.PayloadTypeParamProposal] = executor.NewParamProposalExecutor(sb)
+	execs[payload.PayloadTypeParamVote] = executor.NewParamVoteExecutor(sb)
 
 	return &Execution{
 		executors: execs,
-		sandbox:   sb,
+		sandbox:   sandbox,
+		recorder:  sb,
 	}
 }
 
@@ -35,6 +48,27 @@ func (exe *Execution) Execute(trx *tx.Tx) error {
 		return err
 	}
 
+	return exe.execute(trx)
+}
+
+// DryRun executes the transaction same as Execute, but it accepts unsigned transactions.
+// The signature is verified only if the transaction is signed.
+func (exe *Execution) DryRun(trx *tx.Tx) error {
+	if trx.Signature() == nil {
+		if err := trx.SanityCheckUnsigned(); err != nil {
+			return err
+		}
+	} else {
+		if err := trx.SanityCheck(); err != nil {
+			return err
+		}
+	}
+
+	return exe.execute(trx)
+}
+
+// CheckStamp checks if the stamp of the transaction refers to a recent block
+func (exe *Execution) CheckStamp(trx *tx.Tx) error {
 	curHeight := exe.sandbox.CurrentHeight()
 	height := exe.sandbox.RecentBlockHeight(trx.Stamp())
 	interval := exe.sandbox.TransactionToLiveInterval()
@@ -42,6 +76,19 @@ func (exe *Execution) Execute(trx *tx.Tx) error {
 	if height == -1 || curHeight-height > interval {
 		return errors.Errorf(errors.ErrInvalidTx, "Invalid stamp")
 	}
+	return nil
+}
+
+func (exe *Execution) execute(trx *tx.Tx) error {
+	exe.fee = 0
+	exe.recorder.reset()
+
+	if trx.Version() != exe.sandbox.ProtocolVersion() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid version. Expected: %v, got: %v", exe.sandbox.ProtocolVersion(), trx.Version())
+	}
+	if err := exe.CheckStamp(trx); err != nil {
+		return err
+	}
 	if len(trx.Memo()) > exe.sandbox.MaxMemoLength() {
 		return errors.Errorf(errors.ErrInvalidTx, "Memo length exceeded")
 	}
@@ -55,11 +102,63 @@ func (exe *Execution) Execute(trx *tx.Tx) error {
 		return err
 	}
 
+	exe.fee = e.Fee()
 	exe.accumulatedFee += e.Fee()
 
 	return nil
 }
 
+// ExpectedFee returns the fee that the transaction should pay
+func (exe *Execution) ExpectedFee(trx *tx.Tx) int64 {
+	if trx.IsSubsidyTx() {
+		return 0
+	}
+	if trx.PayloadType() != payload.PayloadTypeSend &&
+		trx.PayloadType() != payload.PayloadTypeBatchSend &&
+		trx.PayloadType() != payload.PayloadTypeLock {
+		return 0
+	}
+	fee := int64(float64(trx.Payload().Value()) * exe.sandbox.FeeFraction())
+	return util.Max64(fee, exe.sandbox.MinFee())
+}
+
+// IsAccountTx returns true if the sequence of the transaction belongs to an account.
+// Other transactions use the sequence of the validator.
+func IsAccountTx(trx *tx.Tx) bool {
+	switch trx.PayloadType() {
+	case payload.PayloadTypeSend, payload.PayloadTypeBatchSend, payload.PayloadTypeBond,
+		payload.PayloadTypeLock, payload.PayloadTypeClaim:
+		return true
+	}
+	return false
+}
+
+// ExpectedSequence returns the sequence that the transaction should have.
+// It returns zero if the signer doesn't exist.
+func (exe *Execution) ExpectedSequence(trx *tx.Tx) int {
+	signer := trx.Payload().Signer()
+	if IsAccountTx(trx) {
+		if acc := exe.sandbox.Account(signer); acc != nil {
+			return acc.Sequence() + 1
+		}
+	} else {
+		if val := exe.sandbox.Validator(signer); val != nil {
+			return val.Sequence() + 1
+		}
+	}
+	return 0
+}
+
+// Fee returns the fee that is charged by the last executed transaction
+func (exe *Execution) Fee() int64 {
+	return exe.fee
+}
+
+// BalanceChanges returns the balance and stake changes caused by the last executed transaction
+func (exe *Execution) BalanceChanges() []tx.BalanceChange {
+	return exe.recorder.balanceChanges()
+}
+
 func (exe *Execution) ResetFee() {
 	exe.accumulatedFee = 0
 }
@@ -4,13 +4,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	"github.com/zarbchain/zarb-go/validator"
+	"github.com/zarbchain/zarb-go/vote"
 )
 
 var tExec *Execution
@@ -58,6 +61,11 @@ func checkTotalCoin(t *testing.T) {
 	for _, val := range tSandbox.Validators {
 		total += val.Stake()
 	}
+	for _, esc := range tSandbox.Escrows {
+		if !esc.IsClaimed() {
+			total += esc.Amount()
+		}
+	}
 	assert.Equal(t, total+tExec.accumulatedFee, tTotalCoin)
 }
 
@@ -100,6 +108,11 @@ func TestExecuteSendTx(t *testing.T) {
 	trx7 := tx.NewSendTx(stamp, tSandbox.AccSeq(tAddr2)+1, tAddr2, rcvAddr, 5000000, 5000, "ok", &tPub2, nil)
 	trx7.SetSignature(tPriv2.Sign(trx7.SignBytes()))
 	assert.NoError(t, tExec.Execute(trx7))
+	assert.Equal(t, tExec.Fee(), int64(5000))
+	assert.Equal(t, tExec.BalanceChanges(), []tx.BalanceChange{
+		{Address: tAddr2, Balance: -5005000},
+		{Address: rcvAddr, Balance: 5000000},
+	})
 	assert.Equal(t, tExec.AccumulatedFee(), int64(6000))
 
 	checkTotalCoin(t)
@@ -146,20 +159,29 @@ func TestExecuteSortitionTx(t *testing.T) {
 	tSandbox.AppendStampAndUpdateHeight(100, stamp)
 	proof := [48]byte{}
 
-	trx1 := tx.NewSortitionTx(stamp, 1, valAddr, proof[:], "invalid validator", &valPub, nil)
+	trx1 := tx.NewSortitionTx(1, stamp, 1, valAddr, proof[:], "invalid validator", &valPub, nil)
 	trx1.SetSignature(valPriv.Sign(trx1.SignBytes()))
 	assert.Error(t, tExec.Execute(trx1))
 
 	val := validator.NewValidator(valPub, 0, 0)
 	tSandbox.UpdateValidator(val)
 
-	trx2 := tx.NewSortitionTx(stamp, 1, valAddr, proof[:], "invalid proof", &valPub, nil)
+	trx2 := tx.NewSortitionTx(1, stamp, 1, valAddr, proof[:], "invalid proof", &valPub, nil)
 	trx2.SetSignature(valPriv.Sign(trx2.SignBytes()))
 	assert.Error(t, tExec.Execute(trx2))
 
 	sortition := sortition.NewSortition(crypto.NewSigner(valPriv))
-	trx3 := sortition.EvaluateTransaction(stamp, val)
+	trx3 := sortition.EvaluateTransaction(1, stamp, val)
 	assert.NotNil(t, trx3)
+
+	t.Run("Jailed validator can't join the set", func(t *testing.T) {
+		jailed := *val
+		jailed.Jail(95)
+		tSandbox.UpdateValidator(&jailed)
+		assert.Error(t, tExec.Execute(trx3))
+		tSandbox.UpdateValidator(val)
+	})
+
 	assert.NoError(t, tExec.Execute(trx3))
 
 	assert.Equal(t, tExec.AccumulatedFee(), int64(0))
@@ -179,7 +201,428 @@ func TestSendToSelf(t *testing.T) {
 	assert.NoError(t, tExec.Execute(trx))
 
 	assert.Equal(t, tSandbox.Account(tAddr1).Balance(), int64(2000)) // Crazy guy just want to pay fee!
+	assert.Equal(t, tExec.BalanceChanges(), []tx.BalanceChange{{Address: tAddr1, Balance: -1000}})
 
 	acc := tSandbox.Account(tAddr1)
 	assert.Equal(t, acc.Sequence(), seq+1)
 }
+
+func TestDryRun(t *testing.T) {
+	setup(t)
+
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	seq := tSandbox.AccSeq(tAddr1)
+	rcvAddr, _, _ := crypto.GenerateTestKeyPair()
+
+	trx1 := tx.NewSendTx(stamp, seq+1, tAddr1, rcvAddr, 1000, 1000, "unsigned", nil, nil)
+	assert.Error(t, tExec.Execute(trx1))
+	assert.NoError(t, tExec.DryRun(trx1))
+	assert.Equal(t, tExec.ExpectedFee(trx1), int64(1000))
+
+	trx2 := tx.NewSendTx(stamp, seq+2, tAddr1, rcvAddr, 1000, 1000, "invalid signature", &tPub2, nil)
+	trx2.SetSignature(tPriv2.Sign(trx2.SignBytes()))
+	assert.Error(t, tExec.DryRun(trx2))
+
+	trx3 := tx.NewBondTx(stamp, seq+2, tAddr1, tPub2, 1000, "bond", nil, nil)
+	assert.Equal(t, tExec.ExpectedFee(trx3), int64(0))
+	assert.Equal(t, tExec.ExpectedSequence(trx3), seq+2)
+
+	trx4 := tx.NewUnbondTx(stamp, 1, tAddr2, "unknown validator", nil, nil)
+	assert.Equal(t, tExec.ExpectedSequence(trx4), 0)
+}
+
+func TestExecuteUnbondTx(t *testing.T) {
+	setup(t)
+
+	valAddr, valPub, valPriv := crypto.GenerateTestKeyPair()
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+
+	trx1 := tx.NewUnbondTx(stamp, 1, valAddr, "invalid validator", &valPub, nil)
+	trx1.SetSignature(valPriv.Sign(trx1.SignBytes()))
+	assert.Error(t, tExec.Execute(trx1))
+
+	val := validator.NewValidator(valPub, 0, 0)
+	val.AddToStake(1000)
+	tSandbox.UpdateValidator(val)
+	tTotalCoin += 1000
+
+	trx2 := tx.NewUnbondTx(stamp, val.Sequence()+2, valAddr, "invalid sequence", &valPub, nil)
+	trx2.SetSignature(valPriv.Sign(trx2.SignBytes()))
+	assert.Error(t, tExec.Execute(trx2))
+
+	trx3 := tx.NewUnbondTx(stamp, val.Sequence()+1, valAddr, "ok", &valPub, nil)
+	trx3.SetSignature(valPriv.Sign(trx3.SignBytes()))
+	assert.NoError(t, tExec.Execute(trx3))
+	assert.Equal(t, tSandbox.Validator(valAddr).UnbondingHeight(), tSandbox.CurrentHeight())
+	assert.Equal(t, tSandbox.Validator(valAddr).Stake(), int64(1000))
+
+	trx4 := tx.NewUnbondTx(stamp, val.Sequence()+2, valAddr, "already unbonded", &valPub, nil)
+	trx4.SetSignature(valPriv.Sign(trx4.SignBytes()))
+	assert.Error(t, tExec.Execute(trx4))
+
+	// Unbonded validator can't be bonded or evaluated anymore
+	trx5 := tx.NewBondTx(stamp, tSandbox.AccSeq(tAddr1)+1, tAddr1, valPub, 1000, "unbonded validator", &tPub1, nil)
+	trx5.SetSignature(tPriv1.Sign(trx5.SignBytes()))
+	assert.Error(t, tExec.Execute(trx5))
+
+	sortition := sortition.NewSortition(crypto.NewSigner(valPriv))
+	trx6 := sortition.EvaluateTransaction(1, stamp, val)
+	assert.NotNil(t, trx6)
+	assert.Error(t, tExec.Execute(trx6))
+
+	assert.Equal(t, tExec.AccumulatedFee(), int64(0))
+
+	checkTotalCoin(t)
+}
+
+func TestExecuteWithdrawTx(t *testing.T) {
+	setup(t)
+
+	valAddr, valPub, valPriv := crypto.GenerateTestKeyPair()
+	rcvAddr, _, _ := crypto.GenerateTestKeyPair()
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+
+	val := validator.NewValidator(valPub, 0, 0)
+	val.AddToStake(1000)
+	tSandbox.UpdateValidator(val)
+	tTotalCoin += 1000
+
+	trx1 := tx.NewWithdrawTx(stamp, val.Sequence()+1, valAddr, rcvAddr, 1000, "not unbonded", &valPub, nil)
+	trx1.SetSignature(valPriv.Sign(trx1.SignBytes()))
+	assert.Error(t, tExec.Execute(trx1))
+
+	trx2 := tx.NewUnbondTx(stamp, val.Sequence()+1, valAddr, "unbond", &valPub, nil)
+	trx2.SetSignature(valPriv.Sign(trx2.SignBytes()))
+	assert.NoError(t, tExec.Execute(trx2))
+	val = tSandbox.Validator(valAddr)
+
+	trx3 := tx.NewWithdrawTx(stamp, val.Sequence()+1, valAddr, rcvAddr, 1000, "locked", &valPub, nil)
+	trx3.SetSignature(valPriv.Sign(trx3.SignBytes()))
+	assert.Error(t, tExec.Execute(trx3))
+
+	stamp2 := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100+tSandbox.WiredrawInterval, stamp2)
+
+	trx4 := tx.NewWithdrawTx(stamp2, val.Sequence()+2, valAddr, rcvAddr, 1000, "invalid sequence", &valPub, nil)
+	trx4.SetSignature(valPriv.Sign(trx4.SignBytes()))
+	assert.Error(t, tExec.Execute(trx4))
+
+	trx5 := tx.NewWithdrawTx(stamp2, val.Sequence()+1, valAddr, rcvAddr, 999, "invalid amount", &valPub, nil)
+	trx5.SetSignature(valPriv.Sign(trx5.SignBytes()))
+	assert.Error(t, tExec.Execute(trx5))
+
+	trx6 := tx.NewWithdrawTx(stamp2, val.Sequence()+1, valAddr, rcvAddr, 1000, "ok", &valPub, nil)
+	trx6.SetSignature(valPriv.Sign(trx6.SignBytes()))
+	assert.NoError(t, tExec.Execute(trx6))
+
+	// Duplicated. Invalid sequence
+	assert.Error(t, tExec.Execute(trx6))
+
+	assert.Equal(t, tSandbox.Validator(valAddr).Stake(), int64(0))
+	assert.Equal(t, tSandbox.Account(rcvAddr).Balance(), int64(1000))
+	assert.Equal(t, tExec.AccumulatedFee(), int64(0))
+
+	checkTotalCoin(t)
+}
+
+func TestExecuteEvidenceTx(t *testing.T) {
+	setup(t)
+
+	treasury := account.NewAccount(crypto.TreasuryAddress, 2)
+	tSandbox.UpdateAccount(treasury)
+
+	offAddr, offPub, offPriv := crypto.GenerateTestKeyPair()
+	repAddr, repPub, repPriv := crypto.GenerateTestKeyPair()
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+
+	offender := validator.NewValidator(offPub, 1, 0)
+	offender.AddToStake(1000)
+	tSandbox.UpdateValidator(offender)
+	reporter := validator.NewValidator(repPub, 2, 0)
+	tSandbox.UpdateValidator(reporter)
+	tTotalCoin += 1000
+
+	signVote := func(v *vote.Vote) []byte {
+		v.SetSignature(offPriv.Sign(v.SignBytes()))
+		bs, _ := v.MarshalCBOR()
+		return bs
+	}
+	h1 := crypto.GenerateTestHash()
+	h2 := crypto.GenerateTestHash()
+	vote1 := signVote(vote.NewPrecommit(100, 0, h1, offAddr))
+	vote2 := signVote(vote.NewPrecommit(100, 0, h2, offAddr))
+	vote3 := signVote(vote.NewPrecommit(100, 1, h2, offAddr))
+	vote4 := signVote(vote.NewPrecommit(100, 0, h1, offAddr))
+
+	trx1 := tx.NewEvidenceTx(stamp, reporter.Sequence()+2, repAddr, offAddr, vote1, vote2, "invalid sequence", &repPub, nil)
+	trx1.SetSignature(repPriv.Sign(trx1.SignBytes()))
+	assert.Error(t, tExec.Execute(trx1))
+
+	trx2 := tx.NewEvidenceTx(stamp, reporter.Sequence()+1, repAddr, offAddr, vote1, vote3, "different rounds", &repPub, nil)
+	trx2.SetSignature(repPriv.Sign(trx2.SignBytes()))
+	assert.Error(t, tExec.Execute(trx2))
+
+	trx3 := tx.NewEvidenceTx(stamp, reporter.Sequence()+1, repAddr, offAddr, vote1, vote4, "same block", &repPub, nil)
+	trx3.SetSignature(repPriv.Sign(trx3.SignBytes()))
+	assert.Error(t, tExec.Execute(trx3))
+
+	trx4 := tx.NewEvidenceTx(stamp, reporter.Sequence()+1, repAddr, tAddr1, vote1, vote2, "invalid offender", &repPub, nil)
+	trx4.SetSignature(repPriv.Sign(trx4.SignBytes()))
+	assert.Error(t, tExec.Execute(trx4))
+
+	trx5 := tx.NewEvidenceTx(stamp, reporter.Sequence()+1, repAddr, offAddr, vote1, vote2, "ok", &repPub, nil)
+	trx5.SetSignature(repPriv.Sign(trx5.SignBytes()))
+	assert.NoError(t, tExec.Execute(trx5))
+
+	assert.Equal(t, tSandbox.Validator(offAddr).Stake(), int64(0))
+	assert.Equal(t, tSandbox.Validator(offAddr).UnbondingHeight(), tSandbox.CurrentHeight())
+	assert.Equal(t, tSandbox.Account(crypto.TreasuryAddress).Balance(), int64(1000))
+
+	// Already slashed
+	trx6 := tx.NewEvidenceTx(stamp, reporter.Sequence()+2, repAddr, offAddr, vote1, vote2, "already slashed", &repPub, nil)
+	trx6.SetSignature(repPriv.Sign(trx6.SignBytes()))
+	assert.Error(t, tExec.Execute(trx6))
+
+	assert.Equal(t, tExec.AccumulatedFee(), int64(0))
+
+	checkTotalCoin(t)
+}
+
+func TestExecuteBatchSendTx(t *testing.T) {
+	setup(t)
+
+	rcvAddr1, _, _ := crypto.GenerateTestKeyPair()
+	rcvAddr2, _, _ := crypto.GenerateTestKeyPair()
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	receivers := []payload.BatchReceiver{
+		{Address: rcvAddr1, Amount: 3000000},
+		{Address: rcvAddr2, Amount: 2000000},
+	}
+
+	trx1 := tx.NewBatchSendTx(stamp, tSandbox.AccSeq(tAddr2)+1, tAddr2, receivers, 3000, "invalid fee", &tPub2, nil)
+	trx1.SetSignature(tPriv2.Sign(trx1.SignBytes()))
+	assert.Error(t, tExec.Execute(trx1))
+
+	trx2 := tx.NewBatchSendTx(stamp, tSandbox.AccSeq(tAddr1)+1, tAddr1, receivers, 5000, "insufficient balance", &tPub1, nil)
+	trx2.SetSignature(tPriv1.Sign(trx2.SignBytes()))
+	assert.Error(t, tExec.Execute(trx2))
+
+	trx3 := tx.NewBatchSendTx(stamp, tSandbox.AccSeq(tAddr2)+2, tAddr2, receivers, 5000, "invalid sequence", &tPub2, nil)
+	trx3.SetSignature(tPriv2.Sign(trx3.SignBytes()))
+	assert.Error(t, tExec.Execute(trx3))
+
+	seq := tSandbox.AccSeq(tAddr2)
+	trx4 := tx.NewBatchSendTx(stamp, seq+1, tAddr2, receivers, 5000, "ok", &tPub2, nil)
+	trx4.SetSignature(tPriv2.Sign(trx4.SignBytes()))
+	assert.Equal(t, tExec.ExpectedFee(trx4), int64(5000))
+	assert.NoError(t, tExec.Execute(trx4))
+	assert.Equal(t, tSandbox.Account(tAddr2).Balance(), int64(10000000000000000-5005000))
+	assert.Equal(t, tSandbox.Account(tAddr2).Sequence(), seq+1)
+	assert.Equal(t, tSandbox.Account(rcvAddr1).Balance(), int64(3000000))
+	assert.Equal(t, tSandbox.Account(rcvAddr2).Balance(), int64(2000000))
+	assert.Equal(t, tExec.AccumulatedFee(), int64(5000))
+
+	checkTotalCoin(t)
+}
+
+func TestExecuteLockAndClaimTx(t *testing.T) {
+	setup(t)
+
+	rcvAddr, rcvPub, rcvPriv := crypto.GenerateTestKeyPair()
+	stamp1 := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp1)
+
+	trx1 := tx.NewLockTx(stamp1, tSandbox.AccSeq(tAddr2)+1, tAddr2, rcvAddr, 1000000, 101, &tAddr2, 120, 1000, "unlock height is not in future", &tPub2, nil)
+	trx1.SetSignature(tPriv2.Sign(trx1.SignBytes()))
+	assert.Error(t, tExec.Execute(trx1))
+
+	trx2 := tx.NewLockTx(stamp1, tSandbox.AccSeq(tAddr2)+1, tAddr2, rcvAddr, 1000000, 110, &tAddr2, 120, 2000, "invalid fee", &tPub2, nil)
+	trx2.SetSignature(tPriv2.Sign(trx2.SignBytes()))
+	assert.Error(t, tExec.Execute(trx2))
+
+	trx3 := tx.NewLockTx(stamp1, tSandbox.AccSeq(tAddr1)+1, tAddr1, rcvAddr, 1000000, 110, nil, 0, 1000, "insufficient balance", &tPub1, nil)
+	trx3.SetSignature(tPriv1.Sign(trx3.SignBytes()))
+	assert.Error(t, tExec.Execute(trx3))
+
+	seq := tSandbox.AccSeq(tAddr2)
+	lock1 := tx.NewLockTx(stamp1, seq+1, tAddr2, rcvAddr, 1000000, 110, &tAddr2, 120, 1000, "ok", &tPub2, nil)
+	lock1.SetSignature(tPriv2.Sign(lock1.SignBytes()))
+	assert.Equal(t, tExec.ExpectedFee(lock1), int64(1000))
+	assert.NoError(t, tExec.Execute(lock1))
+
+	lock2 := tx.NewLockTx(stamp1, seq+2, tAddr2, rcvAddr, 2000000, 110, &tAddr2, 120, 2000, "ok", &tPub2, nil)
+	lock2.SetSignature(tPriv2.Sign(lock2.SignBytes()))
+	assert.NoError(t, tExec.Execute(lock2))
+	assert.Equal(t, tSandbox.Account(tAddr2).Balance(), int64(10000000000000000-3003000))
+	assert.Equal(t, tSandbox.Escrow(lock1.ID()).Amount(), int64(1000000))
+	checkTotalCoin(t)
+
+	t.Run("Receiver can't claim before unlock height", func(t *testing.T) {
+		trx := tx.NewClaimTx(stamp1, 1, rcvAddr, lock1.ID(), 1000000, "locked", &rcvPub, nil)
+		trx.SetSignature(rcvPriv.Sign(trx.SignBytes()))
+		assert.Error(t, tExec.Execute(trx))
+	})
+
+	stamp2 := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(109, stamp2)
+
+	t.Run("Refund address can't claim before deadline", func(t *testing.T) {
+		trx := tx.NewClaimTx(stamp2, tSandbox.AccSeq(tAddr2)+1, tAddr2, lock1.ID(), 1000000, "refund", &tPub2, nil)
+		trx.SetSignature(tPriv2.Sign(trx.SignBytes()))
+		assert.Error(t, tExec.Execute(trx))
+	})
+
+	t.Run("Invalid amount", func(t *testing.T) {
+		trx := tx.NewClaimTx(stamp2, 1, rcvAddr, lock1.ID(), 1000001, "invalid amount", &rcvPub, nil)
+		trx.SetSignature(rcvPriv.Sign(trx.SignBytes()))
+		assert.Error(t, tExec.Execute(trx))
+	})
+
+	t.Run("Unknown escrow", func(t *testing.T) {
+		trx := tx.NewClaimTx(stamp2, 1, rcvAddr, crypto.GenerateTestHash(), 1000000, "unknown", &rcvPub, nil)
+		trx.SetSignature(rcvPriv.Sign(trx.SignBytes()))
+		assert.Error(t, tExec.Execute(trx))
+	})
+
+	t.Run("Invalid sequence doesn't make the claimer account", func(t *testing.T) {
+		totalAccount := tSandbox.TotalAccount
+		trx := tx.NewClaimTx(stamp2, 2, rcvAddr, lock1.ID(), 1000000, "invalid sequence", &rcvPub, nil)
+		trx.SetSignature(rcvPriv.Sign(trx.SignBytes()))
+		assert.Error(t, tExec.Execute(trx))
+		assert.Nil(t, tSandbox.Account(rcvAddr))
+		assert.Equal(t, tSandbox.TotalAccount, totalAccount)
+	})
+
+	t.Run("Receiver claims the funds", func(t *testing.T) {
+		trx := tx.NewClaimTx(stamp2, 1, rcvAddr, lock1.ID(), 1000000, "ok", &rcvPub, nil)
+		trx.SetSignature(rcvPriv.Sign(trx.SignBytes()))
+		assert.NoError(t, tExec.Execute(trx))
+		assert.Equal(t, tSandbox.Account(rcvAddr).Balance(), int64(1000000))
+		assert.True(t, tSandbox.Escrow(lock1.ID()).IsClaimed())
+
+		trx2 := tx.NewClaimTx(stamp2, 2, rcvAddr, lock1.ID(), 1000000, "already claimed", &rcvPub, nil)
+		trx2.SetSignature(rcvPriv.Sign(trx2.SignBytes()))
+		assert.Error(t, tExec.Execute(trx2))
+	})
+
+	stamp3 := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(119, stamp3)
+
+	t.Run("Receiver can't claim after deadline", func(t *testing.T) {
+		trx := tx.NewClaimTx(stamp3, 2, rcvAddr, lock2.ID(), 2000000, "expired", &rcvPub, nil)
+		trx.SetSignature(rcvPriv.Sign(trx.SignBytes()))
+		assert.Error(t, tExec.Execute(trx))
+	})
+
+	t.Run("Refund address claims the funds back", func(t *testing.T) {
+		trx := tx.NewClaimTx(stamp3, tSandbox.AccSeq(tAddr2)+1, tAddr2, lock2.ID(), 2000000, "refund", &tPub2, nil)
+		trx.SetSignature(tPriv2.Sign(trx.SignBytes()))
+		assert.NoError(t, tExec.Execute(trx))
+		assert.Equal(t, tSandbox.Account(tAddr2).Balance(), int64(10000000000000000-1003000))
+	})
+
+	checkTotalCoin(t)
+}
+
+func TestExecuteParamProposalAndVoteTx(t *testing.T) {
+	setup(t)
+
+	_, pub3, priv3 := crypto.GenerateTestKeyPair()
+	val3 := validator.NewValidator(pub3, 1, 0)
+	tSandbox.UpdateValidator(val3)
+
+	stamp1 := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp1)
+
+	params := tSandbox.Params()
+	params.MinimumFee = 2000
+
+	trx1 := tx.NewParamProposalTx(stamp1, tVal1.Sequence()+2, tAddr1, params, 110, "invalid sequence", &tPub1, nil)
+	trx1.SetSignature(tPriv1.Sign(trx1.SignBytes()))
+	assert.Error(t, tExec.Execute(trx1))
+
+	trx2 := tx.NewParamProposalTx(stamp1, tVal1.Sequence()+1, tAddr1, params, 101, "activation height is not in future", &tPub1, nil)
+	trx2.SetSignature(tPriv1.Sign(trx2.SignBytes()))
+	assert.Error(t, tExec.Execute(trx2))
+
+	invParams := params
+	invParams.MaximumPower = params.MaximumPower + 1
+	trx3 := tx.NewParamProposalTx(stamp1, tVal1.Sequence()+1, tAddr1, invParams, 110, "maximum power can't be changed", &tPub1, nil)
+	trx3.SetSignature(tPriv1.Sign(trx3.SignBytes()))
+	assert.Error(t, tExec.Execute(trx3))
+
+	proposal := tx.NewParamProposalTx(stamp1, tVal1.Sequence()+1, tAddr1, params, 110, "ok", &tPub1, nil)
+	proposal.SetSignature(tPriv1.Sign(proposal.SignBytes()))
+	assert.Equal(t, tExec.ExpectedFee(proposal), int64(0))
+	assert.NoError(t, tExec.Execute(proposal))
+	assert.Equal(t, tSandbox.Validator(tAddr1).Sequence(), 1)
+
+	p := tSandbox.Proposal(proposal.ID())
+	require.NotNil(t, p)
+	assert.Equal(t, p.Params(), params)
+	assert.True(t, p.HasVoted(tAddr1))
+	assert.False(t, p.HasVoted(pub3.Address()))
+
+	t.Run("Unknown proposal", func(t *testing.T) {
+		trx := tx.NewParamVoteTx(stamp1, 1, pub3.Address(), crypto.GenerateTestHash(), "unknown", &pub3, nil)
+		trx.SetSignature(priv3.Sign(trx.SignBytes()))
+		assert.Error(t, tExec.Execute(trx))
+	})
+
+	t.Run("Proposer has voted before", func(t *testing.T) {
+		trx := tx.NewParamVoteTx(stamp1, 2, tAddr1, proposal.ID(), "duplicated", &tPub1, nil)
+		trx.SetSignature(tPriv1.Sign(trx.SignBytes()))
+		assert.Error(t, tExec.Execute(trx))
+	})
+
+	t.Run("Validator votes for the proposal", func(t *testing.T) {
+		trx := tx.NewParamVoteTx(stamp1, 1, pub3.Address(), proposal.ID(), "ok", &pub3, nil)
+		trx.SetSignature(priv3.Sign(trx.SignBytes()))
+		assert.NoError(t, tExec.Execute(trx))
+		assert.True(t, tSandbox.Proposal(proposal.ID()).HasVoted(pub3.Address()))
+	})
+
+	stamp2 := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(109, stamp2)
+
+	t.Run("Voting is closed at activation height", func(t *testing.T) {
+		_, pub4, priv4 := crypto.GenerateTestKeyPair()
+		tSandbox.UpdateValidator(validator.NewValidator(pub4, 2, 0))
+
+		trx := tx.NewParamVoteTx(stamp2, 1, pub4.Address(), proposal.ID(), "closed", &pub4, nil)
+		trx.SetSignature(priv4.Sign(trx.SignBytes()))
+		assert.Error(t, tExec.Execute(trx))
+	})
+
+	t.Run("Proposer has too many open proposals", func(t *testing.T) {
+		trx1 := tx.NewParamProposalTx(stamp2, 2, tAddr1, params, 120, "second", &tPub1, nil)
+		trx1.SetSignature(tPriv1.Sign(trx1.SignBytes()))
+		assert.NoError(t, tExec.Execute(trx1))
+
+		trx2 := tx.NewParamProposalTx(stamp2, 3, tAddr1, params, 120, "third", &tPub1, nil)
+		trx2.SetSignature(tPriv1.Sign(trx2.SignBytes()))
+		assert.Error(t, tExec.Execute(trx2))
+	})
+}
+
+func TestInvalidVersion(t *testing.T) {
+	setup(t)
+
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	rcvAddr, _, _ := crypto.GenerateTestKeyPair()
+
+	trx := tx.NewSendTx(stamp, tSandbox.AccSeq(tAddr2)+1, tAddr2, rcvAddr, 1000, 1000, "ok", &tPub2, nil)
+	trx.SetSignature(tPriv2.Sign(trx.SignBytes()))
+
+	tSandbox.Version = 2
+	assert.Error(t, tExec.Execute(trx))
+
+	tSandbox.Version = 1
+	assert.NoError(t, tExec.Execute(trx))
+}
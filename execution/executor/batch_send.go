@@ -0,0 +1,66 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+type BatchSendExecutor struct {
+	sandbox sandbox.Sandbox
+	fee     int64
+}
+
+func NewBatchSendExecutor(sandbox sandbox.Sandbox) *BatchSendExecutor {
+	return &BatchSendExecutor{sandbox: sandbox}
+}
+
+func (e *BatchSendExecutor) Execute(trx *tx.Tx) error {
+	pld := trx.Payload().(*payload.BatchSendPayload)
+
+	senderAcc := e.sandbox.Account(pld.Sender)
+	if senderAcc == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve sender account")
+	}
+	total := pld.Value()
+	if senderAcc.Balance() < total+trx.Fee() {
+		return errors.Errorf(errors.ErrInvalidTx, "Insufficient balance")
+	}
+	if senderAcc.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence, Expected: %v, got: %v", senderAcc.Sequence()+1, trx.Sequence())
+	}
+	if trx.IsSubsidyTx() {
+		if trx.Fee() != 0 {
+			return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: 0, got: %v", trx.Fee())
+		}
+	} else {
+		fee := int64(float64(total) * e.sandbox.FeeFraction())
+		fee = util.Max64(fee, e.sandbox.MinFee())
+		if trx.Fee() != fee {
+			return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: %v, got: %v", fee, trx.Fee())
+		}
+	}
+
+	senderAcc.IncSequence()
+	senderAcc.SubtractFromBalance(total + trx.Fee())
+	e.sandbox.UpdateAccount(senderAcc)
+
+	for _, r := range pld.Receivers {
+		receiverAcc := e.sandbox.Account(r.Address)
+		if receiverAcc == nil {
+			receiverAcc = e.sandbox.MakeNewAccount(r.Address)
+		}
+		receiverAcc.AddToBalance(r.Amount)
+		e.sandbox.UpdateAccount(receiverAcc)
+	}
+
+	e.fee = trx.Fee()
+
+	return nil
+}
+
+func (e *BatchSendExecutor) Fee() int64 {
+	return e.fee
+}
@@ -26,6 +26,9 @@ func (e *BondExecutor) Execute(trx *tx.Tx) error {
 	if bondVal == nil {
 		bondVal = e.sandbox.MakeNewValidator(pld.Validator)
 	}
+	if bondVal.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has unbonded at height %v", bondVal.UnbondingHeight())
+	}
 	if bonderAcc.Sequence()+1 != trx.Sequence() {
 		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", bonderAcc.Sequence()+1, trx.Sequence())
 	}
@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type ClaimExecutor struct {
+	sandbox sandbox.Sandbox
+}
+
+func NewClaimExecutor(sandbox sandbox.Sandbox) *ClaimExecutor {
+	return &ClaimExecutor{sandbox}
+}
+
+func (e *ClaimExecutor) Execute(trx *tx.Tx) error {
+	pld := trx.Payload().(*payload.ClaimPayload)
+
+	esc := e.sandbox.Escrow(pld.Escrow)
+	if esc == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve escrow")
+	}
+	if esc.IsClaimed() {
+		return errors.Errorf(errors.ErrInvalidTx, "Escrow is already claimed")
+	}
+	if !esc.IsClaimableBy(pld.Claimer, e.sandbox.CurrentHeight()) {
+		return errors.Errorf(errors.ErrInvalidTx, "Escrow is not claimable by %v at this height", pld.Claimer)
+	}
+	if esc.Amount() != pld.Amount {
+		return errors.Errorf(errors.ErrInvalidTx, "Amount is wrong. Expected: %v, got: %v", esc.Amount(), pld.Amount)
+	}
+	if trx.Fee() != 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: 0, got: %v", trx.Fee())
+	}
+
+	// The claimer account is made after validating the transaction, an invalid claim shouldn't make an account
+	acc := e.sandbox.Account(pld.Claimer)
+	seq := 0
+	if acc != nil {
+		seq = acc.Sequence()
+	}
+	if seq+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence, Expected: %v, got: %v", seq+1, trx.Sequence())
+	}
+	if acc == nil {
+		acc = e.sandbox.MakeNewAccount(pld.Claimer)
+	}
+
+	esc.Claim()
+	acc.IncSequence()
+	acc.AddToBalance(pld.Amount)
+
+	e.sandbox.UpdateEscrow(esc)
+	e.sandbox.UpdateAccount(acc)
+
+	return nil
+}
+
+func (e *ClaimExecutor) Fee() int64 {
+	return 0
+}
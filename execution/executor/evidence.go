@@ -0,0 +1,109 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+	"github.com/zarbchain/zarb-go/vote"
+)
+
+type EvidenceExecutor struct {
+	sandbox sandbox.Sandbox
+}
+
+func NewEvidenceExecutor(sandbox sandbox.Sandbox) *EvidenceExecutor {
+	return &EvidenceExecutor{sandbox}
+}
+
+func (e *EvidenceExecutor) Execute(trx *tx.Tx) error {
+	pld := trx.Payload().(*payload.EvidencePayload)
+
+	reporter := e.sandbox.Validator(pld.Reporter)
+	if reporter == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve reporter")
+	}
+	offender := e.sandbox.Validator(pld.Offender)
+	if offender == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve offender")
+	}
+	if reporter.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", reporter.Sequence()+1, trx.Sequence())
+	}
+	if trx.Fee() != 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: 0, got: %v", trx.Fee())
+	}
+	if err := e.checkVotes(pld, offender.PublicKey()); err != nil {
+		return err
+	}
+	if offender.Stake() == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Offender has no stake to slash")
+	}
+
+	treasuryAcc := e.sandbox.Account(crypto.TreasuryAddress)
+	if treasuryAcc == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve treasury account")
+	}
+	if err := e.sandbox.LeaveSet(offender.Address()); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+
+	// Slash the whole stake and take the offender out of the set
+	treasuryAcc.AddToBalance(offender.Stake())
+	offender.WithdrawStake()
+	if offender.UnbondingHeight() == 0 {
+		offender.UpdateUnbondingHeight(e.sandbox.CurrentHeight())
+	}
+	reporter.IncSequence()
+
+	e.sandbox.UpdateAccount(treasuryAcc)
+	e.sandbox.UpdateValidator(offender)
+	e.sandbox.UpdateValidator(reporter)
+
+	return nil
+}
+
+func (e *EvidenceExecutor) checkVotes(pld *payload.EvidencePayload, pub crypto.PublicKey) error {
+	vote1 := new(vote.Vote)
+	vote2 := new(vote.Vote)
+	if err := vote1.UnmarshalCBOR(pld.Vote1); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to decode the first vote")
+	}
+	if err := vote2.UnmarshalCBOR(pld.Vote2); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to decode the second vote")
+	}
+	if err := vote1.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+	if err := vote2.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+	if vote1.Height() != vote2.Height() ||
+		vote1.Round() != vote2.Round() ||
+		vote1.VoteType() != vote2.VoteType() {
+		return errors.Errorf(errors.ErrInvalidTx, "Votes are not for the same height, round and type")
+	}
+	if vote1.Height() > e.sandbox.CurrentHeight() {
+		return errors.Errorf(errors.ErrInvalidTx, "Votes are for a future height")
+	}
+	if vote1.BlockHash().IsUndef() || vote2.BlockHash().IsUndef() ||
+		vote1.BlockHash().EqualsTo(vote2.BlockHash()) {
+		return errors.Errorf(errors.ErrInvalidTx, "Votes are not conflicting")
+	}
+	if !vote1.Signer().EqualsTo(pld.Offender) || !vote2.Signer().EqualsTo(pld.Offender) {
+		return errors.Errorf(errors.ErrInvalidTx, "Votes are not signed by the offender")
+	}
+	if err := vote1.Verify(pub); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+	if err := vote2.Verify(pub); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+
+	return nil
+}
+
+func (e *EvidenceExecutor) Fee() int64 {
+	return 0
+}
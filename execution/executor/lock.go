@@ -0,0 +1,58 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+type LockExecutor struct {
+	sandbox sandbox.Sandbox
+	fee     int64
+}
+
+func NewLockExecutor(sandbox sandbox.Sandbox) *LockExecutor {
+	return &LockExecutor{sandbox: sandbox}
+}
+
+func (e *LockExecutor) Execute(trx *tx.Tx) error {
+	pld := trx.Payload().(*payload.LockPayload)
+
+	senderAcc := e.sandbox.Account(pld.Sender)
+	if senderAcc == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve sender account")
+	}
+	if senderAcc.Balance() < pld.Amount+trx.Fee() {
+		return errors.Errorf(errors.ErrInvalidTx, "Insufficient balance")
+	}
+	if senderAcc.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence, Expected: %v, got: %v", senderAcc.Sequence()+1, trx.Sequence())
+	}
+	if pld.UnlockHeight <= e.sandbox.CurrentHeight() {
+		return errors.Errorf(errors.ErrInvalidTx, "Unlock height should be in future")
+	}
+	fee := int64(float64(pld.Amount) * e.sandbox.FeeFraction())
+	fee = util.Max64(fee, e.sandbox.MinFee())
+	if trx.Fee() != fee {
+		return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: %v, got: %v", fee, trx.Fee())
+	}
+
+	esc := e.sandbox.MakeNewEscrow(trx.ID())
+	esc.Lock(pld.Sender, pld.Receiver, pld.Amount, pld.UnlockHeight, pld.Refund, pld.Deadline)
+
+	senderAcc.IncSequence()
+	senderAcc.SubtractFromBalance(pld.Amount + trx.Fee())
+
+	e.sandbox.UpdateAccount(senderAcc)
+	e.sandbox.UpdateEscrow(esc)
+
+	e.fee = trx.Fee()
+
+	return nil
+}
+
+func (e *LockExecutor) Fee() int64 {
+	return e.fee
+}
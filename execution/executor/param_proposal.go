@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/governance"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type ParamProposalExecutor struct {
+	sandbox sandbox.Sandbox
+}
+
+func NewParamProposalExecutor(sandbox sandbox.Sandbox) *ParamProposalExecutor {
+	return &ParamProposalExecutor{sandbox}
+}
+
+func (e *ParamProposalExecutor) Execute(trx *tx.Tx) error {
+	pld := trx.Payload().(*payload.ParamProposalPayload)
+
+	val := e.sandbox.Validator(pld.Proposer)
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
+	}
+	if val.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
+	}
+	if val.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has unbonded")
+	}
+	if trx.Fee() != 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: 0, got: %v", trx.Fee())
+	}
+	if pld.ActivationHeight <= e.sandbox.CurrentHeight() {
+		return errors.Errorf(errors.ErrInvalidTx, "Activation height should be in future")
+	}
+	if e.sandbox.OpenProposals(pld.Proposer) >= governance.MaximumOpenProposals {
+		return errors.Errorf(errors.ErrInvalidTx, "Proposer has too many open proposals")
+	}
+	// These parameters define the size of the validator set and the recent blocks,
+	// changing them needs a new genesis
+	current := e.sandbox.Params()
+	if pld.Params.MaximumPower != current.MaximumPower {
+		return errors.Errorf(errors.ErrInvalidTx, "Maximum power can't be changed")
+	}
+	if pld.Params.TransactionToLiveInterval != current.TransactionToLiveInterval {
+		return errors.Errorf(errors.ErrInvalidTx, "Transaction to live interval can't be changed")
+	}
+
+	proposal := e.sandbox.MakeNewProposal(trx.ID())
+	proposal.Propose(pld.Proposer, pld.Params, pld.ActivationHeight)
+	proposal.AddVote(pld.Proposer)
+
+	val.IncSequence()
+
+	e.sandbox.UpdateProposal(proposal)
+	e.sandbox.UpdateValidator(val)
+
+	return nil
+}
+
+func (e *ParamProposalExecutor) Fee() int64 {
+	return 0
+}
@@ -0,0 +1,57 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type ParamVoteExecutor struct {
+	sandbox sandbox.Sandbox
+}
+
+func NewParamVoteExecutor(sandbox sandbox.Sandbox) *ParamVoteExecutor {
+	return &ParamVoteExecutor{sandbox}
+}
+
+func (e *ParamVoteExecutor) Execute(trx *tx.Tx) error {
+	pld := trx.Payload().(*payload.ParamVotePayload)
+
+	val := e.sandbox.Validator(pld.Voter)
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
+	}
+	if val.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
+	}
+	if val.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has unbonded")
+	}
+	if trx.Fee() != 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: 0, got: %v", trx.Fee())
+	}
+
+	proposal := e.sandbox.Proposal(pld.Proposal)
+	if proposal == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve proposal")
+	}
+	if e.sandbox.CurrentHeight() >= proposal.ActivationHeight() {
+		return errors.Errorf(errors.ErrInvalidTx, "Voting is closed")
+	}
+	if proposal.HasVoted(pld.Voter) {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has voted before")
+	}
+
+	proposal.AddVote(pld.Voter)
+	val.IncSequence()
+
+	e.sandbox.UpdateProposal(proposal)
+	e.sandbox.UpdateValidator(val)
+
+	return nil
+}
+
+func (e *ParamVoteExecutor) Fee() int64 {
+	return 0
+}
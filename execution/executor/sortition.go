@@ -22,6 +22,12 @@ func (e *SortitionExecutor) Execute(trx *tx.Tx) error {
 	if val == nil {
 		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
 	}
+	if val.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has unbonded at height %v", val.UnbondingHeight())
+	}
+	if val.IsJailed(e.sandbox.CurrentHeight(), e.sandbox.Params().JailPeriod) {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator is jailed at height %v", val.JailedHeight())
+	}
 	if trx.Fee() != 0 {
 		return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: 0, got: %v", trx.Fee())
 	}
@@ -0,0 +1,48 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type UnbondExecutor struct {
+	sandbox sandbox.Sandbox
+}
+
+func NewUnbondExecutor(sandbox sandbox.Sandbox) *UnbondExecutor {
+	return &UnbondExecutor{sandbox}
+}
+
+func (e *UnbondExecutor) Execute(trx *tx.Tx) error {
+	pld := trx.Payload().(*payload.UnbondPayload)
+
+	val := e.sandbox.Validator(pld.Validator)
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
+	}
+	if val.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
+	}
+	if val.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has unbonded at height %v", val.UnbondingHeight())
+	}
+	if trx.Fee() != 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: 0, got: %v", trx.Fee())
+	}
+	if err := e.sandbox.LeaveSet(val.Address()); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+
+	val.IncSequence()
+	val.UpdateUnbondingHeight(e.sandbox.CurrentHeight())
+
+	e.sandbox.UpdateValidator(val)
+
+	return nil
+}
+
+func (e *UnbondExecutor) Fee() int64 {
+	return 0
+}
@@ -0,0 +1,61 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type WithdrawExecutor struct {
+	sandbox sandbox.Sandbox
+}
+
+func NewWithdrawExecutor(sandbox sandbox.Sandbox) *WithdrawExecutor {
+	return &WithdrawExecutor{sandbox}
+}
+
+func (e *WithdrawExecutor) Execute(trx *tx.Tx) error {
+	pld := trx.Payload().(*payload.WithdrawPayload)
+
+	val := e.sandbox.Validator(pld.From)
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
+	}
+	if val.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
+	}
+	if val.UnbondingHeight() == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator should unbond first")
+	}
+	if e.sandbox.CurrentHeight()-val.UnbondingHeight() < e.sandbox.WiredrawStakeInterval() {
+		return errors.Errorf(errors.ErrInvalidTx, "Stake is locked until height %v", val.UnbondingHeight()+e.sandbox.WiredrawStakeInterval())
+	}
+	if val.Stake() == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has no stake to withdraw")
+	}
+	if val.Stake() != pld.Amount {
+		return errors.Errorf(errors.ErrInvalidTx, "Amount is wrong. Expected: %v, got: %v", val.Stake(), pld.Amount)
+	}
+	if trx.Fee() != 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Fee is wrong. expected: 0, got: %v", trx.Fee())
+	}
+
+	acc := e.sandbox.Account(pld.To)
+	if acc == nil {
+		acc = e.sandbox.MakeNewAccount(pld.To)
+	}
+
+	val.IncSequence()
+	val.WithdrawStake()
+	acc.AddToBalance(pld.Amount)
+
+	e.sandbox.UpdateValidator(val)
+	e.sandbox.UpdateAccount(acc)
+
+	return nil
+}
+
+func (e *WithdrawExecutor) Fee() int64 {
+	return 0
+}
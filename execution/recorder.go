@@ -0,0 +1,67 @@
+package execution
+
+import (
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+// recorder wraps the sandbox and keeps track of the balance and stake changes.
+// Changes are kept in the order that addresses are touched, so they are same on all nodes.
+type recorder struct {
+	sandbox.Sandbox
+	changes []tx.BalanceChange
+}
+
+func newRecorder(sb sandbox.Sandbox) *recorder {
+	return &recorder{Sandbox: sb}
+}
+
+func (r *recorder) reset() {
+	r.changes = nil
+}
+
+func (r *recorder) record(addr crypto.Address, balance, stake int64) {
+	if balance == 0 && stake == 0 {
+		return
+	}
+	for i, c := range r.changes {
+		if c.Address.EqualsTo(addr) {
+			r.changes[i].Balance += balance
+			r.changes[i].Stake += stake
+			return
+		}
+	}
+	r.changes = append(r.changes, tx.BalanceChange{Address: addr, Balance: balance, Stake: stake})
+}
+
+// balanceChanges returns the recorded changes, without the ones that are neutralized
+func (r *recorder) balanceChanges() []tx.BalanceChange {
+	changes := make([]tx.BalanceChange, 0, len(r.changes))
+	for _, c := range r.changes {
+		if c.Balance != 0 || c.Stake != 0 {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+func (r *recorder) UpdateAccount(acc *account.Account) {
+	before := int64(0)
+	if old := r.Sandbox.Account(acc.Address()); old != nil {
+		before = old.Balance()
+	}
+	r.record(acc.Address(), acc.Balance()-before, 0)
+	r.Sandbox.UpdateAccount(acc)
+}
+
+func (r *recorder) UpdateValidator(val *validator.Validator) {
+	before := int64(0)
+	if old := r.Sandbox.Validator(val.Address()); old != nil {
+		before = old.Stake()
+	}
+	r.record(val.Address(), 0, val.Stake()-before)
+	r.Sandbox.UpdateValidator(val)
+}
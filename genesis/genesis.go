@@ -29,11 +29,12 @@ type Genesis struct {
 }
 
 type genesisData struct {
-	ChainName   string         `cbor:"1,keyasint"`
-	GenesisTime time.Time      `cbor:"2,keyasint"`
-	Params      param.Params   `cbor:"3,keyasint"`
-	Accounts    []genAccount   `cbor:"4,keyasint"`
-	Validators  []genValidator `cbor:"5,keyasint"`
+	ChainName   string                `cbor:"1,keyasint"`
+	GenesisTime time.Time             `cbor:"2,keyasint"`
+	Params      param.Params          `cbor:"3,keyasint"`
+	Accounts    []genAccount          `cbor:"4,keyasint"`
+	Validators  []genValidator        `cbor:"5,keyasint"`
+	Upgrades    param.UpgradeSchedule `cbor:"6,keyasint,omitempty" json:",omitempty"`
 }
 
 func (gen *Genesis) Hash() crypto.Hash {
@@ -56,6 +57,15 @@ func (gen *Genesis) Params() param.Params {
 	return gen.data.Params
 }
 
+func (gen *Genesis) Upgrades() param.UpgradeSchedule {
+	return gen.data.Upgrades
+}
+
+// SetUpgrades sets the scheduled upgrades. It changes the genesis hash.
+func (gen *Genesis) SetUpgrades(upgrades param.UpgradeSchedule) {
+	gen.data.Upgrades = upgrades
+}
+
 func (gen *Genesis) Accounts() []*account.Account {
 	accs := make([]*account.Account, 0)
 	for i, genAcc := range gen.data.Accounts {
@@ -138,6 +148,9 @@ func LoadFromFile(file string) (*Genesis, error) {
 	if err := json.Unmarshal(dat, &gen); err != nil {
 		return nil, err
 	}
+	if err := gen.data.Params.SanityCheck(); err != nil {
+		return nil, err
+	}
 	return &gen, nil
 }
 
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/util"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
@@ -41,3 +43,37 @@ func TestGenesisTestNet(t *testing.T) {
 	expected, _ := crypto.HashFromString("2dc57c69f70d74e0d1c5dba7b30dcf0903402c37e523efee3b910bdca73a2234")
 	assert.Equal(t, g.Hash(), expected)
 }
+
+func TestGenesisUpgrades(t *testing.T) {
+	acc, _ := account.GenerateTestAccount(0)
+	val, _ := validator.GenerateTestValidator(0)
+	gen1 := MakeGenesis("test", time.Now().Truncate(0), []*account.Account{acc}, []*validator.Validator{val}, 5)
+	h1 := gen1.Hash()
+	assert.Empty(t, gen1.Upgrades())
+
+	gen1.SetUpgrades(param.UpgradeSchedule{{Height: 1000, Version: 2}})
+	assert.NotEqual(t, gen1.Hash(), h1)
+
+	bz, err := json.Marshal(gen1)
+	require.NoError(t, err)
+	gen2 := new(Genesis)
+	require.NoError(t, json.Unmarshal(bz, gen2))
+	assert.Equal(t, gen2.Upgrades(), gen1.Upgrades())
+	assert.Equal(t, gen2.Hash(), gen1.Hash())
+}
+
+func TestLoadInvalidParams(t *testing.T) {
+	acc, _ := account.GenerateTestAccount(0)
+	val, _ := validator.GenerateTestValidator(0)
+	gen := MakeGenesis("test", time.Now().Truncate(0), []*account.Account{acc}, []*validator.Validator{val}, 5)
+	path := util.TempFilePath()
+	require.NoError(t, gen.SaveToFile(path))
+	_, err := LoadFromFile(path)
+	assert.NoError(t, err)
+
+	// Fund address is not set
+	gen.data.Params.FundRewardPercentage = 10
+	require.NoError(t, gen.SaveToFile(path))
+	_, err = LoadFromFile(path)
+	assert.Error(t, err)
+}
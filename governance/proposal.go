@@ -0,0 +1,120 @@
+package governance
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+// MaximumOpenProposals is the maximum number of proposals of each proposer that are not activated yet.
+// Proposals pay no fee, this limits the number of proposals that are checked at each height.
+const MaximumOpenProposals = 2
+
+// Proposal is a request to change the chain parameters at the activation height.
+// Validators vote for the proposal and it passes if more than 2/3 of the validator set
+// has voted for it at the activation height.
+type Proposal struct {
+	data proposalData
+}
+
+type proposalData struct {
+	ID               crypto.Hash      `cbor:"1,keyasint"` // Id of the proposal transaction
+	Number           int              `cbor:"2,keyasint"`
+	Proposer         crypto.Address   `cbor:"3,keyasint"`
+	Params           param.Params     `cbor:"4,keyasint"`
+	ActivationHeight int              `cbor:"5,keyasint"`
+	Voters           []crypto.Address `cbor:"6,keyasint"`
+	Passed           bool             `cbor:"7,keyasint,omitempty"`
+}
+
+func NewProposal(id crypto.Hash, number int) *Proposal {
+	return &Proposal{
+		data: proposalData{
+			ID:     id,
+			Number: number,
+		},
+	}
+}
+
+func (p Proposal) ID() crypto.Hash          { return p.data.ID }
+func (p Proposal) Number() int              { return p.data.Number }
+func (p Proposal) Proposer() crypto.Address { return p.data.Proposer }
+func (p Proposal) Params() param.Params     { return p.data.Params }
+func (p Proposal) ActivationHeight() int    { return p.data.ActivationHeight }
+func (p Proposal) Voters() []crypto.Address { return p.data.Voters }
+func (p Proposal) IsPassed() bool           { return p.data.Passed }
+
+func (p *Proposal) Propose(proposer crypto.Address, params param.Params, activationHeight int) {
+	p.data.Proposer = proposer
+	p.data.Params = params
+	p.data.ActivationHeight = activationHeight
+}
+
+// Pass marks the proposal as passed, when its parameters are activated.
+// Passed proposals are committed in the state hash, so the history of the parameters can be rebuilt from them.
+func (p *Proposal) Pass() {
+	p.data.Passed = true
+}
+
+func (p *Proposal) HasVoted(addr crypto.Address) bool {
+	for _, v := range p.data.Voters {
+		if v.EqualsTo(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddVote adds the voter to the list of voters.
+// The list is copied, so the proposals that are returned by the sandbox don't share it.
+func (p *Proposal) AddVote(addr crypto.Address) {
+	voters := make([]crypto.Address, len(p.data.Voters), len(p.data.Voters)+1)
+	copy(voters, p.data.Voters)
+	p.data.Voters = append(voters, addr)
+}
+
+func (p *Proposal) Hash() crypto.Hash {
+	bs, err := p.Encode()
+	if err != nil {
+		panic(err)
+	}
+	return crypto.HashH(bs)
+}
+
+func (p *Proposal) Encode() ([]byte, error) {
+	return cbor.Marshal(p.data)
+}
+
+func (p *Proposal) Decode(bs []byte) error {
+	return cbor.Unmarshal(bs, &p.data)
+}
+
+func (p Proposal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.data)
+}
+
+func (p *Proposal) UnmarshalJSON(bs []byte) error {
+	return json.Unmarshal(bs, &p.data)
+}
+
+func (p Proposal) Fingerprint() string {
+	return fmt.Sprintf("{⌘ %v %v ⏳ %v 🗳 %v}",
+		p.ID().Fingerprint(),
+		p.Proposer().Fingerprint(),
+		p.ActivationHeight(),
+		len(p.Voters()))
+}
+
+// GenerateTestProposal generates a proposal for testing purpose
+func GenerateTestProposal(number int) *Proposal {
+	a, _, _ := crypto.GenerateTestKeyPair()
+	p := NewProposal(crypto.GenerateTestHash(), number)
+	params := param.MainnetParams()
+	params.MinimumFee = util.RandInt64(10000)
+	p.Propose(a, params, util.RandInt(1000)+1)
+	return p
+}
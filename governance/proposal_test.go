@@ -0,0 +1,44 @@
+package governance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+func TestMarshaling(t *testing.T) {
+	p1 := GenerateTestProposal(1)
+	voter, _, _ := crypto.GenerateTestKeyPair()
+	p1.AddVote(voter)
+
+	bs, err := p1.Encode()
+	require.NoError(t, err)
+	p2 := new(Proposal)
+	require.NoError(t, p2.Decode(bs))
+	assert.Equal(t, p1, p2)
+	assert.Equal(t, p1.Hash(), p2.Hash())
+
+	js, err := json.Marshal(p1)
+	require.NoError(t, err)
+	p3 := new(Proposal)
+	require.NoError(t, json.Unmarshal(js, p3))
+	assert.Equal(t, p1, p3)
+}
+
+func TestVoting(t *testing.T) {
+	p1 := GenerateTestProposal(1)
+	voter1, _, _ := crypto.GenerateTestKeyPair()
+	voter2, _, _ := crypto.GenerateTestKeyPair()
+
+	p1.AddVote(voter1)
+	p2 := *p1
+	p2.AddVote(voter2)
+
+	assert.True(t, p1.HasVoted(voter1))
+	assert.False(t, p1.HasVoted(voter2))
+	assert.True(t, p2.HasVoted(voter2))
+	assert.NotEqual(t, p1.Hash(), p2.Hash())
+}
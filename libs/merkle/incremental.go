@@ -0,0 +1,90 @@
+package simpleMerkle
+
+import (
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+// NodeStore keeps the nodes of an incremental merkle tree
+type NodeStore interface {
+	Node(level, index int) (crypto.Hash, error)
+	SetNode(level, index int, hash crypto.Hash) error
+}
+
+// IncrementalTree is a merkle tree that updates only the path of the changed leaf.
+// Its root is same as the root of SimpleMerkleTree with the same leaves.
+type IncrementalTree struct {
+	nodes NodeStore
+	size  int
+}
+
+func NewIncrementalTree(nodes NodeStore, size int) *IncrementalTree {
+	return &IncrementalTree{
+		nodes: nodes,
+		size:  size,
+	}
+}
+
+// Size returns the number of leaves
+func (tree *IncrementalTree) Size() int {
+	return tree.size
+}
+
+// SetLeaf sets or appends the leaf at the given index and updates its path to the root.
+// If the index is beyond the size, the gap is filled with undefined hashes.
+func (tree *IncrementalTree) SetLeaf(index int, hash crypto.Hash) error {
+	for tree.size < index {
+		if err := tree.setLeaf(tree.size, crypto.UndefHash); err != nil {
+			return err
+		}
+	}
+	return tree.setLeaf(index, hash)
+}
+
+func (tree *IncrementalTree) setLeaf(index int, hash crypto.Hash) error {
+	if index >= tree.size {
+		tree.size = index + 1
+	}
+	if err := tree.nodes.SetNode(0, index, hash); err != nil {
+		return err
+	}
+
+	level := 0
+	for tree.levelSize(level) > 1 {
+		parent := index / 2
+		left, err := tree.nodes.Node(level, parent*2)
+		if err != nil {
+			return err
+		}
+		right := left
+		if parent*2+1 < tree.levelSize(level) {
+			right, err = tree.nodes.Node(level, parent*2+1)
+			if err != nil {
+				return err
+			}
+		}
+		if err := tree.nodes.SetNode(level+1, parent, *HashMerkleBranches(&left, &right)); err != nil {
+			return err
+		}
+		level++
+		index = parent
+	}
+	return nil
+}
+
+// Root returns the root of the tree, or an undefined hash if the tree is empty
+func (tree *IncrementalTree) Root() (crypto.Hash, error) {
+	if tree.size == 0 {
+		return crypto.UndefHash, nil
+	}
+	level := 0
+	for tree.levelSize(level) > 1 {
+		level++
+	}
+	return tree.nodes.Node(level, 0)
+}
+
+// levelSize returns the number of the nodes at the given level
+func (tree *IncrementalTree) levelSize(level int) int {
+	width := 1 << uint(level)
+	return (tree.size + width - 1) / width
+}
@@ -0,0 +1,82 @@
+package simpleMerkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+type memoryNodes map[string]crypto.Hash
+
+func (m memoryNodes) Node(level, index int) (crypto.Hash, error) {
+	h, ok := m[fmt.Sprintf("%d:%d", level, index)]
+	if !ok {
+		return crypto.UndefHash, fmt.Errorf("node not found")
+	}
+	return h, nil
+}
+
+func (m memoryNodes) SetNode(level, index int, hash crypto.Hash) error {
+	m[fmt.Sprintf("%d:%d", level, index)] = hash
+	return nil
+}
+
+func TestIncrementalTree(t *testing.T) {
+	tree := NewIncrementalTree(memoryNodes{}, 0)
+	root, err := tree.Root()
+	require.NoError(t, err)
+	assert.Equal(t, root, NewTreeFromHashes(nil).Root())
+
+	hashes := []crypto.Hash{}
+	for i := 0; i < 33; i++ {
+		h := crypto.GenerateTestHash()
+		hashes = append(hashes, h)
+		require.NoError(t, tree.SetLeaf(i, h))
+
+		root, err := tree.Root()
+		require.NoError(t, err)
+		assert.Equal(t, root, NewTreeFromHashes(hashes).Root(), "size: %v", i+1)
+	}
+
+	for _, i := range []int{0, 7, 16, 31, 32} {
+		h := crypto.GenerateTestHash()
+		hashes[i] = h
+		require.NoError(t, tree.SetLeaf(i, h))
+
+		root, err := tree.Root()
+		require.NoError(t, err)
+		assert.Equal(t, root, NewTreeFromHashes(hashes).Root(), "index: %v", i)
+	}
+	assert.Equal(t, tree.Size(), 33)
+}
+
+func TestIncrementalTreeGap(t *testing.T) {
+	tree := NewIncrementalTree(memoryNodes{}, 0)
+	h1 := crypto.GenerateTestHash()
+	h2 := crypto.GenerateTestHash()
+	require.NoError(t, tree.SetLeaf(4, h2))
+	require.NoError(t, tree.SetLeaf(1, h1))
+
+	root, err := tree.Root()
+	require.NoError(t, err)
+	hashes := []crypto.Hash{crypto.UndefHash, h1, crypto.UndefHash, crypto.UndefHash, h2}
+	assert.Equal(t, root, NewTreeFromHashes(hashes).Root())
+	assert.Equal(t, tree.Size(), 5)
+}
+
+func TestIncrementalTreeReload(t *testing.T) {
+	nodes := memoryNodes{}
+	tree1 := NewIncrementalTree(nodes, 0)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, tree1.SetLeaf(i, crypto.GenerateTestHash()))
+	}
+
+	tree2 := NewIncrementalTree(nodes, tree1.Size())
+	require.NoError(t, tree2.SetLeaf(3, crypto.GenerateTestHash()))
+	root1, _ := tree1.Root()
+	root2, _ := tree2.Root()
+	assert.Equal(t, root1, root2)
+}
@@ -0,0 +1,98 @@
+package simpleMerkle
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+// maxProofDepth limits the number of siblings, so the index bits don't overflow
+const maxProofDepth = 62
+
+// Proof is a merkle inclusion proof.
+// Siblings are the hashes on the path from the leaf to the root, and each bit of
+// the index, starting from the lowest one, is set if the path node is the right child.
+type Proof struct {
+	Index    int           `cbor:"1,keyasint"`
+	Siblings []crypto.Hash `cbor:"2,keyasint"`
+}
+
+// AddSibling extends the proof by one level, where the current root is combined with the sibling.
+// It is used to prove a leaf of a sub-tree against the root of the bigger tree.
+func (p *Proof) AddSibling(sibling crypto.Hash, isLeft bool) {
+	if isLeft {
+		p.Index |= 1 << uint(len(p.Siblings))
+	}
+	p.Siblings = append(p.Siblings, sibling)
+}
+
+// Root calculates the root of the tree from the leaf hash
+func (p *Proof) Root(leaf crypto.Hash) crypto.Hash {
+	node := &leaf
+	for i := range p.Siblings {
+		if p.Index&(1<<uint(i)) == 0 {
+			node = HashMerkleBranches(node, &p.Siblings[i])
+		} else {
+			node = HashMerkleBranches(&p.Siblings[i], node)
+		}
+	}
+	return *node
+}
+
+// Verify checks whether the leaf is included in the tree with the given root
+func (p *Proof) Verify(leaf, root crypto.Hash) bool {
+	if len(p.Siblings) > maxProofDepth {
+		return false
+	}
+	if p.Index < 0 || p.Index >= 1<<uint(len(p.Siblings)) {
+		return false
+	}
+	return p.Root(leaf).EqualsTo(root)
+}
+
+// Proof returns the inclusion proof of the leaf at the given index, or nil if index is out of range
+func (tree *SimpleMerkleTree) Proof(index int) *Proof {
+	if tree == nil {
+		return nil
+	}
+	width := (len(tree.merkles) + 1) / 2
+	if index < 0 || index >= width || tree.merkles[index] == nil {
+		return nil
+	}
+	proof := &Proof{Index: index}
+	offset := 0
+	for width > 1 {
+		sibling := tree.merkles[offset+(index^1)]
+		if sibling == nil {
+			// No right child, the node is hashed with itself
+			sibling = tree.merkles[offset+index]
+		}
+		proof.Siblings = append(proof.Siblings, *sibling)
+		offset += width
+		width /= 2
+		index /= 2
+	}
+	return proof
+}
+
+// Proof returns the inclusion proof of the leaf at the given index
+func (tree *IncrementalTree) Proof(index int) (*Proof, error) {
+	if index < 0 || index >= tree.size {
+		return nil, fmt.Errorf("index %d is out of range", index)
+	}
+	proof := &Proof{Index: index}
+	for level := 0; tree.levelSize(level) > 1; level++ {
+		siblingIndex := index ^ 1
+		if siblingIndex >= tree.levelSize(level) {
+			// No right child, the node is hashed with itself
+			siblingIndex = index
+		}
+		sibling, err := tree.nodes.Node(level, siblingIndex)
+		if err != nil {
+			return nil, err
+		}
+		proof.Siblings = append(proof.Siblings, sibling)
+		index /= 2
+	}
+	return proof, nil
+}
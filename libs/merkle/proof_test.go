@@ -0,0 +1,84 @@
+package simpleMerkle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+func TestProof(t *testing.T) {
+	for size := 1; size <= 17; size++ {
+		hashes := []crypto.Hash{}
+		itree := NewIncrementalTree(memoryNodes{}, 0)
+		for i := 0; i < size; i++ {
+			h := crypto.GenerateTestHash()
+			hashes = append(hashes, h)
+			require.NoError(t, itree.SetLeaf(i, h))
+		}
+		tree := NewTreeFromHashes(hashes)
+		root := tree.Root()
+
+		for i, h := range hashes {
+			proof := tree.Proof(i)
+			require.NotNil(t, proof)
+			assert.True(t, proof.Verify(h, root), "size: %d, index: %d", size, i)
+			assert.False(t, proof.Verify(crypto.GenerateTestHash(), root))
+
+			iproof, err := itree.Proof(i)
+			require.NoError(t, err)
+			assert.Equal(t, proof, iproof)
+		}
+
+		assert.Nil(t, tree.Proof(-1))
+		assert.Nil(t, tree.Proof(size))
+		_, err := itree.Proof(size)
+		assert.Error(t, err)
+	}
+}
+
+func TestInvalidProof(t *testing.T) {
+	hashes := []crypto.Hash{strToHash("a"), strToHash("b"), strToHash("c")}
+	tree := NewTreeFromHashes(hashes)
+	root := tree.Root()
+
+	t.Run("Invalid index", func(t *testing.T) {
+		proof := tree.Proof(0)
+		proof.Index = 1
+		assert.False(t, proof.Verify(hashes[0], root))
+		proof.Index = 4
+		assert.False(t, proof.Verify(hashes[0], root))
+		proof.Index = -1
+		assert.False(t, proof.Verify(hashes[0], root))
+	})
+
+	t.Run("Invalid sibling", func(t *testing.T) {
+		proof := tree.Proof(2)
+		proof.Siblings[1] = crypto.GenerateTestHash()
+		assert.False(t, proof.Verify(hashes[2], root))
+	})
+
+	t.Run("Missing sibling", func(t *testing.T) {
+		proof := tree.Proof(1)
+		proof.Siblings = proof.Siblings[:1]
+		assert.False(t, proof.Verify(hashes[1], root))
+	})
+}
+
+func TestAddSibling(t *testing.T) {
+	left := NewTreeFromHashes([]crypto.Hash{strToHash("a"), strToHash("b"), strToHash("c")})
+	right := NewTreeFromHashes([]crypto.Hash{strToHash("d"), strToHash("e")})
+	leftRoot := left.Root()
+	rightRoot := right.Root()
+	root := *HashMerkleBranches(&leftRoot, &rightRoot)
+
+	proof := left.Proof(2)
+	proof.AddSibling(rightRoot, false)
+	assert.True(t, proof.Verify(strToHash("c"), root))
+
+	proof = right.Proof(1)
+	proof.AddSibling(leftRoot, true)
+	assert.True(t, proof.Verify(strToHash("e"), root))
+	assert.False(t, proof.Verify(strToHash("d"), root))
+}
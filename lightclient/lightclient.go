@@ -0,0 +1,245 @@
+package lightclient
+
+import (
+	"github.com/sasha-s/go-deadlock"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/genesis"
+	"github.com/zarbchain/zarb-go/validator"
+	"github.com/zarbchain/zarb-go/vote"
+)
+
+// headersToKeep is the number of recent verified headers that are kept in memory
+const headersToKeep = 1024
+
+// Checkpoint is a trusted point to start following the chain.
+// Validators are the committers of the block at the checkpoint height,
+// Proposer is the proposer of the first round at that height and
+// Round is the round that the block is committed in.
+type Checkpoint struct {
+	Height       int
+	BlockHash    crypto.Hash
+	Round        int
+	Validators   []*validator.Validator
+	Proposer     crypto.Address
+	MaximumPower int
+}
+
+// Update contains a block header and its commit.
+// Joined and Left are the changes of the validator set made by executing the previous block.
+type Update struct {
+	Header block.Header
+	Commit block.Commit
+	Joined []*validator.Validator
+	Left   []crypto.Address
+}
+
+// LightClient follows the block headers without executing the blocks.
+// Each header is verified against the aggregated signature of its commit,
+// signed by the tracked validator set.
+type LightClient struct {
+	lk deadlock.RWMutex
+
+	height        int
+	lastBlockHash crypto.Hash
+	lastRound     int                     // round of the last verified commit
+	validatorSet  *validator.ValidatorSet // committers of the last verified header
+	headers       map[int]block.Header
+}
+
+// NewLightClient creates a light client that starts from the given trusted checkpoint
+func NewLightClient(checkpoint Checkpoint) (*LightClient, error) {
+	if checkpoint.Height < 0 {
+		return nil, errors.Errorf(errors.ErrGeneric, "Invalid checkpoint height")
+	}
+	if checkpoint.Round < 0 {
+		return nil, errors.Errorf(errors.ErrGeneric, "Invalid checkpoint round")
+	}
+	valSet, err := validator.NewValidatorSet(checkpoint.Validators, checkpoint.MaximumPower, checkpoint.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	return &LightClient{
+		height:        checkpoint.Height,
+		lastBlockHash: checkpoint.BlockHash,
+		lastRound:     checkpoint.Round,
+		validatorSet:  valSet,
+		headers:       make(map[int]block.Header),
+	}, nil
+}
+
+// NewLightClientFromGenesis creates a light client that starts from the genesis
+func NewLightClientFromGenesis(genDoc *genesis.Genesis) (*LightClient, error) {
+	vals := genDoc.Validators()
+	if len(vals) == 0 {
+		return nil, errors.Errorf(errors.ErrGeneric, "No validator in genesis")
+	}
+	// There is no block at height zero. The proposer is chosen in a way that
+	// moving to the first height makes the first genesis validator the proposer.
+	return NewLightClient(Checkpoint{
+		Height:       0,
+		BlockHash:    crypto.UndefHash,
+		Validators:   vals,
+		Proposer:     vals[len(vals)-1].Address(),
+		MaximumPower: genDoc.Params().MaximumPower,
+	})
+}
+
+// LastHeight returns the height of the last verified header
+func (lc *LightClient) LastHeight() int {
+	lc.lk.RLock()
+	defer lc.lk.RUnlock()
+
+	return lc.height
+}
+
+// LastBlockHash returns the hash of the last verified header
+func (lc *LightClient) LastBlockHash() crypto.Hash {
+	lc.lk.RLock()
+	defer lc.lk.RUnlock()
+
+	return lc.lastBlockHash
+}
+
+// ValidatorSet returns the committers of the last verified header
+func (lc *LightClient) ValidatorSet() validator.ValidatorSetReader {
+	lc.lk.RLock()
+	defer lc.lk.RUnlock()
+
+	return lc.validatorSet
+}
+
+// Header returns the verified header at the given height.
+// Only the recent headers are kept.
+func (lc *LightClient) Header(height int) (*block.Header, error) {
+	lc.lk.RLock()
+	defer lc.lk.RUnlock()
+
+	h, ok := lc.headers[height]
+	if !ok {
+		return nil, errors.Errorf(errors.ErrGeneric, "No verified header at height %v", height)
+	}
+	return &h, nil
+}
+
+// Checkpoint returns the current state of the light client, it can be used to resume following the chain later
+func (lc *LightClient) Checkpoint() Checkpoint {
+	lc.lk.RLock()
+	defer lc.lk.RUnlock()
+
+	return Checkpoint{
+		Height:       lc.height,
+		BlockHash:    lc.lastBlockHash,
+		Round:        lc.lastRound,
+		Validators:   validators(lc.validatorSet),
+		Proposer:     lc.validatorSet.Proposer(0).Address(),
+		MaximumPower: lc.validatorSet.MaximumPower(),
+	}
+}
+
+// Update verifies the header of the next height and moves the light client forward
+func (lc *LightClient) Update(update Update) error {
+	lc.lk.Lock()
+	defer lc.lk.Unlock()
+
+	valSet, err := lc.nextValidatorSet(update.Joined, update.Left)
+	if err != nil {
+		return err
+	}
+	if err := verifyHeader(lc.lastBlockHash, valSet, update.Header, update.Commit); err != nil {
+		return err
+	}
+
+	lc.height++
+	lc.lastBlockHash = update.Header.Hash()
+	lc.lastRound = update.Commit.Round()
+	lc.validatorSet = valSet
+	lc.headers[lc.height] = update.Header
+	delete(lc.headers, lc.height-headersToKeep)
+
+	return nil
+}
+
+// nextValidatorSet applies the changes on a copy of the validator set, the same way the state does after committing a block.
+// The proposer moves based on the round of the last commit.
+// The current set is not touched until the next header is verified.
+func (lc *LightClient) nextValidatorSet(joined []*validator.Validator, left []crypto.Address) (*validator.ValidatorSet, error) {
+	valSet, err := validator.NewValidatorSet(validators(lc.validatorSet),
+		lc.validatorSet.MaximumPower(), lc.validatorSet.Proposer(0).Address())
+	if err != nil {
+		return nil, err
+	}
+
+	leftVals := make([]*validator.Validator, len(left))
+	for i, addr := range left {
+		val := valSet.Validator(addr)
+		if val == nil {
+			return nil, errors.Errorf(errors.ErrInvalidBlock, "Validator %v is not in the set", addr)
+		}
+		leftVals[i] = val
+	}
+	if err := valSet.MoveToNextHeight(lc.lastRound, joined, leftVals); err != nil {
+		return nil, err
+	}
+	return valSet, nil
+}
+
+func verifyHeader(lastBlockHash crypto.Hash, valSet *validator.ValidatorSet, header block.Header, commit block.Commit) error {
+	if err := header.SanityCheck(); err != nil {
+		return err
+	}
+	if err := commit.SanityCheck(); err != nil {
+		return err
+	}
+
+	if !header.LastBlockHash().EqualsTo(lastBlockHash) {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"Last block hash is not same as we expected. Expected %v, got %v", lastBlockHash, header.LastBlockHash())
+	}
+
+	if !header.CommittersHash().EqualsTo(valSet.CommittersHash()) {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"Committers hash is not same as we expected. Expected %v, got %v", valSet.CommittersHash(), header.CommittersHash())
+	}
+
+	proposer := valSet.Proposer(commit.Round())
+	if !header.ProposerAddress().EqualsTo(proposer.Address()) {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"Proposer is not same as we expected. Expected %v, got %v", proposer.Address(), header.ProposerAddress())
+	}
+
+	if !commit.CommittersHash().EqualsTo(header.CommittersHash()) {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"Committers are not same as we expected. Expected %v, got %v", header.CommittersHash(), commit.CommittersHash())
+	}
+
+	signBytes := vote.CommitSignBytes(header.Hash(), commit.Round())
+	pubs := make([]crypto.PublicKey, 0)
+	for _, c := range commit.Committers() {
+		if c.HasSigned() {
+			val := valSet.Validator(c.Address)
+			if val == nil {
+				return errors.Errorf(errors.ErrInvalidBlock,
+					"invalid committer: %x", c.Address)
+			}
+			pubs = append(pubs, val.PublicKey())
+		}
+	}
+
+	if !crypto.VerifyAggregated(commit.Signature(), pubs, signBytes) {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"invalid commit signature: %v", commit.Signature())
+	}
+
+	return nil
+}
+
+func validators(valSet *validator.ValidatorSet) []*validator.Validator {
+	addrs := valSet.Validators()
+	vals := make([]*validator.Validator, len(addrs))
+	for i, addr := range addrs {
+		vals[i] = valSet.Validator(addr)
+	}
+	return vals
+}
@@ -0,0 +1,251 @@
+package lightclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/genesis"
+	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/state"
+	"github.com/zarbchain/zarb-go/txpool"
+	"github.com/zarbchain/zarb-go/util"
+	"github.com/zarbchain/zarb-go/validator"
+	"github.com/zarbchain/zarb-go/vote"
+)
+
+// testChain mirrors the validator set of a chain and signs its headers
+type testChain struct {
+	t             *testing.T
+	valSet        *validator.ValidatorSet
+	keys          map[crypto.Address]crypto.PrivateKey
+	lastBlockHash crypto.Hash
+	lastRound     int
+}
+
+func setup(t *testing.T) (*testChain, *genesis.Genesis) {
+	vals := make([]*validator.Validator, 4)
+	keys := make(map[crypto.Address]crypto.PrivateKey)
+	for i := range vals {
+		val, priv := validator.GenerateTestValidator(i)
+		vals[i] = val
+		keys[val.Address()] = priv
+	}
+	genDoc := genesis.MakeGenesis("test", util.Now(), []*account.Account{}, vals, 10)
+	valSet, err := validator.NewValidatorSet(vals, genDoc.Params().MaximumPower, vals[0].Address())
+	require.NoError(t, err)
+
+	return &testChain{
+		t:             t,
+		valSet:        valSet,
+		keys:          keys,
+		lastBlockHash: crypto.UndefHash,
+	}, genDoc
+}
+
+func signCommit(blockHash crypto.Hash, round int, signers []crypto.Address, keys map[crypto.Address]crypto.PrivateKey, committers []crypto.Address) block.Commit {
+	signBytes := vote.CommitSignBytes(blockHash, round)
+	sigs := make([]*crypto.Signature, 0)
+	cs := make([]block.Committer, len(committers))
+	for i, addr := range committers {
+		cs[i] = block.Committer{Address: addr, Status: block.CommitNotSigned}
+		for _, s := range signers {
+			if s.EqualsTo(addr) {
+				cs[i].Status = block.CommitSigned
+				key := keys[addr]
+				sigs = append(sigs, key.Sign(signBytes))
+			}
+		}
+	}
+	return *block.NewCommit(round, cs, crypto.Aggregate(sigs))
+}
+
+// nextUpdate makes the next signed header, committed in the first round.
+// The joined and left validators are the changes made by the previous block.
+func (tc *testChain) nextUpdate(joined []*validator.Validator, left []crypto.Address) Update {
+	return tc.nextUpdateAtRound(0, joined, left)
+}
+
+// nextUpdateAtRound makes the next signed header, committed in the given round
+func (tc *testChain) nextUpdateAtRound(round int, joined []*validator.Validator, left []crypto.Address) Update {
+	if !tc.lastBlockHash.IsUndef() {
+		leftVals := make([]*validator.Validator, len(left))
+		for i, addr := range left {
+			leftVals[i] = tc.valSet.Validator(addr)
+		}
+		require.NoError(tc.t, tc.valSet.MoveToNextHeight(tc.lastRound, joined, leftVals))
+	}
+
+	lastCommitHash := crypto.UndefHash
+	lastReceiptsHash := crypto.UndefHash
+	if !tc.lastBlockHash.IsUndef() {
+		lastCommitHash = crypto.GenerateTestHash()
+		lastReceiptsHash = crypto.GenerateTestHash()
+	}
+	header := block.NewHeader(1, time.Now(), crypto.GenerateTestHash(), tc.lastBlockHash,
+		tc.valSet.CommittersHash(), crypto.GenerateTestHash(), lastReceiptsHash, lastCommitHash,
+		tc.valSet.Proposer(round).Address())
+
+	committers := tc.valSet.Validators()
+	commit := signCommit(header.Hash(), round, committers[:len(committers)*2/3+1], tc.keys, committers)
+	tc.lastBlockHash = header.Hash()
+	tc.lastRound = round
+
+	return Update{Header: header, Commit: commit, Joined: joined, Left: left}
+}
+
+func TestFollowChain(t *testing.T) {
+	tc, genDoc := setup(t)
+	lc, err := NewLightClientFromGenesis(genDoc)
+	require.NoError(t, err)
+
+	update := tc.nextUpdate(nil, nil)
+	require.NoError(t, lc.Update(update))
+	require.NoError(t, lc.Update(tc.nextUpdate(nil, nil)))
+	assert.Equal(t, lc.LastHeight(), 2)
+	assert.Equal(t, lc.LastBlockHash(), tc.lastBlockHash)
+
+	h, err := lc.Header(1)
+	require.NoError(t, err)
+	assert.Equal(t, h.Hash(), update.Header.Hash())
+	_, err = lc.Header(3)
+	assert.Error(t, err)
+
+	t.Run("Validator joins the set", func(t *testing.T) {
+		val, priv := validator.GenerateTestValidator(4)
+		tc.keys[val.Address()] = priv
+
+		require.NoError(t, lc.Update(tc.nextUpdate([]*validator.Validator{val}, nil)))
+		assert.True(t, lc.ValidatorSet().Contains(val.Address()))
+		assert.Equal(t, lc.ValidatorSet().Power(), 5)
+	})
+
+	t.Run("Validator leaves the set", func(t *testing.T) {
+		left := tc.valSet.Validators()[0]
+
+		require.NoError(t, lc.Update(tc.nextUpdate(nil, []crypto.Address{left})))
+		assert.False(t, lc.ValidatorSet().Contains(left))
+		assert.Equal(t, lc.ValidatorSet().Power(), 4)
+	})
+
+	t.Run("Block is committed in a later round", func(t *testing.T) {
+		require.NoError(t, lc.Update(tc.nextUpdateAtRound(2, nil, nil)))
+		require.NoError(t, lc.Update(tc.nextUpdateAtRound(1, nil, nil)))
+		assert.Equal(t, lc.ValidatorSet().Proposer(0).Address(), tc.valSet.Proposer(0).Address())
+	})
+
+	t.Run("Resume from checkpoint", func(t *testing.T) {
+		lc2, err := NewLightClient(lc.Checkpoint())
+		require.NoError(t, err)
+
+		update := tc.nextUpdate(nil, nil)
+		require.NoError(t, lc.Update(update))
+		require.NoError(t, lc2.Update(update))
+		assert.Equal(t, lc2.LastHeight(), lc.LastHeight())
+		assert.Equal(t, lc2.LastBlockHash(), lc.LastBlockHash())
+		assert.Equal(t, lc2.ValidatorSet().Proposer(0).Address(), lc.ValidatorSet().Proposer(0).Address())
+	})
+}
+
+func TestInvalidUpdate(t *testing.T) {
+	tc, genDoc := setup(t)
+	lc, err := NewLightClientFromGenesis(genDoc)
+	require.NoError(t, err)
+	require.NoError(t, lc.Update(tc.nextUpdate(nil, nil)))
+
+	lastHash := tc.lastBlockHash
+	update := tc.nextUpdate(nil, nil)
+
+	t.Run("Invalid last block hash", func(t *testing.T) {
+		u := update
+		u.Header = block.NewHeader(1, time.Now(), crypto.GenerateTestHash(), crypto.GenerateTestHash(),
+			update.Header.CommittersHash(), crypto.GenerateTestHash(), crypto.GenerateTestHash(), crypto.GenerateTestHash(),
+			update.Header.ProposerAddress())
+		committers := tc.valSet.Validators()
+		u.Commit = signCommit(u.Header.Hash(), 0, committers, tc.keys, committers)
+		assert.Error(t, lc.Update(u))
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		u := update
+		committers := tc.valSet.Validators()
+		u.Commit = signCommit(lastHash, 0, committers, tc.keys, committers)
+		assert.Error(t, lc.Update(u))
+	})
+
+	t.Run("Unknown committer", func(t *testing.T) {
+		u := update
+		val, priv := validator.GenerateTestValidator(4)
+		keys := map[crypto.Address]crypto.PrivateKey{val.Address(): priv}
+		for k, v := range tc.keys {
+			keys[k] = v
+		}
+		committers := append(tc.valSet.Validators()[1:], val.Address())
+		u.Commit = signCommit(u.Header.Hash(), 0, committers, keys, committers)
+		assert.Error(t, lc.Update(u))
+	})
+
+	t.Run("Not enough signatures", func(t *testing.T) {
+		u := update
+		committers := tc.valSet.Validators()
+		u.Commit = signCommit(u.Header.Hash(), 0, committers[:2], tc.keys, committers)
+		assert.Error(t, lc.Update(u))
+	})
+
+	t.Run("Invalid proposer", func(t *testing.T) {
+		u := update
+		committers := tc.valSet.Validators()
+		u.Commit = signCommit(u.Header.Hash(), 1, committers, tc.keys, committers)
+		assert.Error(t, lc.Update(u))
+	})
+
+	t.Run("Invalid validator set changes", func(t *testing.T) {
+		u := update
+		val, _ := validator.GenerateTestValidator(4)
+		u.Joined = []*validator.Validator{val}
+		assert.Error(t, lc.Update(u))
+
+		u = update
+		addr, _, _ := crypto.GenerateTestKeyPair()
+		u.Left = []crypto.Address{addr}
+		assert.Error(t, lc.Update(u))
+	})
+
+	// The light client is not affected by the invalid updates
+	assert.Equal(t, lc.LastHeight(), 1)
+	require.NoError(t, lc.Update(update))
+	assert.Equal(t, lc.LastHeight(), 2)
+}
+
+func TestFollowState(t *testing.T) {
+	loggerConfig := logger.TestConfig()
+	logger.InitLogger(loggerConfig)
+
+	_, _, priv := crypto.GenerateTestKeyPair()
+	signer := crypto.NewSigner(priv)
+	acc := account.NewAccount(crypto.TreasuryAddress, 0)
+	acc.AddToBalance(21 * 1e14)
+	val := validator.NewValidator(signer.PublicKey(), 0, 0)
+	genDoc := genesis.MakeGenesis("test", util.Now(), []*account.Account{acc}, []*validator.Validator{val}, 1)
+
+	st, err := state.LoadOrNewState(state.TestConfig(), genDoc, signer, txpool.NewMockTxPool())
+	require.NoError(t, err)
+	lc, err := NewLightClientFromGenesis(genDoc)
+	require.NoError(t, err)
+
+	for i := 1; i <= 5; i++ {
+		b := st.ProposeBlock()
+		v := vote.NewPrecommit(i, 0, b.Hash(), signer.Address())
+		c := block.NewCommit(0,
+			[]block.Committer{{Address: signer.Address(), Status: block.CommitSigned}},
+			*signer.Sign(v.SignBytes()))
+		require.NoError(t, st.ApplyBlock(i, b, *c))
+
+		require.NoError(t, lc.Update(Update{Header: *b.Header(), Commit: *c}))
+		assert.Equal(t, lc.LastBlockHash(), st.LastBlockHash())
+	}
+}
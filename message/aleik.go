@@ -12,15 +12,17 @@ type AleykPayload struct {
 	Version     version.Version `cbor:"1,keyasint"`
 	GenesisHash crypto.Hash     `cbor:"2,keyasint"`
 	Height      int             `cbor:"3,keyasint"`
+	Flags       int             `cbor:"4,keyasint,omitempty"`
 }
 
-func NewAleykMessage(genesisHash crypto.Hash, height int) *Message {
+func NewAleykMessage(genesisHash crypto.Hash, height int, flags int) *Message {
 	return &Message{
 		Type: PayloadTypeAleyk,
 		Payload: &AleykPayload{
 			Version:     version.NodeVersion,
 			GenesisHash: genesisHash,
 			Height:      height,
+			Flags:       flags,
 		},
 	}
 
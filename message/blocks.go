@@ -7,10 +7,13 @@ import (
 	"github.com/zarbchain/zarb-go/errors"
 )
 
+// BlocksPayload contains the requested blocks.
+// If the blocks are pruned, it has no block and PrunedHeight is the height that all blocks up to it are pruned.
 type BlocksPayload struct {
-	From       int            `cbor:"1,keyasint"`
-	Blocks     []*block.Block `cbor:"2,keyasint"`
-	LastCommit *block.Commit  `cbor:"3,keyasint, omitempty"`
+	From         int            `cbor:"1,keyasint"`
+	Blocks       []*block.Block `cbor:"2,keyasint"`
+	LastCommit   *block.Commit  `cbor:"3,keyasint, omitempty"`
+	PrunedHeight int            `cbor:"4,keyasint,omitempty"`
 }
 
 func NewBlocksMessage(from int, blocks []*block.Block, lastCommit *block.Commit) *Message {
@@ -24,11 +27,25 @@ func NewBlocksMessage(from int, blocks []*block.Block, lastCommit *block.Commit)
 	}
 
 }
+
+func NewPrunedBlocksMessage(from int, prunedHeight int) *Message {
+	return &Message{
+		Type: PayloadTypeBlocks,
+		Payload: &BlocksPayload{
+			From:         from,
+			PrunedHeight: prunedHeight,
+		},
+	}
+}
+
 func (p *BlocksPayload) SanityCheck() error {
 	if p.From < 0 {
 		return errors.Errorf(errors.ErrInvalidMessage, "Invalid Height")
 	}
-	if len(p.Blocks) == 0 {
+	if p.PrunedHeight < 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "Invalid pruned height")
+	}
+	if len(p.Blocks) == 0 && p.PrunedHeight == 0 {
 		return errors.Errorf(errors.ErrInvalidMessage, "No block")
 	}
 	for _, b := range p.Blocks {
@@ -53,6 +70,9 @@ func (p *BlocksPayload) To() int {
 }
 
 func (p *BlocksPayload) Fingerprint() string {
+	if len(p.Blocks) == 0 {
+		return fmt.Sprintf("{%v: pruned %v}", p.From, p.PrunedHeight)
+	}
 	var s string
 	for _, b := range p.Blocks {
 		s += fmt.Sprintf("%v ", b.Hash().Fingerprint())
@@ -11,17 +11,26 @@ import (
 type PayloadType int
 
 const (
-	PayloadTypeSalam       = PayloadType(1) // Hello message
-	PayloadTypeAleyk       = PayloadType(2) // Hello Ack message
-	PayloadTypeBlocksReq   = PayloadType(3)
-	PayloadTypeBlocks      = PayloadType(4)
-	PayloadTypeTxsReq      = PayloadType(5)
-	PayloadTypeTxs         = PayloadType(6)
-	PayloadTypeProposalReq = PayloadType(7)
-	PayloadTypeProposal    = PayloadType(8)
-	PayloadTypeHeartBeat   = PayloadType(9)
-	PayloadTypeVote        = PayloadType(10)
-	PayloadTypeVoteSet     = PayloadType(11)
+	PayloadTypeSalam            = PayloadType(1) // Hello message
+	PayloadTypeAleyk            = PayloadType(2) // Hello Ack message
+	PayloadTypeBlocksReq        = PayloadType(3)
+	PayloadTypeBlocks           = PayloadType(4)
+	PayloadTypeTxsReq           = PayloadType(5)
+	PayloadTypeTxs              = PayloadType(6)
+	PayloadTypeProposalReq      = PayloadType(7)
+	PayloadTypeProposal         = PayloadType(8)
+	PayloadTypeHeartBeat        = PayloadType(9)
+	PayloadTypeVote             = PayloadType(10)
+	PayloadTypeVoteSet          = PayloadType(11)
+	PayloadTypeSnapshotReq      = PayloadType(12)
+	PayloadTypeSnapshot         = PayloadType(13)
+	PayloadTypeSnapshotChunkReq = PayloadType(14)
+	PayloadTypeSnapshotChunk    = PayloadType(15)
+)
+
+// Flags of the handshake messages
+const (
+	FlagPruned = 0x1 // Node has pruned the old blocks
 )
 
 func (t PayloadType) String() string {
@@ -48,6 +57,14 @@ func (t PayloadType) String() string {
 		return "vote"
 	case PayloadTypeVoteSet:
 		return "vote-set"
+	case PayloadTypeSnapshotReq:
+		return "snapshot-req"
+	case PayloadTypeSnapshot:
+		return "snapshot"
+	case PayloadTypeSnapshotChunkReq:
+		return "snapshot-chunk-req"
+	case PayloadTypeSnapshotChunk:
+		return "snapshot-chunk"
 	}
 	return fmt.Sprintf("%d", t)
 }
@@ -73,6 +90,11 @@ func (m *Message) SanityCheck() error {
 	return nil
 }
 
+// HasTarget returns true if the message is targeted to a node
+func (m *Message) HasTarget() bool {
+	return !m.Target.EqualsTo(crypto.Address{})
+}
+
 func (m *Message) Fingerprint() string {
 	return fmt.Sprintf("{%s %s}", m.Type, m.Payload.Fingerprint())
 }
@@ -137,11 +159,22 @@ func (m *Message) UnmarshalCBOR(bs []byte) error {
 		payload = &VotePayload{}
 	case PayloadTypeVoteSet:
 		payload = &VoteSetPayload{}
+	case PayloadTypeSnapshotReq:
+		payload = &SnapshotReqPayload{}
+	case PayloadTypeSnapshot:
+		payload = &SnapshotPayload{}
+	case PayloadTypeSnapshotChunkReq:
+		payload = &SnapshotChunkReqPayload{}
+	case PayloadTypeSnapshotChunk:
+		payload = &SnapshotChunkPayload{}
 
 	default:
 		return errors.Errorf(errors.ErrInvalidMessage, "Invalid payload")
 	}
 
+	m.Initiator = msg.Initiator
+	m.Target = msg.Target
+	m.Flags = msg.Flags
 	m.Type = msg.PayloadType
 	m.Payload = payload
 	return cbor.Unmarshal(msg.Payload, payload)
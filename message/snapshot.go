@@ -0,0 +1,48 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/snapshot"
+)
+
+// SnapshotPayload contains the manifest of a snapshot and the header of the next block,
+// which commits the state of the snapshot.
+type SnapshotPayload struct {
+	Manifest   snapshot.Manifest `cbor:"1,keyasint"`
+	NextHeader *block.Header     `cbor:"2,keyasint"`
+}
+
+func NewSnapshotMessage(manifest snapshot.Manifest, nextHeader *block.Header) *Message {
+	return &Message{
+		Type: PayloadTypeSnapshot,
+		Payload: &SnapshotPayload{
+			Manifest:   manifest,
+			NextHeader: nextHeader,
+		},
+	}
+}
+
+func (p *SnapshotPayload) SanityCheck() error {
+	if err := p.Manifest.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "Invalid manifest: %v", err)
+	}
+	if p.NextHeader == nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "No header")
+	}
+	if err := p.NextHeader.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "Invalid header: %v", err)
+	}
+
+	return nil
+}
+
+func (p *SnapshotPayload) Type() PayloadType {
+	return PayloadTypeSnapshot
+}
+
+func (p *SnapshotPayload) Fingerprint() string {
+	return fmt.Sprintf("{%v ⌘ %v}", p.Manifest.Fingerprint(), p.NextHeader.Hash().Fingerprint())
+}
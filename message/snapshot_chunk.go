@@ -0,0 +1,46 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+type SnapshotChunkPayload struct {
+	Height int    `cbor:"1,keyasint"`
+	Index  int    `cbor:"2,keyasint"`
+	Data   []byte `cbor:"3,keyasint"`
+}
+
+func NewSnapshotChunkMessage(height, index int, data []byte) *Message {
+	return &Message{
+		Type: PayloadTypeSnapshotChunk,
+		Payload: &SnapshotChunkPayload{
+			Height: height,
+			Index:  index,
+			Data:   data,
+		},
+	}
+}
+
+func (p *SnapshotChunkPayload) SanityCheck() error {
+	if p.Height <= 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "Invalid height")
+	}
+	if p.Index < 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "Invalid index")
+	}
+	if len(p.Data) == 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "No data")
+	}
+
+	return nil
+}
+
+func (p *SnapshotChunkPayload) Type() PayloadType {
+	return PayloadTypeSnapshotChunk
+}
+
+func (p *SnapshotChunkPayload) Fingerprint() string {
+	return fmt.Sprintf("{%v/%v 📦 %v}", p.Height, p.Index, len(p.Data))
+}
@@ -0,0 +1,41 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+type SnapshotChunkReqPayload struct {
+	Height int `cbor:"1,keyasint"`
+	Index  int `cbor:"2,keyasint"`
+}
+
+func NewSnapshotChunkReqMessage(height, index int) *Message {
+	return &Message{
+		Type: PayloadTypeSnapshotChunkReq,
+		Payload: &SnapshotChunkReqPayload{
+			Height: height,
+			Index:  index,
+		},
+	}
+}
+
+func (p *SnapshotChunkReqPayload) SanityCheck() error {
+	if p.Height <= 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "Invalid height")
+	}
+	if p.Index < 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "Invalid index")
+	}
+
+	return nil
+}
+
+func (p *SnapshotChunkReqPayload) Type() PayloadType {
+	return PayloadTypeSnapshotChunkReq
+}
+
+func (p *SnapshotChunkReqPayload) Fingerprint() string {
+	return fmt.Sprintf("{%v/%v}", p.Height, p.Index)
+}
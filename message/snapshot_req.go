@@ -0,0 +1,36 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+type SnapshotReqPayload struct {
+	Height int `cbor:"1,keyasint"`
+}
+
+func NewSnapshotReqMessage(height int) *Message {
+	return &Message{
+		Type: PayloadTypeSnapshotReq,
+		Payload: &SnapshotReqPayload{
+			Height: height,
+		},
+	}
+}
+
+func (p *SnapshotReqPayload) SanityCheck() error {
+	if p.Height <= 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "Invalid height")
+	}
+
+	return nil
+}
+
+func (p *SnapshotReqPayload) Type() PayloadType {
+	return PayloadTypeSnapshotReq
+}
+
+func (p *SnapshotReqPayload) Fingerprint() string {
+	return fmt.Sprintf("{%v}", p.Height)
+}
@@ -52,7 +52,11 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config, signer crypto.Signer)
 		return nil, err
 	}
 
-	consensus, err := consensus.NewConsensus(conf.Consensus, state, signer, broadcastCh)
+	if err := txPool.OpenJournal(conf.State.Store.TxPoolJournalPath()); err != nil {
+		return nil, errors.Wrap(err, "could not open txpool journal")
+	}
+
+	consensus, err := consensus.NewConsensus(conf.Consensus, state, txPool, signer, broadcastCh)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +66,7 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config, signer crypto.Signer)
 		return nil, err
 	}
 
-	capnp, err := capnp.NewServer(conf.Capnp, state, txPool)
+	capnp, err := capnp.NewServer(conf.Capnp, state, sync.Stats(), txPool)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create Capnproto server")
 	}
@@ -122,6 +126,7 @@ func (n *Node) Stop() {
 	n.network.Stop()
 	n.sync.Stop()
 	n.state.Close()
+	n.txPool.CloseJournal()
 	n.http.StopServer()
 	n.capnp.StopServer()
 }
@@ -1,6 +1,11 @@
 package param
 
-import "time"
+import (
+	"time"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
 
 type Params struct {
 	BlockTimeInSecond          int     `cbor:"1,keyasint"`
@@ -12,6 +17,16 @@ type Params struct {
 	MinimumFee                 int64   `cbor:"7,keyasint"`
 	TransactionToLiveInterval  int     `cbor:"8,keyasint"`
 	WiredrawStakeInterval      int     `cbor:"9,keyasint"`
+	// The block reward (subsidy plus fees) is split between the proposer, the committers of the last block
+	// and the development fund. The proposer takes what is left.
+	CommitteeRewardPercentage int             `cbor:"10,keyasint,omitempty"`
+	FundRewardPercentage      int             `cbor:"11,keyasint,omitempty"`
+	FundAddress               *crypto.Address `cbor:"12,keyasint,omitempty"`
+	// Validators that sign less than the minimum signing percentage of the commits in the downtime window,
+	// are jailed for the jail period. Zero window disables jailing.
+	DowntimeWindow           int `cbor:"13,keyasint,omitempty"`
+	MinimumSigningPercentage int `cbor:"14,keyasint,omitempty"`
+	JailPeriod               int `cbor:"15,keyasint,omitempty"`
 }
 
 func MainnetParams() Params {
@@ -25,9 +40,56 @@ func MainnetParams() Params {
 		MinimumFee:                 1000,
 		TransactionToLiveInterval:  8640,   // one days
 		WiredrawStakeInterval:      181440, // 21 days
+		DowntimeWindow:             8640,   // one day
+		MinimumSigningPercentage:   50,
+		JailPeriod:                 8640, // one day
 	}
 }
 
 func (p *Params) BlockTime() time.Duration {
 	return time.Duration(p.BlockTimeInSecond) * time.Second
 }
+
+func (p *Params) SanityCheck() error {
+	if p.BlockTimeInSecond <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid block time")
+	}
+	if p.MaximumTransactionPerBlock <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid maximum transaction per block")
+	}
+	if p.MaximumPower <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid maximum power")
+	}
+	if p.SubsidyReductionInterval < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid subsidy reduction interval")
+	}
+	if p.MaximumMemoLength < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid maximum memo length")
+	}
+	if p.FeeFraction < 0 || p.FeeFraction >= 1 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid fee fraction")
+	}
+	if p.MinimumFee < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid minimum fee")
+	}
+	if p.TransactionToLiveInterval <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid transaction to live interval")
+	}
+	if p.WiredrawStakeInterval <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid withdraw stake interval")
+	}
+	if p.CommitteeRewardPercentage < 0 || p.FundRewardPercentage < 0 ||
+		p.CommitteeRewardPercentage+p.FundRewardPercentage > 100 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid reward percentages")
+	}
+	if p.DowntimeWindow < 0 || p.JailPeriod < 0 ||
+		p.MinimumSigningPercentage < 0 || p.MinimumSigningPercentage > 100 {
+		return errors.Errorf(errors.ErrInvalidConfig, "Invalid downtime parameters")
+	}
+	if p.FundRewardPercentage > 0 {
+		if p.FundAddress == nil || p.FundAddress.SanityCheck() != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "Invalid fund address")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package param
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+func TestSanityCheck(t *testing.T) {
+	p := MainnetParams()
+	assert.NoError(t, p.SanityCheck())
+
+	p.BlockTimeInSecond = 0
+	assert.Error(t, p.SanityCheck())
+
+	p = MainnetParams()
+	p.FeeFraction = 1
+	assert.Error(t, p.SanityCheck())
+
+	p = MainnetParams()
+	p.MinimumFee = -1
+	assert.Error(t, p.SanityCheck())
+
+	p = MainnetParams()
+	p.MaximumTransactionPerBlock = 0
+	assert.Error(t, p.SanityCheck())
+}
+
+func TestRewardSanityCheck(t *testing.T) {
+	fund, _, _ := crypto.GenerateTestKeyPair()
+
+	p := MainnetParams()
+	p.CommitteeRewardPercentage = 60
+	p.FundRewardPercentage = 50
+	p.FundAddress = &fund
+	assert.Error(t, p.SanityCheck())
+
+	p.FundRewardPercentage = 40
+	assert.NoError(t, p.SanityCheck())
+
+	p.FundAddress = nil
+	assert.Error(t, p.SanityCheck())
+
+	p.FundRewardPercentage = 0
+	assert.NoError(t, p.SanityCheck())
+
+	p.CommitteeRewardPercentage = -1
+	assert.Error(t, p.SanityCheck())
+}
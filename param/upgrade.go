@@ -0,0 +1,80 @@
+package param
+
+import (
+	"sort"
+
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// InitialProtocolVersion is the protocol version from the genesis block
+const InitialProtocolVersion = 1
+
+// Upgrade activates a new protocol version at the activation height
+type Upgrade struct {
+	Height  int `cbor:"1,keyasint"`
+	Version int `cbor:"2,keyasint"`
+}
+
+// UpgradeSchedule is a list of upgrades, sorted by the activation height
+type UpgradeSchedule []Upgrade
+
+func (s UpgradeSchedule) SanityCheck() error {
+	lastHeight := 0
+	lastVersion := InitialProtocolVersion
+	for _, u := range s {
+		if u.Height <= lastHeight {
+			return errors.Errorf(errors.ErrInvalidConfig, "Invalid activation height for version %v", u.Version)
+		}
+		if u.Version <= lastVersion {
+			return errors.Errorf(errors.ErrInvalidConfig, "Invalid protocol version at height %v", u.Height)
+		}
+		lastHeight = u.Height
+		lastVersion = u.Version
+	}
+	return nil
+}
+
+// VersionAt returns the protocol version for the block at the given height
+func (s UpgradeSchedule) VersionAt(height int) int {
+	version := InitialProtocolVersion
+	for _, u := range s {
+		if u.Height > height {
+			break
+		}
+		version = u.Version
+	}
+	return version
+}
+
+// NextUpgrade returns the first upgrade after the given height, or nil if there is no upgrade scheduled
+func (s UpgradeSchedule) NextUpgrade(height int) *Upgrade {
+	for i, u := range s {
+		if u.Height > height {
+			return &s[i]
+		}
+	}
+	return nil
+}
+
+// Merge overlays the upgrades on top of this schedule.
+// An upgrade in overlay replaces the upgrade with the same version.
+func (s UpgradeSchedule) Merge(overlay UpgradeSchedule) UpgradeSchedule {
+	merged := make(UpgradeSchedule, 0, len(s)+len(overlay))
+	for _, u := range s {
+		overridden := false
+		for _, o := range overlay {
+			if o.Version == u.Version {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			merged = append(merged, u)
+		}
+	}
+	merged = append(merged, overlay...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Height < merged[j].Height
+	})
+	return merged
+}
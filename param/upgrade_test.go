@@ -0,0 +1,45 @@
+package param
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradeSchedule(t *testing.T) {
+	s := UpgradeSchedule{{Height: 100, Version: 2}, {Height: 200, Version: 3}}
+	assert.NoError(t, s.SanityCheck())
+
+	assert.Equal(t, s.VersionAt(1), 1)
+	assert.Equal(t, s.VersionAt(99), 1)
+	assert.Equal(t, s.VersionAt(100), 2)
+	assert.Equal(t, s.VersionAt(199), 2)
+	assert.Equal(t, s.VersionAt(1000), 3)
+	assert.Equal(t, UpgradeSchedule{}.VersionAt(1000), 1)
+
+	assert.Equal(t, s.NextUpgrade(1).Version, 2)
+	assert.Equal(t, s.NextUpgrade(100).Version, 3)
+	assert.Nil(t, s.NextUpgrade(200))
+}
+
+func TestUpgradeScheduleSanityCheck(t *testing.T) {
+	assert.NoError(t, UpgradeSchedule{}.SanityCheck())
+	assert.Error(t, UpgradeSchedule{{Height: 0, Version: 2}}.SanityCheck())
+	assert.Error(t, UpgradeSchedule{{Height: 10, Version: 1}}.SanityCheck())
+	assert.Error(t, UpgradeSchedule{{Height: 10, Version: 3}, {Height: 20, Version: 2}}.SanityCheck())
+	assert.Error(t, UpgradeSchedule{{Height: 20, Version: 2}, {Height: 10, Version: 3}}.SanityCheck())
+}
+
+func TestMergeUpgradeSchedule(t *testing.T) {
+	s := UpgradeSchedule{{Height: 100, Version: 2}, {Height: 200, Version: 3}}
+
+	assert.Equal(t, s.Merge(nil), s)
+	assert.Equal(t, UpgradeSchedule(nil).Merge(s), s)
+
+	merged := s.Merge(UpgradeSchedule{{Height: 300, Version: 3}, {Height: 400, Version: 4}})
+	assert.Equal(t, merged, UpgradeSchedule{{Height: 100, Version: 2}, {Height: 300, Version: 3}, {Height: 400, Version: 4}})
+	assert.NoError(t, merged.SanityCheck())
+
+	merged = s.Merge(UpgradeSchedule{{Height: 50, Version: 3}})
+	assert.Error(t, merged.SanityCheck())
+}
@@ -3,6 +3,9 @@ package sandbox
 import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
@@ -15,13 +18,28 @@ type Sandbox interface {
 	MakeNewValidator(crypto.PublicKey) *validator.Validator
 	UpdateValidator(*validator.Validator)
 
+	Escrow(crypto.Hash) *escrow.Escrow
+	MakeNewEscrow(crypto.Hash) *escrow.Escrow
+	UpdateEscrow(*escrow.Escrow)
+
+	Proposal(crypto.Hash) *governance.Proposal
+	MakeNewProposal(crypto.Hash) *governance.Proposal
+	UpdateProposal(*governance.Proposal)
+	OpenProposals(proposer crypto.Address) int
+
 	VerifySortition(blockHash crypto.Hash, proof []byte, val *validator.Validator) bool
 	AddToSet(crypto.Hash, crypto.Address) error
+	LeaveSet(crypto.Address) error
 
 	CurrentHeight() int
 	RecentBlockHeight(crypto.Hash) int
 	TransactionToLiveInterval() int
+	WiredrawStakeInterval() int
 	MaxMemoLength() int
 	FeeFraction() float64
 	MinFee() int64
+	Params() param.Params
+	ProtocolVersion() int
+
+	Clear()
 }
@@ -3,6 +3,9 @@ package sandbox
 import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/validator"
 )
@@ -11,30 +14,40 @@ var _ Sandbox = &MockSandbox{}
 
 // MockSandbox is a testing mock for sandbox
 type MockSandbox struct {
-	Accounts       map[crypto.Address]account.Account
-	Validators     map[crypto.Address]validator.Validator
-	Stamps         map[crypto.Hash]int
-	CurrentHeight_ int
-	TTLInterval    int
-	MaxMemoLength_ int
-	FeeFraction_   float64
-	MinFee_        int64
-	TotalAccount   int
-	TotalValidator int
-	Sortition      *sortition.Sortition
+	Accounts         map[crypto.Address]account.Account
+	Validators       map[crypto.Address]validator.Validator
+	Escrows          map[crypto.Hash]escrow.Escrow
+	Proposals        map[crypto.Hash]governance.Proposal
+	Stamps           map[crypto.Hash]int
+	CurrentHeight_   int
+	TTLInterval      int
+	WiredrawInterval int
+	MaxMemoLength_   int
+	FeeFraction_     float64
+	MinFee_          int64
+	Version          int
+	TotalAccount     int
+	TotalValidator   int
+	TotalEscrow      int
+	TotalProposal    int
+	Sortition        *sortition.Sortition
 }
 
 func NewMockSandbox() *MockSandbox {
 	_, _, priv := crypto.GenerateTestKeyPair()
 	return &MockSandbox{
-		Accounts:       make(map[crypto.Address]account.Account),
-		Validators:     make(map[crypto.Address]validator.Validator),
-		Stamps:         make(map[crypto.Hash]int),
-		TTLInterval:    4,
-		MaxMemoLength_: 1024,
-		FeeFraction_:   0.001,
-		MinFee_:        1000,
-		Sortition:      sortition.NewSortition(crypto.NewSigner(priv)),
+		Accounts:         make(map[crypto.Address]account.Account),
+		Validators:       make(map[crypto.Address]validator.Validator),
+		Escrows:          make(map[crypto.Hash]escrow.Escrow),
+		Proposals:        make(map[crypto.Hash]governance.Proposal),
+		Stamps:           make(map[crypto.Hash]int),
+		TTLInterval:      4,
+		WiredrawInterval: 10,
+		MaxMemoLength_:   1024,
+		FeeFraction_:     0.001,
+		MinFee_:          1000,
+		Version:          param.InitialProtocolVersion,
+		Sortition:        sortition.NewSortition(crypto.NewSigner(priv)),
 	}
 }
 func (m *MockSandbox) Account(addr crypto.Address) *account.Account {
@@ -68,9 +81,51 @@ func (m *MockSandbox) UpdateValidator(val *validator.Validator) {
 	m.Validators[val.Address()] = *val
 
 }
+func (m *MockSandbox) Escrow(id crypto.Hash) *escrow.Escrow {
+	e, ok := m.Escrows[id]
+	if !ok {
+		return nil
+	}
+	return &e
+}
+func (m *MockSandbox) MakeNewEscrow(id crypto.Hash) *escrow.Escrow {
+	e := escrow.NewEscrow(id, m.TotalEscrow)
+	m.TotalEscrow++
+	return e
+}
+func (m *MockSandbox) UpdateEscrow(e *escrow.Escrow) {
+	m.Escrows[e.ID()] = *e
+}
+func (m *MockSandbox) Proposal(id crypto.Hash) *governance.Proposal {
+	p, ok := m.Proposals[id]
+	if !ok {
+		return nil
+	}
+	return &p
+}
+func (m *MockSandbox) MakeNewProposal(id crypto.Hash) *governance.Proposal {
+	p := governance.NewProposal(id, m.TotalProposal)
+	m.TotalProposal++
+	return p
+}
+func (m *MockSandbox) UpdateProposal(p *governance.Proposal) {
+	m.Proposals[p.ID()] = *p
+}
+func (m *MockSandbox) OpenProposals(proposer crypto.Address) int {
+	count := 0
+	for _, p := range m.Proposals {
+		if p.Proposer().EqualsTo(proposer) && p.ActivationHeight() >= m.CurrentHeight_ {
+			count++
+		}
+	}
+	return count
+}
 func (m *MockSandbox) AddToSet(crypto.Hash, crypto.Address) error {
 	return nil
 }
+func (m *MockSandbox) LeaveSet(crypto.Address) error {
+	return nil
+}
 func (m *MockSandbox) VerifySortition(blockHash crypto.Hash, proof []byte, val *validator.Validator) bool {
 	return m.Sortition.VerifyProof(blockHash, proof, val)
 }
@@ -87,6 +142,9 @@ func (m *MockSandbox) RecentBlockHeight(hash crypto.Hash) int {
 func (m *MockSandbox) TransactionToLiveInterval() int {
 	return m.TTLInterval
 }
+func (m *MockSandbox) WiredrawStakeInterval() int {
+	return m.WiredrawInterval
+}
 func (m *MockSandbox) MaxMemoLength() int {
 	return m.MaxMemoLength_
 }
@@ -96,6 +154,22 @@ func (m *MockSandbox) FeeFraction() float64 {
 func (m *MockSandbox) MinFee() int64 {
 	return m.MinFee_
 }
+func (m *MockSandbox) Params() param.Params {
+	params := param.MainnetParams()
+	params.MaximumMemoLength = m.MaxMemoLength_
+	params.FeeFraction = m.FeeFraction_
+	params.MinimumFee = m.MinFee_
+	params.TransactionToLiveInterval = m.TTLInterval
+	params.WiredrawStakeInterval = m.WiredrawInterval
+	return params
+}
+func (m *MockSandbox) ProtocolVersion() int {
+	return m.Version
+}
+
+// Clear does nothing here, the mock sandbox has no committed state to return to
+func (m *MockSandbox) Clear() {
+}
 
 func (m *MockSandbox) AppendStampAndUpdateHeight(height int, stamp crypto.Hash) {
 	m.Stamps[stamp] = height
@@ -5,6 +5,8 @@ import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
 	"github.com/zarbchain/zarb-go/libs/linkedmap"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/param"
@@ -21,10 +23,15 @@ type SandboxConcrete struct {
 	validatorSet    validator.ValidatorSetReader
 	accounts        map[crypto.Address]*AccountStatus
 	validators      map[crypto.Address]*ValidatorStatus
+	escrows         map[crypto.Hash]*EscrowStatus
+	proposals       map[crypto.Hash]*ProposalStatus
 	recentBlocks    *linkedmap.LinkedMap
 	params          param.Params
+	protocolVersion int
 	totalAccounts   int
 	totalValidators int
+	totalEscrows    int
+	totalProposals  int
 	changeToStake   int64
 }
 
@@ -32,6 +39,7 @@ type ValidatorStatus struct {
 	Validator validator.Validator
 	Updated   bool
 	AddToSet  bool
+	LeaveSet  bool
 }
 
 type AccountStatus struct {
@@ -39,15 +47,28 @@ type AccountStatus struct {
 	Updated bool
 }
 
+type EscrowStatus struct {
+	Escrow  escrow.Escrow
+	Updated bool
+}
+
+type ProposalStatus struct {
+	Proposal governance.Proposal
+	Updated  bool
+}
+
 func NewSandbox(store store.StoreReader, params param.Params, lastBlockHeight int, sortition *sortition.Sortition, valset validator.ValidatorSetReader) (*SandboxConcrete, error) {
 	sb := &SandboxConcrete{
-		store:        store,
-		sortition:    sortition,
-		validatorSet: valset,
-		params:       params,
-		recentBlocks: linkedmap.NewLinkedMap(params.TransactionToLiveInterval),
-		accounts:     make(map[crypto.Address]*AccountStatus),
-		validators:   make(map[crypto.Address]*ValidatorStatus),
+		store:           store,
+		sortition:       sortition,
+		validatorSet:    valset,
+		params:          params,
+		protocolVersion: param.InitialProtocolVersion,
+		recentBlocks:    linkedmap.NewLinkedMap(params.TransactionToLiveInterval),
+		accounts:        make(map[crypto.Address]*AccountStatus),
+		validators:      make(map[crypto.Address]*ValidatorStatus),
+		escrows:         make(map[crypto.Hash]*EscrowStatus),
+		proposals:       make(map[crypto.Hash]*ProposalStatus),
 	}
 
 	// TODO: add test for me!
@@ -75,6 +96,29 @@ func NewSandbox(store store.StoreReader, params param.Params, lastBlockHeight in
 	return sb, nil
 }
 
+// Clone makes a new sandbox with the same recent blocks, parameters and protocol version.
+// Changes of this sandbox are not copied. It saves reading the recent blocks from the store again.
+func (sb *SandboxConcrete) Clone() *SandboxConcrete {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	clone := &SandboxConcrete{
+		store:           sb.store,
+		sortition:       sb.sortition,
+		validatorSet:    sb.validatorSet,
+		params:          sb.params,
+		protocolVersion: sb.protocolVersion,
+		recentBlocks:    linkedmap.NewLinkedMap(sb.params.TransactionToLiveInterval),
+	}
+	for e := sb.recentBlocks.FirstElement(); e != nil; e = e.Next() {
+		p := e.Value.(*linkedmap.Pair)
+		clone.recentBlocks.PushBack(p.First, p.Second)
+	}
+	clone.clear()
+
+	return clone
+}
+
 func (sb *SandboxConcrete) shouldPanicForDuplicatedAddress() {
 	//
 	// Why we should panic here?
@@ -104,8 +148,13 @@ func (sb *SandboxConcrete) Clear() {
 func (sb *SandboxConcrete) clear() {
 	sb.accounts = make(map[crypto.Address]*AccountStatus)
 	sb.validators = make(map[crypto.Address]*ValidatorStatus)
+	sb.escrows = make(map[crypto.Hash]*EscrowStatus)
+	sb.proposals = make(map[crypto.Hash]*ProposalStatus)
 	sb.totalAccounts = sb.store.TotalAccounts()
 	sb.totalValidators = sb.store.TotalValidators()
+	sb.totalEscrows = sb.store.TotalEscrows()
+	sb.totalProposals = sb.store.TotalProposals()
+	sb.changeToStake = 0
 }
 
 func (sb *SandboxConcrete) Account(addr crypto.Address) *account.Account {
@@ -212,6 +261,123 @@ func (sb *SandboxConcrete) UpdateValidator(val *validator.Validator) {
 	s.Updated = true
 }
 
+func (sb *SandboxConcrete) Escrow(id crypto.Hash) *escrow.Escrow {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	s, ok := sb.escrows[id]
+	if ok {
+		copy := new(escrow.Escrow)
+		*copy = s.Escrow
+		return copy
+	}
+
+	e, err := sb.store.Escrow(id)
+	if err != nil {
+		return nil
+	}
+	sb.escrows[id] = &EscrowStatus{
+		Escrow: *e,
+	}
+	return e
+}
+
+func (sb *SandboxConcrete) MakeNewEscrow(id crypto.Hash) *escrow.Escrow {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	_, ok := sb.escrows[id]
+	if ok || sb.store.HasEscrow(id) {
+		logger.Panic("Duplicated escrow")
+	}
+
+	e := escrow.NewEscrow(id, sb.totalEscrows)
+	sb.escrows[id] = &EscrowStatus{
+		Escrow:  *e,
+		Updated: true,
+	}
+	sb.totalEscrows++
+	return e
+}
+
+func (sb *SandboxConcrete) UpdateEscrow(e *escrow.Escrow) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	s, ok := sb.escrows[e.ID()]
+	if !ok {
+		logger.Panic("Unknown escrow")
+	}
+	s.Escrow = *e
+	s.Updated = true
+}
+
+func (sb *SandboxConcrete) Proposal(id crypto.Hash) *governance.Proposal {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	s, ok := sb.proposals[id]
+	if ok {
+		copy := new(governance.Proposal)
+		*copy = s.Proposal
+		return copy
+	}
+
+	p, err := sb.store.Proposal(id)
+	if err != nil {
+		return nil
+	}
+	sb.proposals[id] = &ProposalStatus{
+		Proposal: *p,
+	}
+	return p
+}
+
+func (sb *SandboxConcrete) MakeNewProposal(id crypto.Hash) *governance.Proposal {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	_, ok := sb.proposals[id]
+	if ok || sb.store.HasProposal(id) {
+		logger.Panic("Duplicated proposal")
+	}
+
+	p := governance.NewProposal(id, sb.totalProposals)
+	sb.proposals[id] = &ProposalStatus{
+		Proposal: *p,
+		Updated:  true,
+	}
+	sb.totalProposals++
+	return p
+}
+
+func (sb *SandboxConcrete) UpdateProposal(p *governance.Proposal) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	s, ok := sb.proposals[p.ID()]
+	if !ok {
+		logger.Panic("Unknown proposal")
+	}
+	s.Proposal = *p
+	s.Updated = true
+}
+
+// OpenProposals returns the number of the proposals of the proposer that are not activated yet,
+// including the new ones in this sandbox
+func (sb *SandboxConcrete) OpenProposals(proposer crypto.Address) int {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	count := sb.store.OpenProposals(proposer, sb.lastHeight()+1)
+	for id, s := range sb.proposals {
+		if s.Proposal.Proposer().EqualsTo(proposer) && !sb.store.HasProposal(id) {
+			count++
+		}
+	}
+	return count
+}
+
 func (sb *SandboxConcrete) AddToSet(blockHash crypto.Hash, addr crypto.Address) error {
 	sb.lk.Lock()
 	defer sb.lk.Unlock()
@@ -250,6 +416,36 @@ func (sb *SandboxConcrete) AddToSet(blockHash crypto.Hash, addr crypto.Address)
 	return nil
 }
 
+// LeaveSet marks the validator to leave the set at the next height.
+// Unbonded, slashed and jailed validators leave the set, and to keep the consensus alive,
+// less than one third of the set can leave at each height.
+func (sb *SandboxConcrete) LeaveSet(addr crypto.Address) error {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	s, ok := sb.validators[addr]
+	if !ok {
+		sb.shouldPanicForUnknownAddress()
+	}
+
+	if !sb.validatorSet.Contains(addr) || s.LeaveSet {
+		return nil
+	}
+
+	left := 0
+	for _, s := range sb.validators {
+		if s.LeaveSet {
+			left++
+		}
+	}
+	if left >= (sb.validatorSet.Power()-1)/3 {
+		return errors.Errorf(errors.ErrGeneric, "In each height less than 1/3 of validator can leave the set")
+	}
+
+	s.LeaveSet = true
+	return nil
+}
+
 func (sb *SandboxConcrete) MaxMemoLength() int {
 	sb.lk.Lock()
 	defer sb.lk.Unlock()
@@ -268,6 +464,36 @@ func (sb *SandboxConcrete) MinFee() int64 {
 	return sb.params.MinimumFee
 }
 
+func (sb *SandboxConcrete) Params() param.Params {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.params
+}
+
+// SetParams updates the parameters, when a parameter change proposal is activated
+func (sb *SandboxConcrete) SetParams(params param.Params) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	sb.params = params
+}
+
+func (sb *SandboxConcrete) ProtocolVersion() int {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.protocolVersion
+}
+
+// SetProtocolVersion updates the protocol version, when an upgrade is activated
+func (sb *SandboxConcrete) SetProtocolVersion(version int) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	sb.protocolVersion = version
+}
+
 func (sb *SandboxConcrete) TransactionToLiveInterval() int {
 	sb.lk.RLock()
 	defer sb.lk.RUnlock()
@@ -275,6 +501,13 @@ func (sb *SandboxConcrete) TransactionToLiveInterval() int {
 	return sb.params.TransactionToLiveInterval
 }
 
+func (sb *SandboxConcrete) WiredrawStakeInterval() int {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.params.WiredrawStakeInterval
+}
+
 func (sb *SandboxConcrete) RecentBlockHeight(hash crypto.Hash) int {
 	sb.lk.RLock()
 	defer sb.lk.RUnlock()
@@ -328,6 +561,14 @@ func (sb *SandboxConcrete) VerifySortition(blockHash crypto.Hash, proof []byte,
 	return sb.sortition.VerifyProof(blockHash, proof, val)
 }
 
+// ChangeToStake returns the total changes to the validators' stake since the last clear
+func (sb *SandboxConcrete) ChangeToStake() int64 {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.changeToStake
+}
+
 func (sb *SandboxConcrete) IterateAccounts(consumer func(*AccountStatus)) {
 	for _, as := range sb.accounts {
 		consumer(as)
@@ -339,3 +580,15 @@ func (sb *SandboxConcrete) IterateValidators(consumer func(*ValidatorStatus)) {
 		consumer(vs)
 	}
 }
+
+func (sb *SandboxConcrete) IterateEscrows(consumer func(*EscrowStatus)) {
+	for _, es := range sb.escrows {
+		consumer(es)
+	}
+}
+
+func (sb *SandboxConcrete) IterateProposals(consumer func(*ProposalStatus)) {
+	for _, ps := range sb.proposals {
+		consumer(ps)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sortition"
@@ -127,6 +129,93 @@ func TestValidatorChange(t *testing.T) {
 	})
 }
 
+func TestEscrowChange(t *testing.T) {
+	setup(t)
+
+	t.Run("Should returns nil for unknown escrow", func(t *testing.T) {
+		assert.Nil(t, tSandbox.Escrow(crypto.GenerateTestHash()))
+	})
+
+	t.Run("Retrieve an escrow from store, claim it and commit it", func(t *testing.T) {
+		esc1 := escrow.GenerateTestEscrow(0)
+		tStore.UpdateEscrow(esc1)
+
+		esc1a := tSandbox.Escrow(esc1.ID())
+		assert.Equal(t, esc1, esc1a)
+
+		esc1a.Claim()
+		assert.False(t, tSandbox.escrows[esc1.ID()].Updated)
+		tSandbox.UpdateEscrow(esc1a)
+		assert.True(t, tSandbox.escrows[esc1.ID()].Updated)
+		assert.False(t, tStore.Escrows[esc1.ID()].IsClaimed())
+	})
+
+	t.Run("Make new escrow and reset the sandbox", func(t *testing.T) {
+		total := tSandbox.totalEscrows
+		esc2 := tSandbox.MakeNewEscrow(crypto.GenerateTestHash())
+		assert.Equal(t, esc2.Number(), total)
+		assert.Equal(t, tSandbox.totalEscrows, total+1)
+
+		tSandbox.Clear()
+		assert.Nil(t, tSandbox.Escrow(esc2.ID()))
+		assert.Equal(t, tSandbox.totalEscrows, 1)
+	})
+
+	t.Run("Make duplicated escrow, should panic", func(t *testing.T) {
+		id := crypto.GenerateTestHash()
+		tSandbox.MakeNewEscrow(id)
+		assert.Panics(t, func() { tSandbox.MakeNewEscrow(id) })
+	})
+}
+
+func TestProposalChange(t *testing.T) {
+	setup(t)
+
+	t.Run("Should returns nil for unknown proposal", func(t *testing.T) {
+		assert.Nil(t, tSandbox.Proposal(crypto.GenerateTestHash()))
+	})
+
+	t.Run("Retrieve a proposal from store, vote it and commit it", func(t *testing.T) {
+		p1 := governance.GenerateTestProposal(0)
+		tStore.UpdateProposal(p1)
+
+		p1a := tSandbox.Proposal(p1.ID())
+		assert.Equal(t, p1, p1a)
+
+		voter, _, _ := crypto.GenerateTestKeyPair()
+		p1a.AddVote(voter)
+		assert.False(t, tSandbox.proposals[p1.ID()].Updated)
+		tSandbox.UpdateProposal(p1a)
+		assert.True(t, tSandbox.proposals[p1.ID()].Updated)
+		assert.False(t, tStore.Proposals[p1.ID()].HasVoted(voter))
+	})
+
+	t.Run("Make new proposal and reset the sandbox", func(t *testing.T) {
+		total := tSandbox.totalProposals
+		p2 := tSandbox.MakeNewProposal(crypto.GenerateTestHash())
+		assert.Equal(t, p2.Number(), total)
+		assert.Equal(t, tSandbox.totalProposals, total+1)
+
+		tSandbox.Clear()
+		assert.Nil(t, tSandbox.Proposal(p2.ID()))
+		assert.Equal(t, tSandbox.totalProposals, 1)
+	})
+
+	t.Run("Make duplicated proposal, should panic", func(t *testing.T) {
+		id := crypto.GenerateTestHash()
+		tSandbox.MakeNewProposal(id)
+		assert.Panics(t, func() { tSandbox.MakeNewProposal(id) })
+	})
+
+	t.Run("Update parameters", func(t *testing.T) {
+		params := tSandbox.Params()
+		params.MinimumFee = 2000
+		tSandbox.SetParams(params)
+		assert.Equal(t, tSandbox.MinFee(), int64(2000))
+		assert.Equal(t, tSandbox.Params(), params)
+	})
+}
+
 func TestAddValidatorToSet(t *testing.T) {
 	// setup(t)
 
@@ -143,6 +232,45 @@ func TestAddValidatorToSet(t *testing.T) {
 	// assert.Nil(t, st.validatorSet.Validator(val1.Address()), "Should be is not in set")
 }
 
+func TestLeaveSet(t *testing.T) {
+	setup(t)
+
+	vals := make([]*validator.Validator, 7)
+	for i := range vals {
+		vals[i], _ = validator.GenerateTestValidator(i + 1)
+		tStore.UpdateValidator(vals[i])
+	}
+	valset, err := validator.NewValidatorSet(vals, 7, vals[0].Address())
+	assert.NoError(t, err)
+	sb, err := NewSandbox(tStore, param.MainnetParams(), 0, tSortition, valset)
+	assert.NoError(t, err)
+
+	t.Run("Validator is not in the set", func(t *testing.T) {
+		val := sb.Validator(tValSigner.Address())
+		assert.NoError(t, sb.LeaveSet(val.Address()))
+		assert.False(t, sb.validators[val.Address()].LeaveSet)
+	})
+
+	t.Run("Less than 1/3 of the set can leave", func(t *testing.T) {
+		sb.Validator(vals[0].Address())
+		sb.Validator(vals[1].Address())
+		sb.Validator(vals[2].Address())
+		assert.NoError(t, sb.LeaveSet(vals[0].Address()))
+		assert.NoError(t, sb.LeaveSet(vals[1].Address()))
+		// Leaving twice is counted once
+		assert.NoError(t, sb.LeaveSet(vals[1].Address()))
+		assert.Error(t, sb.LeaveSet(vals[2].Address()))
+		assert.True(t, sb.validators[vals[1].Address()].LeaveSet)
+		assert.False(t, sb.validators[vals[2].Address()].LeaveSet)
+	})
+
+	t.Run("Clear the sandbox", func(t *testing.T) {
+		sb.Clear()
+		sb.Validator(vals[2].Address())
+		assert.NoError(t, sb.LeaveSet(vals[2].Address()))
+	})
+}
+
 func TestTotalAccountCounter(t *testing.T) {
 	setup(t)
 
@@ -305,4 +433,30 @@ func TestChangeToStake(t *testing.T) {
 
 	tSandbox.UpdateValidator(val2)
 	assert.Equal(t, tSandbox.changeToStake, int64(1500))
+
+	assert.Equal(t, tSandbox.ChangeToStake(), int64(1500))
+
+	tSandbox.Clear()
+	assert.Equal(t, tSandbox.ChangeToStake(), int64(0))
+}
+
+func TestClone(t *testing.T) {
+	setup(t)
+
+	hash := crypto.GenerateTestHash()
+	tSandbox.AppendNewBlock(hash, 1)
+	tSandbox.SetProtocolVersion(2)
+	addr, _, _ := crypto.GenerateTestKeyPair()
+	tSandbox.MakeNewAccount(addr)
+
+	clone := tSandbox.Clone()
+	assert.Equal(t, clone.CurrentHeight(), tSandbox.CurrentHeight())
+	assert.Equal(t, clone.RecentBlockHeight(hash), 1)
+	assert.Equal(t, clone.ProtocolVersion(), 2)
+	assert.Equal(t, clone.Params(), tSandbox.Params())
+	// Changes are not cloned
+	assert.Nil(t, clone.Account(addr))
+
+	clone.AppendNewBlock(crypto.GenerateTestHash(), 2)
+	assert.NotEqual(t, clone.CurrentHeight(), tSandbox.CurrentHeight())
 }
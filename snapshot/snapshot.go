@@ -0,0 +1,178 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/sasha-s/go-deadlock"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// DefaultChunkSize is the maximum size of each chunk, it should fit in a network message
+const DefaultChunkSize = 256 * 1024
+
+// maxChunks limits the size of a snapshot that we accept from other peers
+const maxChunks = 16 * 1024
+
+// Manifest describes a snapshot.
+// BlockHash is the hash of the last block that is applied to the state of the snapshot.
+type Manifest struct {
+	Height      int           `cbor:"1,keyasint"`
+	BlockHash   crypto.Hash   `cbor:"2,keyasint"`
+	ChunkHashes []crypto.Hash `cbor:"3,keyasint"`
+}
+
+func (m *Manifest) SanityCheck() error {
+	if m.Height <= 0 {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid height")
+	}
+	if err := m.BlockHash.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid block hash: %v", err)
+	}
+	if len(m.ChunkHashes) == 0 || len(m.ChunkHashes) > maxChunks {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid number of chunks: %v", len(m.ChunkHashes))
+	}
+	for _, h := range m.ChunkHashes {
+		if err := h.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid chunk hash: %v", err)
+		}
+	}
+	return nil
+}
+
+func (m *Manifest) Fingerprint() string {
+	return fmt.Sprintf("{#%d ⌘ %v 🧩 %d}",
+		m.Height,
+		m.BlockHash.Fingerprint(),
+		len(m.ChunkHashes))
+}
+
+// Snapshot keeps the chunks of a snapshot.
+// A snapshot is either made from the state data, or downloaded chunk by chunk from other peers.
+type Snapshot struct {
+	lk deadlock.RWMutex
+
+	manifest Manifest
+	chunks   [][]byte
+}
+
+// NewSnapshot splits the data into chunks and makes a complete snapshot
+func NewSnapshot(height int, blockHash crypto.Hash, data []byte, chunkSize int) *Snapshot {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	s := &Snapshot{
+		manifest: Manifest{
+			Height:    height,
+			BlockHash: blockHash,
+		},
+	}
+	for start := 0; start < len(data) || start == 0; start += chunkSize {
+		end := start + chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk := data[start:end]
+		s.chunks = append(s.chunks, chunk)
+		s.manifest.ChunkHashes = append(s.manifest.ChunkHashes, crypto.HashH(chunk))
+	}
+	return s
+}
+
+// NewSnapshotFromManifest makes an empty snapshot, that chunks can be added to it later
+func NewSnapshotFromManifest(manifest Manifest) (*Snapshot, error) {
+	if err := manifest.SanityCheck(); err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		manifest: manifest,
+		chunks:   make([][]byte, len(manifest.ChunkHashes)),
+	}, nil
+}
+
+func (s *Snapshot) Manifest() Manifest {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.manifest
+}
+
+func (s *Snapshot) Height() int {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.manifest.Height
+}
+
+func (s *Snapshot) BlockHash() crypto.Hash {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.manifest.BlockHash
+}
+
+// Chunk returns the chunk at the given index, or nil if we don't have it
+func (s *Snapshot) Chunk(index int) []byte {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	if index < 0 || index >= len(s.chunks) {
+		return nil
+	}
+	return s.chunks[index]
+}
+
+// AddChunk adds a chunk to the snapshot, after checking its hash against the manifest
+func (s *Snapshot) AddChunk(index int, data []byte) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if index < 0 || index >= len(s.chunks) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Chunk index is out of range: %v", index)
+	}
+	if !crypto.HashH(data).EqualsTo(s.manifest.ChunkHashes[index]) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Chunk hash is not same as we expected, index: %v", index)
+	}
+	s.chunks[index] = data
+	return nil
+}
+
+// MissingChunks returns the indexes of the chunks that we don't have yet
+func (s *Snapshot) MissingChunks() []int {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	missing := make([]int, 0)
+	for i, c := range s.chunks {
+		if c == nil {
+			missing = append(missing, i)
+		}
+	}
+	return missing
+}
+
+func (s *Snapshot) IsComplete() bool {
+	return len(s.MissingChunks()) == 0
+}
+
+// Data joins the chunks together
+func (s *Snapshot) Data() ([]byte, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	data := make([]byte, 0)
+	for i, c := range s.chunks {
+		if c == nil {
+			return nil, errors.Errorf(errors.ErrInvalidSnapshot, "Chunk %v is missing", i)
+		}
+		data = append(data, c...)
+	}
+	return data, nil
+}
+
+func (s *Snapshot) Fingerprint() string {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.manifest.Fingerprint()
+}
@@ -0,0 +1,69 @@
+package snapshot
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+func randomData(size int) []byte {
+	data := make([]byte, size)
+	_, _ = rand.Read(data)
+	return data
+}
+
+func TestSnapshotChunks(t *testing.T) {
+	data := randomData(1000)
+	blockHash := crypto.GenerateTestHash()
+
+	s1 := NewSnapshot(10, blockHash, data, 300)
+	m := s1.Manifest()
+	assert.NoError(t, m.SanityCheck())
+	assert.Equal(t, len(m.ChunkHashes), 4)
+	assert.True(t, s1.IsComplete())
+	assert.Len(t, s1.Chunk(3), 100)
+	assert.Nil(t, s1.Chunk(4))
+
+	s2, err := NewSnapshotFromManifest(m)
+	require.NoError(t, err)
+	assert.Equal(t, s2.Height(), 10)
+	assert.Equal(t, s2.BlockHash(), blockHash)
+	assert.False(t, s2.IsComplete())
+	assert.Equal(t, s2.MissingChunks(), []int{0, 1, 2, 3})
+	_, err = s2.Data()
+	assert.Error(t, err)
+
+	assert.Error(t, s2.AddChunk(0, s1.Chunk(1)))
+	assert.Error(t, s2.AddChunk(4, s1.Chunk(3)))
+
+	for i := 3; i >= 0; i-- {
+		assert.NoError(t, s2.AddChunk(i, s1.Chunk(i)))
+	}
+	assert.True(t, s2.IsComplete())
+	d, err := s2.Data()
+	assert.NoError(t, err)
+	assert.Equal(t, d, data)
+}
+
+func TestManifestSanityCheck(t *testing.T) {
+	s := NewSnapshot(10, crypto.GenerateTestHash(), randomData(10), 0)
+	m := s.Manifest()
+	assert.Equal(t, len(m.ChunkHashes), 1)
+
+	m1 := m
+	m1.Height = 0
+	assert.Error(t, m1.SanityCheck())
+	_, err := NewSnapshotFromManifest(m1)
+	assert.Error(t, err)
+
+	m2 := m
+	m2.ChunkHashes = nil
+	assert.Error(t, m2.SanityCheck())
+
+	m3 := m
+	m3.BlockHash = crypto.UndefHash
+	assert.Error(t, m3.SanityCheck())
+}
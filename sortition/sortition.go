@@ -43,7 +43,7 @@ func (s *Sortition) TotalStake() int64 {
 	return s.vrf.Max()
 }
 
-func (s *Sortition) EvaluateTransaction(hash crypto.Hash, val *validator.Validator) *tx.Tx {
+func (s *Sortition) EvaluateTransaction(version int, hash crypto.Hash, val *validator.Validator) *tx.Tx {
 	s.lk.RLock()
 	defer s.lk.RUnlock()
 
@@ -57,7 +57,7 @@ func (s *Sortition) EvaluateTransaction(hash crypto.Hash, val *validator.Validat
 	}
 
 	pub := s.signer.PublicKey()
-	trx := tx.NewSortitionTx(hash, val.Sequence()+1, val.Address(), proof, "", &pub, nil)
+	trx := tx.NewSortitionTx(version, hash, val.Sequence()+1, val.Address(), proof, "", &pub, nil)
 	s.signer.SignMsg(trx)
 	return trx
 }
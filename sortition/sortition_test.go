@@ -25,11 +25,12 @@ func TestEvaluationTotalStakeZero(t *testing.T) {
 	invHash := crypto.GenerateTestHash()
 
 	invSortition := NewSortition(crypto.NewSigner(invPriv))
-	trx := invSortition.EvaluateTransaction(h, val)
+	trx := invSortition.EvaluateTransaction(1, h, val)
 	require.Nil(t, trx)
 
-	trx = s.EvaluateTransaction(h, val)
+	trx = s.EvaluateTransaction(2, h, val)
 	require.NotNil(t, trx)
+	assert.Equal(t, trx.Version(), 2)
 	proof := trx.Payload().(*payload.SortitionPayload).Proof
 	assert.True(t, s.VerifyProof(h, proof, val))
 	assert.False(t, s.VerifyProof(invHash, proof, val))
@@ -56,7 +57,7 @@ func TestEvaluationTotalStakeNotZero(t *testing.T) {
 		i := 0
 		for ; ; i++ {
 			h = crypto.GenerateTestHash()
-			trx = s.EvaluateTransaction(h, val)
+			trx = s.EvaluateTransaction(1, h, val)
 			if trx != nil {
 				break
 			}
@@ -2,6 +2,7 @@ package state
 
 import (
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/store"
 )
 
@@ -9,6 +10,11 @@ import (
 type Config struct {
 	MintbaseAddress *crypto.Address
 	Store           *store.Config
+	// Upgrades overlays the upgrade schedule of the genesis
+	Upgrades param.UpgradeSchedule
+	// SnapshotInterval is the number of blocks between two state snapshots.
+	// Snapshots are served to the new nodes to bootstrap their state. Zero disables it.
+	SnapshotInterval int
 }
 
 // DefaultConfig instantiates the default configuration for the node
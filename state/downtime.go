@@ -0,0 +1,90 @@
+package state
+
+import (
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+// signingRecord keeps the commit of a block, to track the missed signatures
+type signingRecord struct {
+	height int
+	commit *block.Commit
+}
+
+// recordCommit adds the commit of the given height to the signing window and drops the old ones
+func (st *state) recordCommit(height int, commit *block.Commit) {
+	st.signingWindow = append(st.signingWindow, signingRecord{height: height, commit: commit})
+
+	i := 0
+	for ; i < len(st.signingWindow); i++ {
+		if st.signingWindow[i].height > height-st.params.DowntimeWindow {
+			break
+		}
+	}
+	st.signingWindow = st.signingWindow[i:]
+}
+
+// loadSigningWindow rebuilds the signing window from the last commits of the stored blocks
+func (st *state) loadSigningWindow() error {
+	from := st.lastBlockHeight - st.params.DowntimeWindow + 1
+	if from < 1 {
+		from = 1
+	}
+	for h := from; h <= st.lastBlockHeight; h++ {
+		b, err := st.store.Block(h)
+		if err != nil {
+			return err
+		}
+		if b.LastCommit() != nil {
+			st.recordCommit(h-1, b.LastCommit())
+		}
+	}
+	return nil
+}
+
+// missedSignatures counts the commits in the signing window after the given height,
+// that the validator was a committer but didn't sign
+func (st *state) missedSignatures(addr crypto.Address, after int) int {
+	missed := 0
+	for _, r := range st.signingWindow {
+		if r.height <= after {
+			continue
+		}
+		for _, c := range r.commit.Committers() {
+			if c.Address.EqualsTo(addr) && !c.HasSigned() {
+				missed++
+			}
+		}
+	}
+	return missed
+}
+
+// jailOfflineValidators jails the validators in the set that have missed too many signatures.
+// Jailed validators leave the set at the next height.
+// The sandbox limits the number of validators that leave the set at each height,
+// including the unbonded and slashed ones.
+func (st *state) jailOfflineValidators() {
+	window := st.params.DowntimeWindow
+	if window == 0 {
+		return
+	}
+	maxMissed := window * (100 - st.params.MinimumSigningPercentage) / 100
+	curHeight := st.executionSandbox.CurrentHeight()
+
+	for _, addr := range st.validatorSet.Validators() {
+		val := st.executionSandbox.Validator(addr)
+		if val == nil {
+			continue
+		}
+		missed := st.missedSignatures(addr, val.JailedHeight())
+		if missed > maxMissed {
+			if err := st.executionSandbox.LeaveSet(addr); err != nil {
+				st.logger.Debug("Unable to jail the validator", "address", addr, "err", err)
+				break
+			}
+			st.logger.Info("Validator is jailed for missing signatures", "address", addr, "missed", missed)
+			val.Jail(curHeight)
+			st.executionSandbox.UpdateValidator(val)
+		}
+	}
+}
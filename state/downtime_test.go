@@ -0,0 +1,121 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/txpool"
+	"github.com/zarbchain/zarb-go/vote"
+)
+
+// makeCommitWithoutSigner4 makes a commit that the fourth validator hasn't signed
+func makeCommitWithoutSigner4(t *testing.T, round int, blockHash crypto.Hash) block.Commit {
+	sigs := make([]*crypto.Signature, 0, 3)
+	for _, s := range []crypto.Signer{tValSigner1, tValSigner2, tValSigner3} {
+		v := vote.NewPrecommit(-1, round, blockHash, s.Address())
+		sigs = append(sigs, s.Sign(v.SignBytes()))
+	}
+	return *block.NewCommit(round, []block.Committer{
+		{Address: tValSigner1.Address(), Status: block.CommitSigned},
+		{Address: tValSigner2.Address(), Status: block.CommitSigned},
+		{Address: tValSigner3.Address(), Status: block.CommitSigned},
+		{Address: tValSigner4.Address(), Status: block.CommitNotSigned},
+	}, crypto.Aggregate(sigs))
+}
+
+func TestJailOfflineValidator(t *testing.T) {
+	st := setupStatewithFourValidators(t, tValSigner1)
+	params := st.params
+	params.DowntimeWindow = 4
+	params.MinimumSigningPercentage = 50
+	params.JailPeriod = 10
+	st.applyParams(params)
+
+	for h := 1; h <= 3; h++ {
+		b := st.ProposeBlock()
+		c := makeCommitWithoutSigner4(t, 0, b.Hash())
+		require.NoError(t, st.ApplyBlock(h, b, c))
+		assert.True(t, st.validatorSet.Contains(tValSigner4.Address()))
+	}
+	// Commits of height 1 and 2 are in the window
+	assert.Equal(t, st.missedSignatures(tValSigner4.Address(), 0), 2)
+	assert.Equal(t, st.missedSignatures(tValSigner1.Address(), 0), 0)
+
+	b4 := st.ProposeBlock()
+	c4 := makeCommitWithoutSigner4(t, 0, b4.Hash())
+	require.NoError(t, st.ApplyBlock(4, b4, c4))
+
+	val4, err := st.store.Validator(tValSigner4.Address())
+	require.NoError(t, err)
+	assert.Equal(t, val4.JailedHeight(), st.executionSandbox.CurrentHeight()-1)
+	assert.True(t, val4.IsJailed(val4.JailedHeight()+9, params.JailPeriod))
+	assert.False(t, val4.IsJailed(val4.JailedHeight()+10, params.JailPeriod))
+	assert.False(t, st.validatorSet.Contains(tValSigner4.Address()))
+	assert.Equal(t, st.validatorSet.Power(), 3)
+
+	// Missed signatures before jailing are not counted anymore
+	assert.Equal(t, st.missedSignatures(tValSigner4.Address(), val4.JailedHeight()), 0)
+
+	t.Run("Signing window should be loaded after restart", func(t *testing.T) {
+		require.NoError(t, st.Close())
+
+		st2, err := LoadOrNewState(st.config, st.genDoc, tValSigner1, txpool.NewMockTxPool())
+		require.NoError(t, err)
+		window := st2.(*state).signingWindow
+		require.Equal(t, len(window), len(st.signingWindow))
+		for i, r := range window {
+			assert.Equal(t, r.height, st.signingWindow[i].height)
+			assert.Equal(t, r.commit.Hash(), st.signingWindow[i].commit.Hash())
+		}
+	})
+}
+
+func TestSigningWindow(t *testing.T) {
+	st := setupStatewithOneValidator(t)
+	params := st.params
+	params.DowntimeWindow = 3
+	st.applyParams(params)
+
+	c := makeCommitAndSign(t, crypto.GenerateTestHash(), tValSigner1)
+	for h := 1; h <= 5; h++ {
+		st.recordCommit(h, &c)
+	}
+	require.Equal(t, len(st.signingWindow), 3)
+	assert.Equal(t, st.signingWindow[0].height, 3)
+	assert.Equal(t, st.signingWindow[2].height, 5)
+}
+
+func TestRestartAfterJailingInLaterRound(t *testing.T) {
+	st := setupStatewithFourValidators(t, tValSigner1)
+	params := st.params
+	params.DowntimeWindow = 4
+	params.MinimumSigningPercentage = 50
+	params.JailPeriod = 10
+	st.applyParams(params)
+
+	for h := 1; h <= 3; h++ {
+		b := st.ProposeBlock()
+		c := makeCommitWithoutSigner4(t, 0, b.Hash())
+		require.NoError(t, st.ApplyBlock(h, b, c))
+	}
+
+	// The fourth validator is the proposer of the first round, it is offline.
+	// The first validator proposes the block in the second round, and the fourth validator is jailed.
+	assert.Equal(t, st.validatorSet.Proposer(0).Address(), tValSigner4.Address())
+	assert.Equal(t, st.validatorSet.Proposer(1).Address(), tValSigner1.Address())
+	b4 := st.ProposeBlock()
+	c4 := makeCommitWithoutSigner4(t, 1, b4.Hash())
+	require.NoError(t, st.ApplyBlock(4, b4, c4))
+	assert.False(t, st.validatorSet.Contains(tValSigner4.Address()))
+
+	require.NoError(t, st.Close())
+	st2, err := LoadOrNewState(st.config, st.genDoc, tValSigner1, txpool.NewMockTxPool())
+	require.NoError(t, err)
+	valSet := st2.(*state).validatorSet
+	assert.Equal(t, valSet.Validators(), st.validatorSet.Validators())
+	assert.Equal(t, valSet.Proposer(0).Address(), st.validatorSet.Proposer(0).Address())
+	assert.Equal(t, valSet.Proposer(1).Address(), st.validatorSet.Proposer(1).Address())
+}
@@ -0,0 +1,70 @@
+package state
+
+import (
+	"sort"
+
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/execution"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+// DryRunResult is the outcome of executing a transaction against the current state
+type DryRunResult struct {
+	Receipt *tx.Receipt
+	// ExpectedFee is the fee that the transaction should pay
+	ExpectedFee int64
+	// ExpectedSequence is the sequence that the transaction should have, it is zero if the signer doesn't exist
+	ExpectedSequence int
+	// Accounts and Validators are the ones that would be updated by the transaction
+	Accounts   []*account.Account
+	Validators []*validator.Validator
+	// Error is the reason of failure, it is nil for successful transactions
+	Error error
+}
+
+// DryRunTx executes the transaction on a throwaway sandbox.
+// The transaction can be unsigned and nothing is changed in the state.
+func (st *state) DryRunTx(trx *tx.Tx) (*DryRunResult, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	sb := st.txPoolSandbox.Clone()
+	exe := execution.NewExecution(sb)
+
+	res := &DryRunResult{
+		ExpectedFee:      exe.ExpectedFee(trx),
+		ExpectedSequence: exe.ExpectedSequence(trx),
+	}
+
+	res.Receipt = trx.GenerateReceipt(tx.Ok, crypto.UndefHash)
+	if err := exe.DryRun(trx); err != nil {
+		res.Error = err
+		res.Receipt.SetError(err)
+	} else {
+		res.Receipt.SetExecutionResult(st.lastBlockHeight+1, 0, exe.Fee(), exe.BalanceChanges())
+	}
+
+	sb.IterateAccounts(func(as *sandbox.AccountStatus) {
+		if as.Updated {
+			acc := as.Account
+			res.Accounts = append(res.Accounts, &acc)
+		}
+	})
+	sb.IterateValidators(func(vs *sandbox.ValidatorStatus) {
+		if vs.Updated {
+			val := vs.Validator
+			res.Validators = append(res.Validators, &val)
+		}
+	})
+	sort.Slice(res.Accounts, func(i, j int) bool {
+		return res.Accounts[i].Number() < res.Accounts[j].Number()
+	})
+	sort.Slice(res.Validators, func(i, j int) bool {
+		return res.Validators[i].Number() < res.Validators[j].Number()
+	})
+
+	return res, nil
+}
@@ -0,0 +1,62 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+func TestDryRunTx(t *testing.T) {
+	st := setupStatewithFourValidators(t, tValSigner1)
+
+	t.Run("Unknown sender", func(t *testing.T) {
+		sender, _, _ := crypto.GenerateTestKeyPair()
+		receiver, _, _ := crypto.GenerateTestKeyPair()
+		trx := tx.NewSendTx(st.lastBlockHash, 1, sender, receiver, 1000, 1000, "", nil, nil)
+
+		res, err := st.DryRunTx(trx)
+		assert.NoError(t, err)
+		assert.Error(t, res.Error)
+		assert.Equal(t, res.Receipt.Status(), tx.Failed)
+		assert.Equal(t, res.Receipt.ErrorCode(), errors.ErrInvalidTx)
+		assert.Empty(t, res.Receipt.BalanceChanges())
+		assert.Equal(t, res.ExpectedSequence, 0)
+		assert.Equal(t, res.ExpectedFee, int64(1000))
+		assert.Empty(t, res.Accounts)
+	})
+
+	t.Run("Subsidy transaction", func(t *testing.T) {
+		trx := st.createSubsidyTx(0)
+
+		res, err := st.DryRunTx(trx)
+		assert.NoError(t, err)
+		assert.NoError(t, res.Error)
+		assert.Equal(t, res.Receipt.Status(), tx.Ok)
+		assert.Equal(t, res.Receipt.TxID(), trx.ID())
+		assert.Equal(t, res.ExpectedSequence, trx.Sequence())
+		assert.Equal(t, res.ExpectedFee, int64(0))
+		assert.Equal(t, len(res.Accounts), 2)
+		assert.Equal(t, res.Accounts[0].Address(), crypto.TreasuryAddress)
+		assert.Equal(t, res.Accounts[1].Balance(), trx.Payload().Value())
+		assert.Equal(t, res.Receipt.Height(), st.lastBlockHeight+1)
+		assert.Equal(t, len(res.Receipt.BalanceChanges()), 2)
+	})
+
+	t.Run("Unsigned unbond transaction", func(t *testing.T) {
+		trx := tx.NewUnbondTx(st.lastBlockHash, 1, tValSigner1.Address(), "", nil, nil)
+
+		res, err := st.DryRunTx(trx)
+		assert.NoError(t, err)
+		assert.NoError(t, res.Error)
+		assert.Equal(t, res.ExpectedSequence, 1)
+		assert.Equal(t, len(res.Validators), 1)
+		assert.Equal(t, res.Validators[0].UnbondingHeight(), st.executionSandbox.CurrentHeight())
+
+		// State should not be changed
+		val, _ := st.store.Validator(tValSigner1.Address())
+		assert.Equal(t, val.UnbondingHeight(), 0)
+	})
+}
@@ -11,6 +11,7 @@ func (st *state) executeBlock(block block.Block) ([]tx.CommittedTx, error) {
 	st.executionSandbox.Clear()
 	st.execution.ResetFee()
 
+	height := st.lastBlockHeight + 1
 	ids := block.TxIDs().IDs()
 	twrs := make([]tx.CommittedTx, len(ids))
 	var subsidyTrx *tx.Tx
@@ -37,13 +38,14 @@ func (st *state) executeBlock(block block.Block) ([]tx.CommittedTx, error) {
 			return nil, err
 		}
 		receipt := trx.GenerateReceipt(tx.Ok, block.Hash())
+		receipt.SetExecutionResult(height, i, st.execution.Fee(), st.execution.BalanceChanges())
 		twrs[i].Tx = trx
 		twrs[i].Receipt = receipt
 	}
 
-	subsidyAmt := calcBlockSubsidy(st.lastBlockHeight+1, st.params.SubsidyReductionInterval) + st.execution.AccumulatedFee()
-	if subsidyTrx.Payload().Value() != subsidyAmt {
-		return nil, errors.Errorf(errors.ErrInvalidTx, "Invalid subsidy amount. Expected %v, got %v", subsidyAmt, subsidyTrx.Payload().Value())
+	subsidyAmt := calcBlockSubsidy(height, st.params.SubsidyReductionInterval) + st.execution.AccumulatedFee()
+	if err := st.validateSubsidyTx(subsidyTrx, subsidyAmt, block.LastCommit()); err != nil {
+		return nil, err
 	}
 
 	return twrs, nil
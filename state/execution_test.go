@@ -1,12 +1,16 @@
 package state
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/message"
+	"github.com/zarbchain/zarb-go/txpool"
 	"github.com/zarbchain/zarb-go/util"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/tx"
 )
@@ -18,7 +22,7 @@ func TestProposeBlock(t *testing.T) {
 	assert.NoError(t, st1.ApplyBlock(1, b1, c1))
 
 	subsidy := calcBlockSubsidy(st1.LastBlockHeight(), st1.params.SubsidyReductionInterval)
-	invSubsidyTx := tx.NewSubsidyTx(st1.LastBlockHash(), 1, tValSigner2.Address(), subsidy, "")
+	invSubsidyTx := tx.NewSubsidyTx(1, st1.LastBlockHash(), 1, tValSigner2.Address(), subsidy, "")
 	invSendTx, _ := tx.GenerateTestSendTx()
 	invBondTx, _ := tx.GenerateTestBondTx()
 	invSortitionTx, _ := tx.GenerateTestSortitionTx()
@@ -66,7 +70,7 @@ func TestExecuteBlock(t *testing.T) {
 	t.Run("Subsidy tx is invalid", func(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(invSubsidyTx.ID())
-		invBlock := block.MakeBlock(util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
+		invBlock := block.MakeBlock(1, util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
 		_, err := st.executeBlock(invBlock)
 		assert.Error(t, err)
 	})
@@ -75,7 +79,7 @@ func TestExecuteBlock(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(validSubsidyTx.ID())
 		txIDs.Append(invSendTx.ID())
-		invBlock := block.MakeBlock(util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
+		invBlock := block.MakeBlock(1, util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
 		_, err := st.executeBlock(invBlock)
 		assert.Error(t, err)
 	})
@@ -84,7 +88,7 @@ func TestExecuteBlock(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(validTx1.ID())
 		txIDs.Append(validSubsidyTx.ID())
-		invBlock := block.MakeBlock(util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
+		invBlock := block.MakeBlock(1, util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
 		_, err := st.executeBlock(invBlock)
 		assert.Error(t, err)
 	})
@@ -92,7 +96,7 @@ func TestExecuteBlock(t *testing.T) {
 	t.Run("Has no subsidy", func(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(validTx1.ID())
-		invBlock := block.MakeBlock(util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
+		invBlock := block.MakeBlock(1, util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
 		_, err := st.executeBlock(invBlock)
 		assert.Error(t, err)
 	})
@@ -101,8 +105,59 @@ func TestExecuteBlock(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(validSubsidyTx.ID())
 		txIDs.Append(validTx1.ID())
-		invBlock := block.MakeBlock(util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
+		invBlock := block.MakeBlock(1, util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
 		_, err := st.executeBlock(invBlock)
 		assert.NoError(t, err)
 	})
 }
+
+func TestExecuteBlockWithConflictingPoolTx(t *testing.T) {
+	st1 := setupStatewithOneValidator(t)
+
+	// The second node has a real pool, the first node serves the requested transactions
+	ch := make(chan *message.Message, 10)
+	pool, _ := txpool.NewTxPool(txpool.TestConfig(), ch)
+	st, err := LoadOrNewState(TestConfig(), st1.genDoc, tValSigner1, pool)
+	require.NoError(t, err)
+	st2 := st.(*state)
+	served := new(sync.Map)
+	go func() {
+		for msg := range ch {
+			if msg.PayloadType() != message.PayloadTypeTxsReq {
+				continue
+			}
+			for _, id := range msg.Payload.(*message.TxsReqPayload).IDs {
+				if trx, ok := served.Load(id); ok {
+					assert.NoError(t, pool.AppendTxFrom(trx.(*tx.Tx), "peer"))
+				}
+			}
+		}
+	}()
+	serveBlockTxs := func(b block.Block) {
+		for _, id := range b.TxIDs().IDs() {
+			served.Store(id, st1.txPool.PendingTx(id))
+		}
+	}
+
+	b1, c1 := proposeAndSignBlock(t, st1, tValSigner1)
+	serveBlockTxs(b1)
+	require.NoError(t, st1.ApplyBlock(1, b1, c1))
+	require.NoError(t, st2.ApplyBlock(1, b1, c1))
+
+	// Two transactions with the same sequence and fee
+	pub := tValSigner1.PublicKey()
+	trx1 := tx.NewSendTx(b1.Hash(), 1, tValSigner1.Address(), tValSigner2.Address(), 1000, 1000, "", &pub, nil)
+	tValSigner1.SignMsg(trx1)
+	trx2 := tx.NewSendTx(b1.Hash(), 1, tValSigner1.Address(), tValSigner3.Address(), 1000, 1000, "", &pub, nil)
+	tValSigner1.SignMsg(trx2)
+	assert.NoError(t, st1.txPool.AppendTx(trx1))
+	assert.NoError(t, pool.AppendTxFrom(trx2, "peer"))
+
+	b2, c2 := proposeAndSignBlock(t, st1, tValSigner1)
+	assert.Contains(t, b2.TxIDs().IDs(), trx1.ID())
+	serveBlockTxs(b2)
+	require.NoError(t, st1.ApplyBlock(2, b2, c2))
+	require.NoError(t, st2.ApplyBlock(2, b2, c2))
+	assert.Equal(t, st1.stateHash(), st2.stateHash())
+	assert.False(t, pool.HasTx(trx2.ID()))
+}
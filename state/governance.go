@@ -0,0 +1,54 @@
+package state
+
+import (
+	"github.com/zarbchain/zarb-go/governance"
+	"github.com/zarbchain/zarb-go/param"
+)
+
+// activateProposals applies the parameters of the passed proposals that are activated at the next height.
+// If more than one proposal passes, the last one wins.
+func (st *state) activateProposals() {
+	height := st.lastBlockHeight + 1
+
+	candidates := make([]*governance.Proposal, 0)
+	st.store.IterateProposalsAt(height, func(p *governance.Proposal) (stop bool) {
+		candidates = append(candidates, p)
+		return false
+	})
+
+	var passed *governance.Proposal
+	for _, p := range candidates {
+		if !st.isProposalPassed(p) {
+			st.logger.Info("Proposal is rejected", "proposal", p)
+			continue
+		}
+		if passed == nil || p.Number() > passed.Number() {
+			passed = p
+		}
+	}
+
+	if passed != nil {
+		st.logger.Info("Proposal is passed, new parameters are activated", "proposal", passed, "height", height)
+		passed.Pass()
+		st.store.UpdateProposal(passed)
+		st.store.SaveParams(height, passed.Params())
+		st.applyParams(passed.Params())
+	}
+}
+
+// isProposalPassed checks if more than 2/3 of the validator set has voted for the proposal
+func (st *state) isProposalPassed(p *governance.Proposal) bool {
+	votes := 0
+	for _, addr := range p.Voters() {
+		if st.validatorSet.Contains(addr) {
+			votes++
+		}
+	}
+	return votes*3 > st.validatorSet.Power()*2
+}
+
+func (st *state) applyParams(params param.Params) {
+	st.params = params
+	st.executionSandbox.SetParams(params)
+	st.txPoolSandbox.SetParams(params)
+}
@@ -0,0 +1,82 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/governance"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/txpool"
+)
+
+func TestParamChangeProposal(t *testing.T) {
+	st := setupStatewithOneValidator(t)
+
+	b1, c1 := proposeAndSignBlock(t, st, tValSigner1)
+	require.NoError(t, st.ApplyBlock(1, b1, c1))
+
+	params := st.params
+	params.MinimumFee = 2000
+	params.MaximumTransactionPerBlock = 500
+	pub := tValSigner1.PublicKey()
+	trx := tx.NewParamProposalTx(st.lastBlockHash, 1, tValSigner1.Address(), params, 4, "", &pub, nil)
+	tValSigner1.SignMsg(trx)
+	require.NoError(t, st.txPool.AppendTx(trx))
+
+	b2, c2 := proposeAndSignBlock(t, st, tValSigner1)
+	require.NoError(t, st.ApplyBlock(2, b2, c2))
+
+	p, err := st.store.Proposal(trx.ID())
+	require.NoError(t, err)
+	assert.True(t, p.HasVoted(tValSigner1.Address()))
+	assert.Equal(t, st.params.MinimumFee, int64(1000))
+
+	b3, c3 := proposeAndSignBlock(t, st, tValSigner1)
+	require.NoError(t, st.ApplyBlock(3, b3, c3))
+
+	// Activated at height 4
+	assert.Equal(t, st.params, params)
+	assert.Equal(t, st.executionSandbox.MinFee(), int64(2000))
+	assert.Equal(t, st.txPoolSandbox.MinFee(), int64(2000))
+	saved, ok := st.store.Params(4)
+	assert.True(t, ok)
+	assert.Equal(t, saved, params)
+	_, ok = st.store.Params(3)
+	assert.False(t, ok)
+	p, err = st.store.Proposal(trx.ID())
+	require.NoError(t, err)
+	assert.True(t, p.IsPassed())
+
+	b4, c4 := proposeAndSignBlock(t, st, tValSigner1)
+	require.NoError(t, st.ApplyBlock(4, b4, c4))
+
+	t.Run("Parameters should be loaded after restart", func(t *testing.T) {
+		require.NoError(t, st.Close())
+
+		st2, err := LoadOrNewState(st.config, st.genDoc, tValSigner1, txpool.NewMockTxPool())
+		require.NoError(t, err)
+		assert.Equal(t, st2.(*state).params, params)
+		assert.Equal(t, st2.(*state).executionSandbox.MinFee(), int64(2000))
+	})
+}
+
+func TestProposalVoting(t *testing.T) {
+	st := setupStatewithFourValidators(t, tValSigner1)
+
+	p := governance.GenerateTestProposal(0)
+	assert.False(t, st.isProposalPassed(p))
+
+	p.AddVote(tValSigner1.Address())
+	p.AddVote(tValSigner2.Address())
+	assert.False(t, st.isProposalPassed(p))
+
+	// Not in the set
+	addr, _, _ := crypto.GenerateTestKeyPair()
+	p.AddVote(addr)
+	assert.False(t, st.isProposalPassed(p))
+
+	p.AddVote(tValSigner3.Address())
+	assert.True(t, st.isProposalPassed(p))
+}
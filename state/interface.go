@@ -3,9 +3,13 @@ package state
 import (
 	"time"
 
+	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/snapshot"
 	"github.com/zarbchain/zarb-go/store"
+	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
@@ -14,12 +18,18 @@ type StateReader interface {
 	ValidatorSet() validator.ValidatorSetReader
 	LastBlockHeight() int
 	GenesisHash() crypto.Hash
+	ChainName() string
 	LastBlockHash() crypto.Hash
 	LastBlockTime() time.Time
 	LastCommit() *block.Commit
 	BlockTime() time.Duration
 	UpdateLastCommit(lastCommit *block.Commit) error
+	DryRunTx(trx *tx.Tx) (*DryRunResult, error)
 	Fingerprint() string
+	AccountProof(addr crypto.Address) (*account.Account, *simpleMerkle.Proof, int, error)
+	ValidatorProof(addr crypto.Address) (*validator.Validator, *simpleMerkle.Proof, int, error)
+	TxProof(id crypto.Hash) (*simpleMerkle.Proof, error)
+	LastSnapshot() *snapshot.Snapshot
 }
 
 type State interface {
@@ -29,4 +39,5 @@ type State interface {
 	ProposeBlock() block.Block
 	ValidateBlock(block block.Block) error
 	ApplyBlock(height int, block block.Block, commit block.Commit) error
+	RestoreSnapshot(snap *snapshot.Snapshot, nextHeader block.Header) error
 }
@@ -15,7 +15,7 @@ type lastInfo struct {
 	LastReceiptHash *crypto.Hash
 }
 
-func (st *state) saveLastInfo(height int, commit *block.Commit, lastReceiptHash *crypto.Hash) {
+func (st *state) saveLastInfo(height int, commit *block.Commit, lastReceiptHash *crypto.Hash) error {
 	path := st.config.Store.Path + "/last_info.json"
 	li := lastInfo{
 		LastHeight:      height,
@@ -23,11 +23,11 @@ func (st *state) saveLastInfo(height int, commit *block.Commit, lastReceiptHash
 		LastReceiptHash: lastReceiptHash,
 	}
 
-	bs, _ := json.Marshal(&li)
-
-	if err := util.WriteFile(path, bs); err != nil {
-		st.logger.Error("Unable to write last sate info", "err", err)
+	bs, err := json.Marshal(&li)
+	if err != nil {
+		return err
 	}
+	return util.WriteFile(path, bs)
 }
 
 func (st *state) loadLastInfo() (int, *block.Commit, *crypto.Hash, error) {
@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
@@ -66,3 +69,94 @@ func TestChangeVal(t *testing.T) {
 
 	assert.NotEqual(t, root1, root2)
 }
+
+func TestChangeEscrow(t *testing.T) {
+	st1 := setupStatewithOneValidator(t)
+	st2 := setupStatewithOneValidator(t)
+
+	require.Equal(t, st1.store.TotalEscrows(), 0)
+	hash0 := st1.stateHash()
+
+	esc1 := escrow.GenerateTestEscrow(0)
+	esc2 := escrow.GenerateTestEscrow(1)
+
+	st1.store.UpdateEscrow(esc1)
+	st1.store.UpdateEscrow(esc2)
+	root1 := st1.escrowsMerkleRootHash()
+	assert.NotEqual(t, hash0, st1.stateHash())
+
+	// Claim an escrow
+	esc2.Claim()
+
+	st2.store.UpdateEscrow(esc2)
+	st2.store.UpdateEscrow(esc1)
+	root2 := st2.escrowsMerkleRootHash()
+
+	assert.NotEqual(t, root1, root2)
+	assert.NotEqual(t, st1.stateHash(), st2.stateHash())
+}
+
+func TestStateProofs(t *testing.T) {
+	st := setupStatewithOneValidator(t)
+
+	// Add some accounts and validators to have deeper trees
+	for i := 1; i < 6; i++ {
+		acc, _ := account.GenerateTestAccount(i)
+		st.store.UpdateAccount(acc)
+		val, _ := validator.GenerateTestValidator(i)
+		st.store.UpdateValidator(val)
+	}
+	st.store.UpdateEscrow(escrow.GenerateTestEscrow(0))
+
+	blocks := []block.Block{}
+	for i := 0; i < 3; i++ {
+		b, c := proposeAndSignBlock(t, st, tValSigner1)
+		require.NoError(t, st.ApplyBlock(i+1, b, c))
+		blocks = append(blocks, b)
+	}
+	// The state hash is committed in the next block
+	stateHash := st.ProposeBlock().Header().StateHash()
+	require.Equal(t, stateHash, st.stateHash())
+
+	t.Run("Account proof", func(t *testing.T) {
+		acc, proof, height, err := st.AccountProof(tValSigner1.Address())
+		require.NoError(t, err)
+		assert.Equal(t, height, 3)
+		assert.True(t, proof.Verify(acc.Hash(), stateHash))
+
+		acc.AddToBalance(1)
+		assert.False(t, proof.Verify(acc.Hash(), stateHash))
+	})
+
+	t.Run("Validator proof", func(t *testing.T) {
+		val, proof, height, err := st.ValidatorProof(tValSigner1.Address())
+		require.NoError(t, err)
+		assert.Equal(t, height, 3)
+		assert.True(t, proof.Verify(val.Hash(), stateHash))
+
+		// Validator hash can't be proved as an account
+		acc, err := st.store.Account(tValSigner1.Address())
+		require.NoError(t, err)
+		assert.False(t, proof.Verify(acc.Hash(), stateHash))
+	})
+
+	t.Run("Transaction proof", func(t *testing.T) {
+		for _, b := range blocks {
+			for _, id := range b.TxIDs().IDs() {
+				proof, err := st.TxProof(id)
+				require.NoError(t, err)
+				assert.True(t, proof.Verify(id, b.Header().TxIDsHash()))
+			}
+		}
+	})
+
+	t.Run("Unknown entries", func(t *testing.T) {
+		addr, _, _ := crypto.GenerateTestKeyPair()
+		_, _, _, err := st.AccountProof(addr)
+		assert.Error(t, err)
+		_, _, _, err = st.ValidatorProof(addr)
+		assert.Error(t, err)
+		_, err = st.TxProof(crypto.GenerateTestHash())
+		assert.Error(t, err)
+	})
+}
@@ -3,54 +3,121 @@ package state
 import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
 	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
 func (st *state) accountsMerkleRootHash() crypto.Hash {
-	total := st.store.TotalAccounts()
-	hashes := make([]crypto.Hash, total)
-	st.store.IterateAccounts(func(acc *account.Account) (stop bool) {
-		if acc.Number() >= total {
-			panic("Account number is out of range")
-		}
-		if !hashes[acc.Number()].IsUndef() {
-			panic("Duplicated account number")
-		}
-		hashes[acc.Number()] = acc.Hash()
-		return false
-	})
-
-	tree := simpleMerkle.NewTreeFromHashes(hashes)
-	return tree.Root()
+	root, err := st.store.AccountsMerkleRoot()
+	if err != nil {
+		logger.Panic("Unable to calculate the accounts root hash", "err", err)
+	}
+	return root
 }
 
 func (st *state) validatorsMerkleRootHash() crypto.Hash {
-	total := st.store.TotalValidators()
-	hashes := make([]crypto.Hash, total)
-	st.store.IterateValidators(func(val *validator.Validator) (stop bool) {
-		if val.Number() >= total {
-			panic("Validator number is out of range")
-		}
-		if !hashes[val.Number()].IsUndef() {
-			panic("Duplicated validator number")
-		}
-		hashes[val.Number()] = val.Hash()
-		return false
-	})
-	tree := simpleMerkle.NewTreeFromHashes(hashes)
-	return tree.Root()
+	root, err := st.store.ValidatorsMerkleRoot()
+	if err != nil {
+		logger.Panic("Unable to calculate the validators root hash", "err", err)
+	}
+	return root
+}
+
+func (st *state) escrowsMerkleRootHash() crypto.Hash {
+	root, err := st.store.EscrowsMerkleRoot()
+	if err != nil {
+		logger.Panic("Unable to calculate the escrows root hash", "err", err)
+	}
+	return root
+}
+
+func (st *state) proposalsMerkleRootHash() crypto.Hash {
+	root, err := st.store.ProposalsMerkleRoot()
+	if err != nil {
+		logger.Panic("Unable to calculate the proposals root hash", "err", err)
+	}
+	return root
 }
 
 func (st *state) stateHash() crypto.Hash {
 	accRootHash := st.accountsMerkleRootHash()
 	valRootHash := st.validatorsMerkleRootHash()
+	escRootHash := st.escrowsMerkleRootHash()
+	propRootHash := st.proposalsMerkleRootHash()
+
+	return calcStateHash(accRootHash, valRootHash, escRootHash, propRootHash)
+}
 
+func calcStateHash(accRootHash, valRootHash, escRootHash, propRootHash crypto.Hash) crypto.Hash {
 	rootHash := simpleMerkle.HashMerkleBranches(&accRootHash, &valRootHash)
+	rootHash = simpleMerkle.HashMerkleBranches(rootHash, &escRootHash)
+	rootHash = simpleMerkle.HashMerkleBranches(rootHash, &propRootHash)
 	if rootHash == nil {
 		logger.Panic("State hash can't be nil")
 	}
 
 	return *rootHash
 }
+
+// AccountProof returns the account and its inclusion proof against the state hash, at the returned height.
+// The state hash is committed in the header of the next block.
+func (st *state) AccountProof(addr crypto.Address) (*account.Account, *simpleMerkle.Proof, int, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	acc, err := st.store.Account(addr)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	proof, err := st.store.AccountMerkleProof(addr)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	proof.AddSibling(st.validatorsMerkleRootHash(), false)
+	proof.AddSibling(st.escrowsMerkleRootHash(), false)
+	proof.AddSibling(st.proposalsMerkleRootHash(), false)
+	return acc, proof, st.lastBlockHeight, nil
+}
+
+// ValidatorProof returns the validator and its inclusion proof against the state hash, at the returned height.
+// The state hash is committed in the header of the next block.
+func (st *state) ValidatorProof(addr crypto.Address) (*validator.Validator, *simpleMerkle.Proof, int, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	val, err := st.store.Validator(addr)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	proof, err := st.store.ValidatorMerkleProof(addr)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	proof.AddSibling(st.accountsMerkleRootHash(), true)
+	proof.AddSibling(st.escrowsMerkleRootHash(), false)
+	proof.AddSibling(st.proposalsMerkleRootHash(), false)
+	return val, proof, st.lastBlockHeight, nil
+}
+
+// TxProof returns the inclusion proof of the transaction against the transactions hash of its block
+func (st *state) TxProof(id crypto.Hash) (*simpleMerkle.Proof, error) {
+	ctrx, err := st.store.Transaction(id)
+	if err != nil {
+		return nil, err
+	}
+	height, err := st.store.BlockHeight(ctrx.Receipt.BlockHash())
+	if err != nil {
+		return nil, err
+	}
+	b, err := st.store.Block(height)
+	if err != nil {
+		return nil, err
+	}
+	proof := b.TxIDs().Proof(id)
+	if proof == nil {
+		return nil, errors.Errorf(errors.ErrInvalidTx, "Transaction %v is not in block %v", id, height)
+	}
+	return proof, nil
+}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/snapshot"
 	"github.com/zarbchain/zarb-go/store"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
@@ -18,13 +21,17 @@ type MockState struct {
 	LastBlockCommit  *block.Commit
 	GenHash          crypto.Hash
 	Store            *store.MockStore
+	ValSet           *validator.ValidatorSet
 	InvalidBlockHash crypto.Hash
+	Snapshot         *snapshot.Snapshot
 }
 
 func NewMockStore() *MockState {
+	valSet, _ := validator.GenerateTestValidatorSet()
 	return &MockState{
 		GenHash: crypto.GenerateTestHash(),
 		Store:   store.NewMockStore(),
+		ValSet:  valSet,
 	}
 }
 
@@ -32,7 +39,7 @@ func (m *MockState) StoreReader() store.StoreReader {
 	return m.Store
 }
 func (m *MockState) ValidatorSet() validator.ValidatorSetReader {
-	return nil
+	return m.ValSet
 }
 func (m *MockState) LastBlockHeight() int {
 	return m.Store.LastBlockHeight()
@@ -40,6 +47,9 @@ func (m *MockState) LastBlockHeight() int {
 func (m *MockState) GenesisHash() crypto.Hash {
 	return m.GenHash
 }
+func (m *MockState) ChainName() string {
+	return "test"
+}
 func (m *MockState) LastBlockHash() crypto.Hash {
 	return m.Store.Blocks[m.Store.LastBlockHeight()].Hash()
 }
@@ -56,9 +66,59 @@ func (m *MockState) UpdateLastCommit(commit *block.Commit) error {
 	m.LastBlockCommit = commit
 	return nil
 }
+func (m *MockState) DryRunTx(trx *tx.Tx) (*DryRunResult, error) {
+	return &DryRunResult{
+		Receipt:          trx.GenerateReceipt(tx.Ok, crypto.UndefHash),
+		ExpectedFee:      trx.Fee(),
+		ExpectedSequence: trx.Sequence(),
+	}, nil
+}
 func (m *MockState) Fingerprint() string {
 	return ""
 }
+func (m *MockState) AccountProof(addr crypto.Address) (*account.Account, *simpleMerkle.Proof, int, error) {
+	acc, err := m.Store.Account(addr)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return acc, &simpleMerkle.Proof{}, m.LastBlockHeight(), nil
+}
+func (m *MockState) ValidatorProof(addr crypto.Address) (*validator.Validator, *simpleMerkle.Proof, int, error) {
+	val, err := m.Store.Validator(addr)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return val, &simpleMerkle.Proof{}, m.LastBlockHeight(), nil
+}
+func (m *MockState) TxProof(id crypto.Hash) (*simpleMerkle.Proof, error) {
+	ctrx, err := m.Store.Transaction(id)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range m.Store.Blocks {
+		if b.Hash().EqualsTo(ctrx.Receipt.BlockHash()) {
+			if proof := b.TxIDs().Proof(id); proof != nil {
+				return proof, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("Not found")
+}
+func (m *MockState) LastSnapshot() *snapshot.Snapshot {
+	return m.Snapshot
+}
+func (m *MockState) RestoreSnapshot(snap *snapshot.Snapshot, nextHeader block.Header) error {
+	if m.LastBlockHeight() != 0 {
+		return fmt.Errorf("State is not fresh")
+	}
+	if !nextHeader.LastBlockHash().EqualsTo(snap.BlockHash()) {
+		return fmt.Errorf("Invalid snapshot")
+	}
+	b, _ := block.GenerateTestBlock(nil)
+	m.Store.Blocks[snap.Height()] = b
+	m.Snapshot = snap
+	return nil
+}
 func (m *MockState) ApplyBlock(height int, b block.Block, c block.Commit) error {
 	if b.Hash().EqualsTo(m.InvalidBlockHash) {
 		return fmt.Errorf("Invalid block")
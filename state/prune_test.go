@@ -0,0 +1,39 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/txpool"
+)
+
+func TestPruneBlocks(t *testing.T) {
+	st := setupStatewithOneValidator(t)
+	st.config.Store.RetainBlocks = 2
+	params := st.params
+	params.TransactionToLiveInterval = 4
+	params.DowntimeWindow = 3
+	st.store.SaveParams(1, params)
+	st.applyParams(params)
+
+	for h := 1; h <= 10; h++ {
+		b, c := proposeAndSignBlock(t, st, tValSigner1)
+		require.NoError(t, st.ApplyBlock(h, b, c))
+	}
+
+	// Blocks in the transactions' lifetime are kept
+	assert.Eventually(t, func() bool { return st.store.PrunedHeight() == 5 }, time.Second, 10*time.Millisecond)
+	_, err := st.store.Block(5)
+	assert.Error(t, err)
+	_, err = st.store.Block(6)
+	assert.NoError(t, err)
+
+	// Pruned state can be loaded again
+	assert.NoError(t, st.Close())
+	st2, err := LoadOrNewState(st.config, st.genDoc, tValSigner1, txpool.NewMockTxPool())
+	require.NoError(t, err)
+	assert.Equal(t, st2.LastBlockHeight(), 10)
+	assert.Equal(t, st2.LastBlockHash(), st.LastBlockHash())
+}
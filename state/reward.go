@@ -0,0 +1,92 @@
+package state
+
+import (
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+// calcRewards splits the block reward between the committers who signed the last commit and the development fund.
+// It returns their rewards and what is left for the proposer.
+func calcRewards(params param.Params, reward int64, lastCommit *block.Commit) ([]payload.BatchReceiver, int64) {
+	rewards := make([]payload.BatchReceiver, 0)
+	left := reward
+
+	if lastCommit != nil && params.CommitteeRewardPercentage > 0 {
+		signers := make([]crypto.Address, 0)
+		for _, c := range lastCommit.Committers() {
+			if c.HasSigned() {
+				signers = append(signers, c.Address)
+			}
+		}
+		if len(signers) > 0 {
+			share := reward * int64(params.CommitteeRewardPercentage) / 100 / int64(len(signers))
+			for _, addr := range signers {
+				rewards = addReward(rewards, addr, share)
+				left -= share
+			}
+		}
+	}
+
+	if params.FundRewardPercentage > 0 {
+		share := reward * int64(params.FundRewardPercentage) / 100
+		rewards = addReward(rewards, *params.FundAddress, share)
+		left -= share
+	}
+
+	return rewards, left
+}
+
+func addReward(rewards []payload.BatchReceiver, addr crypto.Address, amount int64) []payload.BatchReceiver {
+	if amount == 0 {
+		return rewards
+	}
+	for i, r := range rewards {
+		if r.Address.EqualsTo(addr) {
+			rewards[i].Amount += amount
+			return rewards
+		}
+	}
+	return append(rewards, payload.BatchReceiver{Address: addr, Amount: amount})
+}
+
+// validateSubsidyTx checks if the subsidy transaction pays the block reward as it should.
+// The proposer can take its reward at any address.
+func (st *state) validateSubsidyTx(trx *tx.Tx, reward int64, lastCommit *block.Commit) error {
+	if trx.Payload().Value() != reward {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid subsidy amount. Expected %v, got %v", reward, trx.Payload().Value())
+	}
+
+	paid := make(map[crypto.Address]int64)
+	switch pld := trx.Payload().(type) {
+	case *payload.SendPayload:
+		paid[pld.Receiver] += pld.Amount
+	case *payload.BatchSendPayload:
+		for _, r := range pld.Receivers {
+			paid[r.Address] += r.Amount
+		}
+	}
+
+	rewards, _ := calcRewards(st.params, reward, lastCommit)
+	for _, r := range rewards {
+		if paid[r.Address] < r.Amount {
+			return errors.Errorf(errors.ErrInvalidTx, "Invalid subsidy reward for %v. Expected %v, got %v", r.Address, r.Amount, paid[r.Address])
+		}
+		paid[r.Address] -= r.Amount
+	}
+
+	proposers := 0
+	for _, amt := range paid {
+		if amt > 0 {
+			proposers++
+		}
+	}
+	if proposers > 1 {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid subsidy transaction, proposer reward is paid to more than one address")
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+func TestCalcRewards(t *testing.T) {
+	st := setupStatewithOneValidator(t)
+	fund, _, _ := crypto.GenerateTestKeyPair()
+	a1, _, _ := crypto.GenerateTestKeyPair()
+	a2, _, _ := crypto.GenerateTestKeyPair()
+	a3, _, _ := crypto.GenerateTestKeyPair()
+	commit := block.NewCommit(0, []block.Committer{
+		{Address: a1, Status: block.CommitSigned},
+		{Address: a2, Status: block.CommitNotSigned},
+		{Address: a3, Status: block.CommitSigned},
+	}, *tValSigner1.Sign([]byte("commit")))
+
+	params := st.params
+	rewards, left := calcRewards(params, 1000, commit)
+	assert.Empty(t, rewards)
+	assert.Equal(t, left, int64(1000))
+
+	params.CommitteeRewardPercentage = 30
+	params.FundRewardPercentage = 10
+	params.FundAddress = &fund
+	rewards, left = calcRewards(params, 1001, commit)
+	assert.Equal(t, rewards, []payload.BatchReceiver{
+		{Address: a1, Amount: 150},
+		{Address: a3, Amount: 150},
+		{Address: fund, Amount: 100},
+	})
+	assert.Equal(t, left, int64(601))
+
+	t.Run("No last commit for the first block", func(t *testing.T) {
+		rewards, left = calcRewards(params, 1000, nil)
+		assert.Equal(t, rewards, []payload.BatchReceiver{{Address: fund, Amount: 100}})
+		assert.Equal(t, left, int64(900))
+	})
+
+	t.Run("Fund address is also a committer", func(t *testing.T) {
+		params.FundAddress = &a1
+		rewards, left = calcRewards(params, 1000, commit)
+		assert.Equal(t, rewards, []payload.BatchReceiver{
+			{Address: a1, Amount: 250},
+			{Address: a3, Amount: 150},
+		})
+		assert.Equal(t, left, int64(600))
+	})
+}
+
+func TestRewardSplit(t *testing.T) {
+	st := setupStatewithOneValidator(t)
+	fund, _, _ := crypto.GenerateTestKeyPair()
+	params := st.params
+	params.CommitteeRewardPercentage = 20
+	params.FundRewardPercentage = 10
+	params.FundAddress = &fund
+	st.applyParams(params)
+
+	b1, c1 := proposeAndSignBlock(t, st, tValSigner1)
+	require.NoError(t, st.ApplyBlock(1, b1, c1))
+	b2, c2 := proposeAndSignBlock(t, st, tValSigner1)
+	require.NoError(t, st.ApplyBlock(2, b2, c2))
+
+	reward := calcBlockSubsidy(2, params.SubsidyReductionInterval)
+	ctrx, err := st.store.Transaction(b2.TxIDs().IDs()[0])
+	require.NoError(t, err)
+	assert.True(t, ctrx.Tx.IsSubsidyTx())
+	// The proposer has signed the last commit too
+	assert.Equal(t, ctrx.Tx.Payload().(*payload.BatchSendPayload).Receivers, []payload.BatchReceiver{
+		{Address: tValSigner1.Address(), Amount: reward * 90 / 100},
+		{Address: fund, Amount: reward * 10 / 100},
+	})
+
+	fundAcc, err := st.store.Account(fund)
+	require.NoError(t, err)
+	assert.Equal(t, fundAcc.Balance(), reward*10/100*2)
+
+	t.Run("Invalid reward split", func(t *testing.T) {
+		stamp := crypto.GenerateTestHash()
+		addr, _, _ := crypto.GenerateTestKeyPair()
+
+		trx := tx.NewSubsidyTx(1, stamp, 1, addr, 1000, "")
+		assert.Error(t, st.validateSubsidyTx(trx, 1000, &c2))
+
+		trx = tx.NewBatchSubsidyTx(1, stamp, 1, []payload.BatchReceiver{
+			{Address: tValSigner1.Address(), Amount: 200},
+			{Address: fund, Amount: 99},
+			{Address: addr, Amount: 701},
+		}, "")
+		assert.Error(t, st.validateSubsidyTx(trx, 1000, &c2))
+
+		trx = tx.NewBatchSubsidyTx(1, stamp, 1, []payload.BatchReceiver{
+			{Address: tValSigner1.Address(), Amount: 200},
+			{Address: fund, Amount: 100},
+			{Address: addr, Amount: 700},
+		}, "")
+		assert.NoError(t, st.validateSubsidyTx(trx, 1000, &c2))
+
+		addr2, _, _ := crypto.GenerateTestKeyPair()
+		trx = tx.NewBatchSubsidyTx(1, stamp, 1, []payload.BatchReceiver{
+			{Address: tValSigner1.Address(), Amount: 200},
+			{Address: fund, Amount: 100},
+			{Address: addr, Amount: 600},
+			{Address: addr2, Amount: 100},
+		}, "")
+		assert.Error(t, st.validateSubsidyTx(trx, 1000, &c2))
+	})
+}
@@ -0,0 +1,362 @@
+package state
+
+import (
+	"github.com/fxamacker/cbor/v2"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/snapshot"
+	"github.com/zarbchain/zarb-go/store"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+// snapshotData is the state after committing the last block.
+// Blocks are the recent blocks, ending at the last height. They are needed to
+// check the transactions' lifetime and the signing window of validators.
+// Transactions of the last block are needed to restore the validators that joined the set in the last block.
+// The history of the parameters is not part of the snapshot, it is rebuilt from the passed proposals.
+type snapshotData struct {
+	LastHeight       int               `cbor:"1,keyasint"`
+	LastCommit       *block.Commit     `cbor:"2,keyasint"`
+	LastReceiptsHash crypto.Hash       `cbor:"3,keyasint"`
+	Accounts         [][]byte          `cbor:"4,keyasint"`
+	Validators       [][]byte          `cbor:"5,keyasint"`
+	Escrows          [][]byte          `cbor:"6,keyasint"`
+	Proposals        [][]byte          `cbor:"7,keyasint"`
+	Blocks           []*block.Block    `cbor:"8,keyasint"`
+	LastTxs          []*tx.CommittedTx `cbor:"9,keyasint"`
+}
+
+// LastSnapshot returns the last snapshot of the state, or nil if there is no snapshot yet
+func (st *state) LastSnapshot() *snapshot.Snapshot {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.lastSnapshot
+}
+
+// makeSnapshot makes a snapshot of the state in the background, from a read view of the store.
+// If making the previous snapshot is not finished yet, it does nothing.
+func (st *state) makeSnapshot() {
+	if st.snapshotting {
+		st.logger.Warn("Making the previous snapshot is not finished yet", "height", st.lastBlockHeight)
+		return
+	}
+	rv, err := st.store.NewReadView()
+	if err != nil {
+		st.logger.Error("Unable to make a snapshot", "err", err)
+		return
+	}
+	data := &snapshotData{
+		LastHeight:       st.lastBlockHeight,
+		LastCommit:       st.lastCommit,
+		LastReceiptsHash: st.lastReceiptsHash,
+	}
+	blockHash := st.lastBlockHash
+	from := st.lastBlockHeight - util.Max(st.params.TransactionToLiveInterval, st.params.DowntimeWindow-1)
+	if from < 1 {
+		from = 1
+	}
+
+	st.snapshotting = true
+	st.snapshotWG.Add(1)
+	go func() {
+		defer st.snapshotWG.Done()
+
+		snap, err := buildSnapshot(rv, data, blockHash, from)
+		rv.Release()
+
+		st.lk.Lock()
+		defer st.lk.Unlock()
+
+		st.snapshotting = false
+		if err != nil {
+			st.logger.Error("Unable to make a snapshot", "height", data.LastHeight, "err", err)
+			return
+		}
+		st.lastSnapshot = snap
+		st.logger.Info("New snapshot is created", "snapshot", snap)
+	}()
+}
+
+func buildSnapshot(rv *store.ReadView, data *snapshotData, blockHash crypto.Hash, from int) (*snapshot.Snapshot, error) {
+	if err := rv.IterateAccounts(func(acc *account.Account) (stop bool) {
+		bs, _ := acc.Encode()
+		data.Accounts = append(data.Accounts, bs)
+		return false
+	}); err != nil {
+		return nil, err
+	}
+	if err := rv.IterateValidators(func(val *validator.Validator) (stop bool) {
+		bs, _ := val.Encode()
+		data.Validators = append(data.Validators, bs)
+		return false
+	}); err != nil {
+		return nil, err
+	}
+	if err := rv.IterateEscrows(func(e *escrow.Escrow) (stop bool) {
+		bs, _ := e.Encode()
+		data.Escrows = append(data.Escrows, bs)
+		return false
+	}); err != nil {
+		return nil, err
+	}
+	if err := rv.IterateProposals(func(p *governance.Proposal) (stop bool) {
+		bs, _ := p.Encode()
+		data.Proposals = append(data.Proposals, bs)
+		return false
+	}); err != nil {
+		return nil, err
+	}
+	for h := from; h <= data.LastHeight; h++ {
+		b, err := rv.Block(h)
+		if err != nil {
+			return nil, err
+		}
+		data.Blocks = append(data.Blocks, b)
+	}
+	for _, id := range data.Blocks[len(data.Blocks)-1].TxIDs().IDs() {
+		ctrx, err := rv.Transaction(id)
+		if err != nil {
+			return nil, err
+		}
+		data.LastTxs = append(data.LastTxs, ctrx)
+	}
+
+	bs, err := cbor.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.NewSnapshot(data.LastHeight, blockHash, bs, snapshot.DefaultChunkSize), nil
+}
+
+// RestoreSnapshot bootstraps a fresh state from a snapshot.
+// The snapshot is verified against the header of the next block, which commits the state hash.
+// The caller should make sure the header is trusted.
+func (st *state) RestoreSnapshot(snap *snapshot.Snapshot, nextHeader block.Header) error {
+	st.lk.Lock()
+	defer st.lk.Unlock()
+
+	if st.lastBlockHeight != 0 {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Only a fresh state can be restored from a snapshot")
+	}
+
+	bs, err := snap.Data()
+	if err != nil {
+		return err
+	}
+	data := new(snapshotData)
+	if err := cbor.Unmarshal(bs, data); err != nil {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Unable to decode snapshot: %v", err)
+	}
+
+	accs := make([]*account.Account, len(data.Accounts))
+	accHashes := make(map[int]crypto.Hash)
+	for i, d := range data.Accounts {
+		acc := new(account.Account)
+		if err := acc.Decode(d); err != nil {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid account: %v", err)
+		}
+		if err := addLeaf(accHashes, acc.Number(), acc.Hash()); err != nil {
+			return err
+		}
+		accs[i] = acc
+	}
+	vals := make([]*validator.Validator, len(data.Validators))
+	valHashes := make(map[int]crypto.Hash)
+	for i, d := range data.Validators {
+		val := new(validator.Validator)
+		if err := val.Decode(d); err != nil {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid validator: %v", err)
+		}
+		if err := addLeaf(valHashes, val.Number(), val.Hash()); err != nil {
+			return err
+		}
+		vals[i] = val
+	}
+	escs := make([]*escrow.Escrow, len(data.Escrows))
+	escHashes := make(map[int]crypto.Hash)
+	for i, d := range data.Escrows {
+		e := new(escrow.Escrow)
+		if err := e.Decode(d); err != nil {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid escrow: %v", err)
+		}
+		if err := addLeaf(escHashes, e.Number(), e.Hash()); err != nil {
+			return err
+		}
+		escs[i] = e
+	}
+	props := make([]*governance.Proposal, len(data.Proposals))
+	propHashes := make(map[int]crypto.Hash)
+	activations := make(map[int]bool)
+	for i, d := range data.Proposals {
+		p := new(governance.Proposal)
+		if err := p.Decode(d); err != nil {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid proposal: %v", err)
+		}
+		if err := addLeaf(propHashes, p.Number(), p.Hash()); err != nil {
+			return err
+		}
+		if p.IsPassed() {
+			// The parameters are activated at the next height of committing the block
+			if p.ActivationHeight() > data.LastHeight+1 || activations[p.ActivationHeight()] {
+				return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid passed proposal: %v", p.ID())
+			}
+			activations[p.ActivationHeight()] = true
+		}
+		props[i] = p
+	}
+
+	if err := verifySnapshot(snap.Manifest(), data, nextHeader); err != nil {
+		return err
+	}
+	stateHash := calcStateHash(
+		merkleRootOfLeaves(accHashes),
+		merkleRootOfLeaves(valHashes),
+		merkleRootOfLeaves(escHashes),
+		merkleRootOfLeaves(propHashes))
+	if !stateHash.EqualsTo(nextHeader.StateHash()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot,
+			"State hash is not same as we expected. Expected %v, got %v", nextHeader.StateHash(), stateHash)
+	}
+
+	// The snapshot is valid, let's write it into the store.
+	// The last info is written at the end, so if restoring stops in the middle,
+	// the half-written store is not loaded as a restored state.
+	for _, acc := range accs {
+		st.store.UpdateAccount(acc)
+	}
+	for _, val := range vals {
+		st.store.UpdateValidator(val)
+	}
+	for _, e := range escs {
+		st.store.UpdateEscrow(e)
+	}
+	for _, p := range props {
+		st.store.UpdateProposal(p)
+		// The proposals are verified by the state hash, so the history of the parameters is rebuilt from them
+		if p.IsPassed() {
+			st.store.SaveParams(p.ActivationHeight(), p.Params())
+		}
+	}
+	from := data.LastHeight - len(data.Blocks) + 1
+	for i, b := range data.Blocks {
+		if err := st.store.SaveBlock(*b, from+i); err != nil {
+			return err
+		}
+	}
+	for _, ctrx := range data.LastTxs {
+		st.store.SaveTransaction(*ctrx)
+	}
+	// Blocks before the snapshot are not available
+	st.store.MarkPruned(from - 1)
+	if err := st.saveLastInfo(data.LastHeight, data.LastCommit, &data.LastReceiptsHash); err != nil {
+		return err
+	}
+
+	if err := st.loadState(); err != nil {
+		return err
+	}
+	if err := st.makeSandboxes(); err != nil {
+		return err
+	}
+	st.lastSnapshot = snap
+
+	st.logger.Info("State is restored from the snapshot", "snapshot", snap)
+	return nil
+}
+
+// verifySnapshot checks the last info of the snapshot against the header of the next block
+func verifySnapshot(manifest snapshot.Manifest, data *snapshotData, nextHeader block.Header) error {
+	if err := nextHeader.SanityCheck(); err != nil {
+		return err
+	}
+	if data.LastHeight != manifest.Height {
+		return errors.Errorf(errors.ErrInvalidSnapshot,
+			"Height is not same as we expected. Expected %v, got %v", manifest.Height, data.LastHeight)
+	}
+	if !nextHeader.LastBlockHash().EqualsTo(manifest.BlockHash) {
+		return errors.Errorf(errors.ErrInvalidSnapshot,
+			"Last block hash is not same as we expected. Expected %v, got %v", nextHeader.LastBlockHash(), manifest.BlockHash)
+	}
+	if !nextHeader.LastReceiptsHash().EqualsTo(data.LastReceiptsHash) {
+		return errors.Errorf(errors.ErrInvalidSnapshot,
+			"Last receipts hash is not same as we expected. Expected %v, got %v", nextHeader.LastReceiptsHash(), data.LastReceiptsHash)
+	}
+	if data.LastCommit == nil || !nextHeader.LastCommitHash().EqualsTo(data.LastCommit.Hash()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Last commit is not same as we expected")
+	}
+	if err := data.LastCommit.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid last commit: %v", err)
+	}
+
+	// Recent blocks should be chained to the last block
+	if len(data.Blocks) == 0 || len(data.Blocks) > data.LastHeight {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid number of blocks: %v", len(data.Blocks))
+	}
+	blockHash := manifest.BlockHash
+	for i := len(data.Blocks) - 1; i >= 0; i-- {
+		b := data.Blocks[i]
+		if err := b.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid block: %v", err)
+		}
+		if !b.Hash().EqualsTo(blockHash) {
+			return errors.Errorf(errors.ErrInvalidSnapshot,
+				"Block hash is not same as we expected. Expected %v, got %v", blockHash, b.Hash())
+		}
+		blockHash = b.Header().LastBlockHash()
+	}
+
+	// Transactions of the last block are verified by the last receipts hash
+	ids := data.Blocks[len(data.Blocks)-1].TxIDs().IDs()
+	if len(data.LastTxs) != len(ids) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid number of transactions: %v", len(data.LastTxs))
+	}
+	receiptsHashes := make([]crypto.Hash, len(ids))
+	for i, ctrx := range data.LastTxs {
+		if ctrx == nil || ctrx.Tx == nil || ctrx.Receipt == nil {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid transaction")
+		}
+		if err := ctrx.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "Invalid transaction: %v", err)
+		}
+		if !ctrx.Tx.ID().EqualsTo(ids[i]) {
+			return errors.Errorf(errors.ErrInvalidSnapshot,
+				"Transaction is not same as we expected. Expected %v, got %v", ids[i], ctrx.Tx.ID())
+		}
+		receiptsHashes[i] = ctrx.Receipt.Hash()
+	}
+	receiptsHash := simpleMerkle.NewTreeFromHashes(receiptsHashes).Root()
+	if !receiptsHash.EqualsTo(data.LastReceiptsHash) {
+		return errors.Errorf(errors.ErrInvalidSnapshot,
+			"Receipts hash is not same as we expected. Expected %v, got %v", data.LastReceiptsHash, receiptsHash)
+	}
+	return nil
+}
+
+func addLeaf(leaves map[int]crypto.Hash, number int, hash crypto.Hash) error {
+	if _, ok := leaves[number]; ok {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "Duplicated number: %v", number)
+	}
+	leaves[number] = hash
+	return nil
+}
+
+// merkleRootOfLeaves calculates the merkle root of the leaves, ordered by their numbers
+func merkleRootOfLeaves(leaves map[int]crypto.Hash) crypto.Hash {
+	hashes := make([]crypto.Hash, len(leaves))
+	for n, h := range leaves {
+		if n < 0 || n >= len(hashes) {
+			// Numbers are not sequential, it can't match the state hash
+			return crypto.UndefHash
+		}
+		hashes[n] = h
+	}
+	return simpleMerkle.NewTreeFromHashes(hashes).Root()
+}
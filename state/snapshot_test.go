@@ -0,0 +1,145 @@
+package state
+
+import (
+	"os"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/snapshot"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/txpool"
+)
+
+func TestMakeSnapshot(t *testing.T) {
+	st := setupStatewithOneValidator(t)
+	st.config.SnapshotInterval = 4
+
+	for h := 1; h <= 9; h++ {
+		b, c := proposeAndSignBlock(t, st, tValSigner1)
+		require.NoError(t, st.ApplyBlock(h, b, c))
+		st.snapshotWG.Wait()
+		if h < 4 {
+			assert.Nil(t, st.LastSnapshot())
+		}
+	}
+	snap := st.LastSnapshot()
+	require.NotNil(t, snap)
+	assert.Equal(t, snap.Height(), 8)
+	b8, _ := st.store.Block(8)
+	assert.Equal(t, snap.BlockHash(), b8.Hash())
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	st1 := setupStatewithOneValidator(t)
+	st1.config.SnapshotInterval = 5
+
+	params := st1.params
+	params.MinimumFee = 2000
+	for h := 1; h <= 6; h++ {
+		if h == 2 {
+			// The parameters are changed before the snapshot
+			pub := tValSigner1.PublicKey()
+			trx := tx.NewParamProposalTx(st1.lastBlockHash, 1, tValSigner1.Address(), params, 4, "", &pub, nil)
+			tValSigner1.SignMsg(trx)
+			require.NoError(t, st1.txPool.AppendTx(trx))
+		}
+		b, c := proposeAndSignBlock(t, st1, tValSigner1)
+		require.NoError(t, st1.ApplyBlock(h, b, c))
+		st1.snapshotWG.Wait()
+	}
+	require.Equal(t, st1.params, params)
+	// The state of the snapshot is committed in the header of the next block
+	b6, _ := st1.store.Block(6)
+	nextHeader := *b6.Header()
+	b5, _ := st1.store.Block(5)
+
+	// Download the snapshot chunk by chunk
+	manifest := st1.LastSnapshot().Manifest()
+	snap, err := snapshot.NewSnapshotFromManifest(manifest)
+	require.NoError(t, err)
+	for i := range manifest.ChunkHashes {
+		require.NoError(t, snap.AddChunk(i, st1.LastSnapshot().Chunk(i)))
+	}
+
+	t.Run("Invalid header", func(t *testing.T) {
+		st2 := setupStatewithOneValidator(t)
+		assert.Error(t, st2.RestoreSnapshot(snap, *b5.Header()))
+
+		h := block.NewHeader(nextHeader.Version(), nextHeader.Time(), nextHeader.TxIDsHash(),
+			nextHeader.LastBlockHash(), nextHeader.CommittersHash(), crypto.GenerateTestHash(),
+			nextHeader.LastReceiptsHash(), nextHeader.LastCommitHash(), nextHeader.ProposerAddress())
+		assert.Error(t, st2.RestoreSnapshot(snap, h))
+		assert.Equal(t, st2.LastBlockHeight(), 0)
+	})
+
+	t.Run("Invalid transactions of the last block", func(t *testing.T) {
+		bs, err := snap.Data()
+		require.NoError(t, err)
+		data := new(snapshotData)
+		require.NoError(t, cbor.Unmarshal(bs, data))
+		require.NoError(t, verifySnapshot(snap.Manifest(), data, nextHeader))
+
+		lastTxs := data.LastTxs
+		data.LastTxs = lastTxs[1:]
+		assert.Error(t, verifySnapshot(snap.Manifest(), data, nextHeader))
+
+		trx, _ := tx.GenerateTestSendTx()
+		receipt := trx.GenerateReceipt(tx.Ok, b5.Hash())
+		data.LastTxs = append([]*tx.CommittedTx{{Tx: trx, Receipt: receipt}}, lastTxs[1:]...)
+		assert.Error(t, verifySnapshot(snap.Manifest(), data, nextHeader))
+	})
+
+	t.Run("Unable to write the last info", func(t *testing.T) {
+		st2 := setupStatewithOneValidator(t)
+		path := st2.config.Store.Path + "/last_info.json"
+		require.NoError(t, os.RemoveAll(path))
+		require.NoError(t, os.Mkdir(path, 0750))
+
+		assert.Error(t, st2.RestoreSnapshot(snap, nextHeader))
+		assert.Equal(t, st2.LastBlockHeight(), 0)
+		assert.Nil(t, st2.LastSnapshot())
+	})
+
+	t.Run("State is not fresh", func(t *testing.T) {
+		assert.Error(t, st1.RestoreSnapshot(snap, nextHeader))
+	})
+
+	t.Run("Restore and continue", func(t *testing.T) {
+		st2 := setupStatewithOneValidator(t)
+		require.NoError(t, st2.RestoreSnapshot(snap, nextHeader))
+		assert.Equal(t, st2.LastBlockHeight(), 5)
+		assert.Equal(t, st2.LastBlockHash(), b5.Hash())
+		assert.Equal(t, st2.stateHash(), nextHeader.StateHash())
+		assert.Equal(t, st2.params, params)
+		saved, ok := st2.store.Params(4)
+		assert.True(t, ok)
+		assert.Equal(t, saved, params)
+		for _, id := range b5.TxIDs().IDs() {
+			_, err := st2.store.Transaction(id)
+			assert.NoError(t, err)
+		}
+
+		c6 := st1.LastCommit()
+		assert.Equal(t, st2.ProposeBlock().Hash(), b6.Hash())
+		require.NoError(t, st2.ApplyBlock(6, *b6, *c6))
+		assert.Equal(t, st1.stateHash(), st2.stateHash())
+
+		b7, c7 := proposeAndSignBlock(t, st1, tValSigner1)
+		assert.Equal(t, st2.ProposeBlock().Hash(), b7.Hash())
+		require.NoError(t, st1.ApplyBlock(7, b7, c7))
+		require.NoError(t, st2.ApplyBlock(7, b7, c7))
+		assert.Equal(t, st1.stateHash(), st2.stateHash())
+		assert.Equal(t, st1.sortition.TotalStake(), st2.sortition.TotalStake())
+
+		// Restored state can be loaded again
+		assert.NoError(t, st2.Close())
+		st3, err := LoadOrNewState(st2.config, st2.genDoc, tValSigner1, txpool.NewMockTxPool())
+		require.NoError(t, err)
+		assert.Equal(t, st3.LastBlockHash(), b7.Hash())
+		assert.Equal(t, st3.(*state).params, params)
+	})
+}
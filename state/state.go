@@ -2,6 +2,7 @@ package state
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/sasha-s/go-deadlock"
@@ -14,9 +15,11 @@ import (
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/snapshot"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/store"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	"github.com/zarbchain/zarb-go/txpool"
 	"github.com/zarbchain/zarb-go/util"
 	"github.com/zarbchain/zarb-go/validator"
@@ -33,6 +36,7 @@ type state struct {
 	genDoc           *genesis.Genesis
 	store            *store.Store
 	params           param.Params
+	upgrades         param.UpgradeSchedule
 	txPool           txpool.TxPool
 	txPoolSandbox    *sandbox.SandboxConcrete
 	execution        *execution.Execution
@@ -44,6 +48,10 @@ type state struct {
 	lastReceiptsHash crypto.Hash
 	lastCommit       *block.Commit
 	lastBlockTime    time.Time
+	signingWindow    []signingRecord
+	lastSnapshot     *snapshot.Snapshot
+	snapshotting     bool
+	snapshotWG       sync.WaitGroup
 	logger           *logger.Logger
 }
 
@@ -58,11 +66,16 @@ func LoadOrNewState(
 		genDoc:    genDoc,
 		txPool:    txPool,
 		params:    genDoc.Params(),
+		upgrades:  genDoc.Upgrades().Merge(conf.Upgrades),
 		proposer:  signer.Address(),
 		sortition: sortition.NewSortition(signer),
 	}
 	st.logger = logger.NewLogger("_state", st)
 
+	if err := st.upgrades.SanityCheck(); err != nil {
+		return nil, err
+	}
+
 	store, err := store.NewStore(conf.Store)
 	if err != nil {
 		return nil, err
@@ -70,8 +83,7 @@ func LoadOrNewState(
 	st.store = store
 
 	if store.HasAnyBlock() {
-		err := st.tryLoadLastInfo()
-		if err != nil {
+		if err := st.loadState(); err != nil {
 			return nil, err
 		}
 	} else {
@@ -81,18 +93,44 @@ func LoadOrNewState(
 		}
 	}
 
-	st.txPoolSandbox, err = sandbox.NewSandbox(store, st.params, st.lastBlockHeight, st.sortition, st.validatorSet)
-	if err != nil {
+	// Rewards are calculated based on the parameters, make sure they are valid
+	if err := st.params.SanityCheck(); err != nil {
 		return nil, err
 	}
-	st.executionSandbox, err = sandbox.NewSandbox(store, st.params, st.lastBlockHeight, st.sortition, st.validatorSet)
-	if err != nil {
+
+	if err := st.makeSandboxes(); err != nil {
 		return nil, err
 	}
+
+	return st, nil
+}
+
+func (st *state) loadState() error {
+	if err := st.tryLoadLastInfo(); err != nil {
+		return err
+	}
+	// Parameters might be changed by governance
+	if params, ok := st.store.Params(st.lastBlockHeight + 1); ok {
+		st.params = params
+	}
+	return st.loadSigningWindow()
+}
+
+func (st *state) makeSandboxes() error {
+	var err error
+	st.txPoolSandbox, err = sandbox.NewSandbox(st.store, st.params, st.lastBlockHeight, st.sortition, st.validatorSet)
+	if err != nil {
+		return err
+	}
+	st.executionSandbox, err = sandbox.NewSandbox(st.store, st.params, st.lastBlockHeight, st.sortition, st.validatorSet)
+	if err != nil {
+		return err
+	}
+	st.activateUpgrade()
 	st.txPool.SetSandbox(st.txPoolSandbox)
 	st.execution = execution.NewExecution(st.executionSandbox)
 
-	return st, nil
+	return nil
 }
 
 func (st *state) tryLoadLastInfo() error {
@@ -112,21 +150,10 @@ func (st *state) tryLoadLastInfo() error {
 	st.lastBlockTime = b.Header().Time()
 	st.lastReceiptsHash = *receiptHash
 
-	vals := make([]*validator.Validator, len(commit.Committers()))
-	for i, c := range commit.Committers() {
-		val, err := st.store.Validator(c.Address)
-		if err != nil {
-			return fmt.Errorf("Last commit has unknown validator: %v", err)
-		}
-		vals[i] = val
-	}
-	st.validatorSet, err = validator.NewValidatorSet(vals, st.params.MaximumPower, b.Header().ProposerAddress())
+	st.validatorSet, err = st.restoreValidatorSet(height, b, commit)
 	if err != nil {
 		return err
 	}
-	if err := st.validatorSet.MoveToNextHeight(0, nil); err != nil {
-		return err
-	}
 
 	totalStake := int64(0)
 	st.store.IterateValidators(func(val *validator.Validator) (stop bool) {
@@ -139,6 +166,69 @@ func (st *state) tryLoadLastInfo() error {
 	return nil
 }
 
+// restoreValidatorSet makes the validator set of the next height, the same as commitSandbox does.
+// The committers of the last block are the validators of the last height.
+// The set is moved by the round of the last commit, and the validators that joined or left the set
+// in the last block are added or removed.
+func (st *state) restoreValidatorSet(height int, b *block.Block, commit *block.Commit) (*validator.ValidatorSet, error) {
+	vals := make([]*validator.Validator, len(commit.Committers()))
+	proposerIndex := -1
+	for i, c := range commit.Committers() {
+		val, err := st.store.Validator(c.Address)
+		if err != nil {
+			return nil, fmt.Errorf("Last commit has unknown validator: %v", err)
+		}
+		vals[i] = val
+		if val.Address().EqualsTo(b.Header().ProposerAddress()) {
+			proposerIndex = i
+		}
+	}
+	if proposerIndex == -1 {
+		return nil, fmt.Errorf("Proposer of the last block is not in the last commit")
+	}
+
+	// The proposer of the first round of the last height
+	n := len(vals)
+	firstProposer := vals[((proposerIndex-commit.Round())%n+n)%n]
+
+	// The current height of the sandbox, when the last block was executed
+	executedAt := height + 1
+
+	joined := make([]*validator.Validator, 0)
+	left := make([]*validator.Validator, 0)
+	for _, val := range vals {
+		// Validators unbonded, slashed or jailed in the last block have left the set
+		if val.UnbondingHeight() == executedAt || val.JailedHeight() == executedAt {
+			left = append(left, val)
+		}
+	}
+	for _, id := range b.TxIDs().IDs() {
+		ctrx, err := st.store.Transaction(id)
+		if err != nil {
+			return nil, err
+		}
+		if ctrx.Tx.PayloadType() != payload.PayloadTypeSortition {
+			continue
+		}
+		val, err := st.store.Validator(ctrx.Tx.Payload().Signer())
+		if err != nil {
+			return nil, err
+		}
+		if val.UnbondingHeight() == 0 {
+			joined = append(joined, val)
+		}
+	}
+
+	valSet, err := validator.NewValidatorSet(vals, st.params.MaximumPower, firstProposer.Address())
+	if err != nil {
+		return nil, err
+	}
+	if err := valSet.MoveToNextHeight(commit.Round(), joined, left); err != nil {
+		return nil, err
+	}
+	return valSet, nil
+}
+
 func (st *state) makeGenesisState(genDoc *genesis.Genesis) error {
 	accs := genDoc.Accounts()
 	for _, acc := range accs {
@@ -163,6 +253,9 @@ func (st *state) makeGenesisState(genDoc *genesis.Genesis) error {
 }
 
 func (st *state) Close() error {
+	// Wait for making the snapshot to stop
+	st.snapshotWG.Wait()
+
 	st.lk.RLock()
 	defer st.lk.RUnlock()
 
@@ -194,6 +287,13 @@ func (st *state) GenesisHash() crypto.Hash {
 	return st.genDoc.Hash()
 }
 
+func (st *state) ChainName() string {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.genDoc.ChainName()
+}
+
 func (st *state) LastBlockHash() crypto.Hash {
 	st.lk.RLock()
 	defer st.lk.RUnlock()
@@ -237,6 +337,7 @@ func (st *state) UpdateLastCommit(commit *block.Commit) error {
 
 func (st *state) createSubsidyTx(fee int64) *tx.Tx {
 	acc, _ := st.store.Account(crypto.TreasuryAddress)
+	ver := st.protocolVersion()
 	stamp := st.lastBlockHash
 	seq := acc.Sequence() + 1
 	amt := calcBlockSubsidy(st.lastBlockHeight+1, st.params.SubsidyReductionInterval)
@@ -245,8 +346,12 @@ func (st *state) createSubsidyTx(fee int64) *tx.Tx {
 	if mintbaseAddr == nil {
 		mintbaseAddr = &st.proposer
 	}
-	tx := tx.NewSubsidyTx(stamp, seq, *mintbaseAddr, amt+fee, "")
-	return tx
+	rewards, proposerReward := calcRewards(st.params, amt+fee, st.lastCommit)
+	if len(rewards) == 0 {
+		return tx.NewSubsidyTx(ver, stamp, seq, *mintbaseAddr, amt+fee, "")
+	}
+	rewards = addReward(rewards, *mintbaseAddr, proposerReward)
+	return tx.NewBatchSubsidyTx(ver, stamp, seq, rewards, "")
 }
 
 func (st *state) ProposeBlock() block.Block {
@@ -267,7 +372,7 @@ func (st *state) ProposeBlock() block.Block {
 	txIDs := block.NewTxIDs()
 
 	// Re-chaeck all transactions again, remove invalid ones
-	trxs := st.txPool.AllTransactions()
+	trxs := st.txPool.PrioritizedTransactions()
 	for _, trx := range trxs {
 		// All subsidy transactions (probably from invalid rounds)
 		// should be removed from the pool
@@ -298,6 +403,7 @@ func (st *state) ProposeBlock() block.Block {
 	stateHash := st.stateHash()
 	committersHash := st.validatorSet.CommittersHash()
 	block := block.MakeBlock(
+		uint(st.protocolVersion()),
 		timestamp,
 		txIDs,
 		st.lastBlockHash,
@@ -377,6 +483,12 @@ func (st *state) ApplyBlock(height int, block block.Block, commit block.Commit)
 		receiptsHashes[i] = ctrx.Receipt.Hash()
 	}
 
+	// The last commit of the block is the one that all nodes agreed on
+	if block.LastCommit() != nil {
+		st.recordCommit(st.lastBlockHeight, block.LastCommit())
+	}
+	st.jailOfflineValidators()
+
 	// Commit changes and move proposer index
 	st.commitSandbox(commit.Round())
 
@@ -392,13 +504,39 @@ func (st *state) ApplyBlock(height int, block block.Block, commit block.Commit)
 
 	st.executionSandbox.AppendNewBlock(st.lastBlockHash, st.lastBlockHeight)
 	st.txPoolSandbox.AppendNewBlock(st.lastBlockHash, st.lastBlockHeight)
-	st.saveLastInfo(st.lastBlockHeight, st.lastCommit, &st.lastReceiptsHash)
+	st.activateProposals()
+	st.activateUpgrade()
+	st.txPool.Recheck()
+	if err := st.saveLastInfo(st.lastBlockHeight, st.lastCommit, &st.lastReceiptsHash); err != nil {
+		st.logger.Error("Unable to write last sate info", "err", err)
+	}
+
+	if st.config.SnapshotInterval > 0 && st.lastBlockHeight%st.config.SnapshotInterval == 0 {
+		st.makeSnapshot()
+	}
+	st.pruneBlocks()
 
 	st.EvaluateSortition()
 
 	return nil
 }
 
+// pruneBlocks deletes the old blocks in the background.
+// The recent blocks that are needed to check the transactions' stamps and the signing window are kept.
+func (st *state) pruneBlocks() {
+	retain := st.config.Store.RetainBlocks
+	if retain == 0 {
+		return
+	}
+	retain = util.Max(retain, st.params.TransactionToLiveInterval+1)
+	retain = util.Max(retain, st.params.DowntimeWindow)
+
+	height := st.lastBlockHeight - retain
+	if height > st.store.PrunedHeight() {
+		st.store.PruneBlocks(height)
+	}
+}
+
 func (st *state) EvaluateSortition() {
 	if st.validatorSet.Contains(st.proposer) {
 		// We are in the validator set right now
@@ -410,8 +548,18 @@ func (st *state) EvaluateSortition() {
 		// We are not a validator
 		return
 	}
+
+	if val.UnbondingHeight() > 0 {
+		// We have left the validator role
+		return
+	}
+
+	if val.IsJailed(st.executionSandbox.CurrentHeight(), st.params.JailPeriod) {
+		// We are jailed for missing signatures
+		return
+	}
 	//
-	trx := st.sortition.EvaluateTransaction(st.lastBlockHash, val)
+	trx := st.sortition.EvaluateTransaction(st.protocolVersion(), st.lastBlockHash, val)
 	if trx != nil {
 		st.logger.Info("👏 This validator is chosen to be in set", "address", st.proposer, "stake", val.Stake(), "tx", trx)
 		if err := st.txPool.AppendTxAndBroadcast(trx); err != nil {
@@ -439,13 +587,18 @@ func (st *state) Fingerprint() string {
 // TODO: add tests for me
 func (st *state) commitSandbox(round int) {
 	joined := make([]*validator.Validator, 0)
+	left := make([]*validator.Validator, 0)
 	st.executionSandbox.IterateValidators(func(vs *sandbox.ValidatorStatus) {
-		if vs.AddToSet {
+		if vs.AddToSet && vs.Validator.UnbondingHeight() == 0 {
 			joined = append(joined, &vs.Validator)
 		}
+		// Validators unbonded, slashed or jailed in this block leave the set at the next height
+		if vs.LeaveSet {
+			left = append(left, &vs.Validator)
+		}
 	})
 
-	if err := st.validatorSet.MoveToNextHeight(0, joined); err != nil {
+	if err := st.validatorSet.MoveToNextHeight(round, joined, left); err != nil {
 		//
 		// We should panic here before updating state
 		//
@@ -463,4 +616,18 @@ func (st *state) commitSandbox(round int) {
 			st.store.UpdateValidator(&vs.Validator)
 		}
 	})
+
+	st.executionSandbox.IterateEscrows(func(es *sandbox.EscrowStatus) {
+		if es.Updated {
+			st.store.UpdateEscrow(&es.Escrow)
+		}
+	})
+
+	st.executionSandbox.IterateProposals(func(ps *sandbox.ProposalStatus) {
+		if ps.Updated {
+			st.store.UpdateProposal(&ps.Proposal)
+		}
+	})
+
+	st.sortition.AddToTotalStake(st.executionSandbox.ChangeToStake())
 }
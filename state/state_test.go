@@ -9,8 +9,11 @@ import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/genesis"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/tx/payload"
 	"github.com/zarbchain/zarb-go/txpool"
 	"github.com/zarbchain/zarb-go/util"
@@ -144,6 +147,55 @@ func TestLoadState(t *testing.T) {
 	assert.Equal(t, st1.executionSandbox.LastBlockHash(), st3.(*state).executionSandbox.LastBlockHash())
 }
 
+func TestCommitInLaterRound(t *testing.T) {
+	st := setupStatewithFourValidators(t, tValSigner2)
+
+	// Second validator proposes the block in the second round
+	b := st.ProposeBlock()
+	assert.Equal(t, st.validatorSet.Proposer(1).Address(), tValSigner2.Address())
+	signers := []crypto.Signer{tValSigner1, tValSigner2, tValSigner3}
+	committers := make([]block.Committer, len(signers))
+	sigs := make([]*crypto.Signature, len(signers))
+	for i, s := range signers {
+		v := vote.NewPrecommit(1, 1, b.Hash(), s.Address())
+		committers[i] = block.Committer{Status: 1, Address: s.Address()}
+		sigs[i] = s.Sign(v.SignBytes())
+	}
+	committers = append(committers, block.Committer{Status: 0, Address: tValSigner4.Address()})
+	c := block.NewCommit(1, committers, crypto.Aggregate(sigs))
+	require.NoError(t, st.ApplyBlock(1, b, *c))
+
+	// Proposer moves to the validator after the proposer of the committed block
+	assert.Equal(t, st.validatorSet.Proposer(0).Address(), tValSigner3.Address())
+
+	t.Run("Proposer should be same after restart", func(t *testing.T) {
+		require.NoError(t, st.Close())
+		st2, err := LoadOrNewState(st.config, st.genDoc, tValSigner2, txpool.NewMockTxPool())
+		require.NoError(t, err)
+		assert.Equal(t, st2.(*state).validatorSet.Proposer(0).Address(), tValSigner3.Address())
+	})
+}
+
+func TestLoadInvalidParams(t *testing.T) {
+	genDoc := makeTestGenesis()
+	conf := TestConfig()
+	st1, err := LoadOrNewState(conf, genDoc, tValSigner1, txpool.NewMockTxPool())
+	require.NoError(t, err)
+	st := st1.(*state)
+
+	b, c := proposeAndSignBlock(t, st, tValSigner1)
+	require.NoError(t, st.ApplyBlock(1, b, c))
+
+	// Fund address is not set
+	params := param.MainnetParams()
+	params.FundRewardPercentage = 10
+	st.store.SaveParams(2, params)
+	require.NoError(t, st.Close())
+
+	_, err = LoadOrNewState(conf, genDoc, tValSigner1, txpool.NewMockTxPool())
+	assert.Equal(t, errors.Code(err), errors.ErrInvalidConfig)
+}
+
 func TestBlockSubsidy(t *testing.T) {
 	interval := 2100000
 	assert.Equal(t, int64(5*1e8), calcBlockSubsidy(1, interval))
@@ -175,4 +227,22 @@ func TestApplyBlocks(t *testing.T) {
 	assert.Error(t, st.ApplyBlock(1, *invBlock, c1))
 	assert.Error(t, st.ApplyBlock(2, b1, c1))
 	assert.NoError(t, st.ApplyBlock(1, b1, c1))
+
+	t.Run("Committed transactions should have receipts", func(t *testing.T) {
+		ids := b1.TxIDs().IDs()
+		ctrx, err := st.store.Transaction(ids[0])
+		require.NoError(t, err)
+		assert.NoError(t, ctrx.SanityCheck())
+
+		r := ctrx.Receipt
+		value := ctrx.Tx.Payload().Value()
+		assert.Equal(t, r.Height(), 1)
+		assert.Equal(t, r.Index(), 0)
+		assert.Equal(t, r.Fee(), int64(0))
+		assert.Equal(t, r.BlockHash(), b1.Hash())
+		assert.Equal(t, r.BalanceChanges(), []tx.BalanceChange{
+			{Address: crypto.TreasuryAddress, Balance: -value},
+			{Address: tValSigner1.Address(), Balance: value},
+		})
+	})
 }
@@ -0,0 +1,43 @@
+package state
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/version"
+)
+
+// protocolVersion returns the protocol version for the next block
+func (st *state) protocolVersion() int {
+	return st.upgrades.VersionAt(st.lastBlockHeight + 1)
+}
+
+// checkProtocolVersion makes sure this node supports the protocol version for the next block.
+// If not, the node halts here and doesn't accept any block, instead of forking the chain.
+func (st *state) checkProtocolVersion() error {
+	ver := st.protocolVersion()
+	if ver > version.ProtocolVersion {
+		return errors.Errorf(errors.ErrUnsupportedVersion,
+			"Protocol version %v is activated at height %v, but this node supports up to version %v. Please upgrade your node",
+			ver, st.lastBlockHeight+1, version.ProtocolVersion)
+	}
+	return nil
+}
+
+// activateUpgrade updates the protocol version of the sandboxes for the next block
+func (st *state) activateUpgrade() {
+	ver := st.protocolVersion()
+	st.executionSandbox.SetProtocolVersion(ver)
+	st.txPoolSandbox.SetProtocolVersion(ver)
+
+	if err := st.checkProtocolVersion(); err != nil {
+		st.logger.Error("Node is halted", "err", err)
+		return
+	}
+	if ver != st.upgrades.VersionAt(st.lastBlockHeight) {
+		st.logger.Info("New protocol version is activated", "version", ver, "height", st.lastBlockHeight+1)
+	}
+
+	if next := st.upgrades.NextUpgrade(st.lastBlockHeight + 1); next != nil && next.Version > version.ProtocolVersion {
+		st.logger.Warn("This node doesn't support the upcoming protocol version, please upgrade your node",
+			"version", next.Version, "height", next.Height)
+	}
+}
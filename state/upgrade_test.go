@@ -0,0 +1,122 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/execution"
+	"github.com/zarbchain/zarb-go/genesis"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/txpool"
+	"github.com/zarbchain/zarb-go/util"
+	"github.com/zarbchain/zarb-go/validator"
+	"github.com/zarbchain/zarb-go/version"
+)
+
+func TestInvalidBlockVersion(t *testing.T) {
+	st := setupStatewithOneValidator(t)
+
+	txIDs := block.NewTxIDs()
+	txIDs.Append(crypto.GenerateTestHash())
+	b := block.MakeBlock(2, util.Now(), txIDs, st.lastBlockHash, st.validatorSet.CommittersHash(), st.stateHash(), st.lastReceiptsHash, st.lastCommit, st.proposer)
+	assert.Error(t, st.ValidateBlock(b))
+}
+
+func makeTestGenesis() *genesis.Genesis {
+	acc := account.NewAccount(crypto.TreasuryAddress, 0)
+	acc.AddToBalance(21 * 1e14)
+	val := validator.NewValidator(tValSigner1.PublicKey(), 0, 0)
+	return genesis.MakeGenesis("test", tGenTime, []*account.Account{acc}, []*validator.Validator{val}, 1)
+}
+
+func TestInvalidUpgradeSchedule(t *testing.T) {
+	conf := TestConfig()
+	conf.Upgrades = param.UpgradeSchedule{{Height: 10, Version: 1}}
+
+	_, err := LoadOrNewState(conf, makeTestGenesis(), tValSigner1, txpool.NewMockTxPool())
+	assert.Error(t, err)
+
+	genDoc := makeTestGenesis()
+	genDoc.SetUpgrades(param.UpgradeSchedule{{Height: 10, Version: 3}})
+	conf.Upgrades = param.UpgradeSchedule{{Height: 20, Version: 2}}
+	_, err = LoadOrNewState(conf, genDoc, tValSigner1, txpool.NewMockTxPool())
+	assert.Error(t, err)
+}
+
+func TestHaltOnUnsupportedVersion(t *testing.T) {
+	genDoc := makeTestGenesis()
+	conf := TestConfig()
+	conf.Upgrades = param.UpgradeSchedule{{Height: 3, Version: 2}}
+
+	st1, err := LoadOrNewState(conf, genDoc, tValSigner1, txpool.NewMockTxPool())
+	require.NoError(t, err)
+	st := st1.(*state)
+
+	b1, c1 := proposeAndSignBlock(t, st, tValSigner1)
+	assert.Equal(t, b1.Header().Version(), uint(1))
+	require.NoError(t, st.ApplyBlock(1, b1, c1))
+	b2, c2 := proposeAndSignBlock(t, st, tValSigner1)
+	require.NoError(t, st.ApplyBlock(2, b2, c2))
+
+	b3, c3 := proposeAndSignBlock(t, st, tValSigner1)
+	assert.Equal(t, b3.Header().Version(), uint(2))
+	err = st.ValidateBlock(b3)
+	assert.Equal(t, errors.Code(err), errors.ErrUnsupportedVersion)
+	err = st.ApplyBlock(3, b3, c3)
+	assert.Equal(t, errors.Code(err), errors.ErrUnsupportedVersion)
+	assert.Equal(t, st.LastBlockHeight(), 2)
+
+	t.Run("Node should be halted after restart", func(t *testing.T) {
+		require.NoError(t, st.Close())
+
+		st2, err := LoadOrNewState(conf, genDoc, tValSigner1, txpool.NewMockTxPool())
+		require.NoError(t, err)
+		err = st2.ApplyBlock(3, b3, c3)
+		assert.Equal(t, errors.Code(err), errors.ErrUnsupportedVersion)
+	})
+}
+
+func TestActivateNewVersion(t *testing.T) {
+	version.ProtocolVersion = 2
+	defer func() { version.ProtocolVersion = 1 }()
+
+	genDoc := makeTestGenesis()
+	conf := TestConfig()
+	conf.Upgrades = param.UpgradeSchedule{{Height: 3, Version: 2}}
+
+	txPool := txpool.NewMockTxPool()
+	st1, err := LoadOrNewState(conf, genDoc, tValSigner1, txPool)
+	require.NoError(t, err)
+	st := st1.(*state)
+
+	for h := 1; h <= 2; h++ {
+		b, c := proposeAndSignBlock(t, st, tValSigner1)
+		assert.Equal(t, b.Header().Version(), uint(1))
+		require.NoError(t, st.ApplyBlock(h, b, c))
+	}
+
+	b3, c3 := proposeAndSignBlock(t, st, tValSigner1)
+	assert.Equal(t, b3.Header().Version(), uint(2))
+	subsidy := txPool.PendingTx(b3.TxIDs().IDs()[0])
+	require.NotNil(t, subsidy)
+	assert.Equal(t, subsidy.Version(), 2)
+	require.NoError(t, st.ApplyBlock(3, b3, c3))
+
+	ctrx, err := st.store.Transaction(subsidy.ID())
+	require.NoError(t, err)
+	assert.Equal(t, ctrx.Tx.Version(), 2)
+
+	t.Run("Transactions with the old version are rejected", func(t *testing.T) {
+		trx := tx.NewSubsidyTx(1, st.lastBlockHash, subsidy.Sequence()+1, tValSigner1.Address(), 1, "")
+		exe := execution.NewExecution(st.txPoolSandbox.Clone())
+		err := exe.Execute(trx)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+		assert.Contains(t, err.Error(), "Invalid version")
+	})
+}
@@ -8,10 +8,19 @@ import (
 )
 
 func (st *state) validateBlock(block block.Block) error {
+	if err := st.checkProtocolVersion(); err != nil {
+		return err
+	}
+
 	if err := block.SanityCheck(); err != nil {
 		return err
 	}
 
+	if block.Header().Version() != uint(st.protocolVersion()) {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"Block version is not same as we expected. Expected %v, got %v", st.protocolVersion(), block.Header().Version())
+	}
+
 	if !block.Header().LastBlockHash().EqualsTo(st.lastBlockHash) {
 		return errors.Errorf(errors.ErrInvalidBlock,
 			"Last block hash is not same as we expected. Expected %v, got %v", st.lastBlockHash, block.Header().LastBlockHash())
@@ -5,11 +5,13 @@ import (
 	"github.com/syndtr/goleveldb/leveldb/util"
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
 )
 
 type accountStore struct {
 	db    *leveldb.DB
 	total int
+	tree  *merkleTree
 }
 
 var (
@@ -27,6 +29,15 @@ func newAccountStore(path string) (*accountStore, error) {
 		db: db,
 	}
 	as.total = as.countAccounts()
+	as.tree, err = loadMerkleTree(db, as.total, func(consumer func(int, crypto.Hash)) {
+		as.iterateAccounts(func(acc *account.Account) bool {
+			consumer(acc.Number(), acc.Hash())
+			return false
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return as, nil
 }
@@ -83,11 +94,32 @@ func (as *accountStore) updateAccount(acc *account.Account) error {
 	if err != nil {
 		panic(err)
 	}
-	if !as.hasAccount(acc.Address()) {
+	isNew := !as.hasAccount(acc.Address())
+
+	batch := new(leveldb.Batch)
+	batch.Put(accountKey(acc.Address()), data)
+	if err := as.tree.update(batch, acc.Number(), acc.Hash()); err != nil {
+		return err
+	}
+	if err := as.db.Write(batch, nil); err != nil {
+		return err
+	}
+	if isNew {
 		as.total++
 	}
+	return nil
+}
+
+func (as *accountStore) merkleRoot() (crypto.Hash, error) {
+	return as.tree.Root()
+}
 
-	return tryPut(as.db, accountKey(acc.Address()), data)
+func (as *accountStore) merkleProof(addr crypto.Address) (*simpleMerkle.Proof, error) {
+	acc, err := as.account(addr)
+	if err != nil {
+		return nil, err
+	}
+	return as.tree.Proof(acc.Number())
 }
 
 func (as *accountStore) countAccounts() int {
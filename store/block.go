@@ -11,6 +11,7 @@ import (
 var (
 	blockPrefix     = []byte{0x01}
 	blockHashPrefix = []byte{0x03}
+	prunedHeightKey = []byte{0x04}
 )
 
 func blockKey(height int) []byte           { return append(blockPrefix, util.IntToSlice(height)...) }
@@ -79,3 +80,23 @@ func (bs *blockStore) hasAnyBlock() bool {
 	iter := bs.db.NewIterator(dbutil.BytesPrefix(blockHashPrefix), nil)
 	return iter.First()
 }
+
+func (bs *blockStore) deleteBlock(height int, hash crypto.Hash) error {
+	if err := bs.db.Delete(blockHashKey(hash), nil); err != nil {
+		return err
+	}
+	return bs.db.Delete(blockKey(height), nil)
+}
+
+// prunedHeight returns the height that all blocks up to it are deleted
+func (bs *blockStore) prunedHeight() int {
+	data, err := tryGet(bs.db, prunedHeightKey)
+	if err != nil {
+		return 0
+	}
+	return util.SliceToInt(data)
+}
+
+func (bs *blockStore) setPrunedHeight(height int) error {
+	return tryPut(bs.db, prunedHeightKey, util.IntToSlice(height))
+}
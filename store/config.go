@@ -6,6 +6,10 @@ import (
 
 type Config struct {
 	Path string
+	// RetainBlocks is the number of recent blocks that are kept, older blocks and their
+	// transactions are pruned. Zero keeps all the blocks.
+	// The recent blocks that the state needs are always kept.
+	RetainBlocks int
 }
 
 func DefaultConfig() *Config {
@@ -36,6 +40,22 @@ func (conf *Config) ValidatorStorePath() string {
 	return util.MakeAbs(conf.Path + "/validator.db")
 }
 
+func (conf *Config) EscrowStorePath() string {
+	return util.MakeAbs(conf.Path + "/escrow.db")
+}
+
+func (conf *Config) ProposalStorePath() string {
+	return util.MakeAbs(conf.Path + "/proposal.db")
+}
+
+func (conf *Config) ParamStorePath() string {
+	return util.MakeAbs(conf.Path + "/param.db")
+}
+
 func (conf *Config) StateStorePath() string {
 	return util.MakeAbs(conf.Path + "/state.db")
 }
+
+func (conf *Config) TxPoolJournalPath() string {
+	return util.MakeAbs(conf.Path + "/txpool.journal")
+}
@@ -0,0 +1,121 @@
+package store
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
+)
+
+type escrowStore struct {
+	db    *leveldb.DB
+	total int
+	tree  *merkleTree
+}
+
+var (
+	escrowPrefix = []byte{0x01}
+)
+
+func escrowKey(id crypto.Hash) []byte { return append(escrowPrefix, id.RawBytes()...) }
+
+func newEscrowStore(path string) (*escrowStore, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	es := &escrowStore{
+		db: db,
+	}
+	es.total = es.countEscrows()
+	es.tree, err = loadMerkleTree(db, es.total, func(consumer func(int, crypto.Hash)) {
+		es.iterateEscrows(func(e *escrow.Escrow) bool {
+			consumer(e.Number(), e.Hash())
+			return false
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return es, nil
+}
+
+func (es *escrowStore) close() error {
+	return es.db.Close()
+}
+
+func (es *escrowStore) hasEscrow(id crypto.Hash) bool {
+	has, err := es.db.Has(escrowKey(id), nil)
+	if err != nil {
+		return false
+	}
+	return has
+}
+
+func (es *escrowStore) escrow(id crypto.Hash) (*escrow.Escrow, error) {
+	bs, err := tryGet(es.db, escrowKey(id))
+	if err != nil {
+		return nil, err
+	}
+
+	e := new(escrow.Escrow)
+	if err := e.Decode(bs); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (es *escrowStore) iterateEscrows(consumer func(*escrow.Escrow) (stop bool)) {
+	r := util.BytesPrefix(escrowPrefix)
+	iter := es.db.NewIterator(r, nil)
+	defer iter.Release()
+	for iter.Next() {
+		value := iter.Value()
+
+		e := new(escrow.Escrow)
+		if err := e.Decode(value); err != nil {
+			panic(err)
+		}
+
+		stopped := consumer(e)
+		if stopped {
+			return
+		}
+	}
+}
+
+func (es *escrowStore) updateEscrow(e *escrow.Escrow) error {
+	data, err := e.Encode()
+	if err != nil {
+		panic(err)
+	}
+	isNew := !es.hasEscrow(e.ID())
+
+	batch := new(leveldb.Batch)
+	batch.Put(escrowKey(e.ID()), data)
+	if err := es.tree.update(batch, e.Number(), e.Hash()); err != nil {
+		return err
+	}
+	if err := es.db.Write(batch, nil); err != nil {
+		return err
+	}
+	if isNew {
+		es.total++
+	}
+	return nil
+}
+
+func (es *escrowStore) merkleRoot() (crypto.Hash, error) {
+	return es.tree.Root()
+}
+
+func (es *escrowStore) countEscrows() int {
+	count := 0
+	es.iterateEscrows(func(e *escrow.Escrow) bool {
+		count++
+		return false
+	})
+	return count
+}
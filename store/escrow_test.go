@@ -0,0 +1,36 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestRetrieveEscrow(t *testing.T) {
+	store, _ := newEscrowStore(util.TempDirPath())
+
+	e := escrow.GenerateTestEscrow(0)
+
+	t.Run("Add escrow, should able to retrieve", func(t *testing.T) {
+		assert.False(t, store.hasEscrow(e.ID()))
+		assert.NoError(t, store.updateEscrow(e))
+		assert.True(t, store.hasEscrow(e.ID()))
+		e2, err := store.escrow(e.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, e.Hash(), e2.Hash())
+		assert.Equal(t, store.total, 1)
+	})
+
+	t.Run("Claim escrow, should update database without increasing counter", func(t *testing.T) {
+		e.Claim()
+		assert.NoError(t, store.updateEscrow(e))
+
+		e2, err := store.escrow(e.ID())
+		assert.NoError(t, err)
+		assert.True(t, e2.IsClaimed())
+		assert.Equal(t, store.total, store.countEscrows())
+		assert.Equal(t, store.total, 1)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/validator"
 )
@@ -18,4 +20,12 @@ type StoreReader interface {
 	HasValidator(crypto.Address) bool
 	Validator(addr crypto.Address) (*validator.Validator, error)
 	TotalValidators() int
+	HasEscrow(crypto.Hash) bool
+	Escrow(id crypto.Hash) (*escrow.Escrow, error)
+	TotalEscrows() int
+	HasProposal(crypto.Hash) bool
+	Proposal(id crypto.Hash) (*governance.Proposal, error)
+	TotalProposals() int
+	OpenProposals(proposer crypto.Address, height int) int
+	PrunedHeight() int
 }
@@ -0,0 +1,112 @@
+package store
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/zarbchain/zarb-go/crypto"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+var (
+	merkleNodePrefix = []byte{0x02}
+	merkleSizeKey    = []byte{0x03}
+)
+
+func merkleNodeKey(level, index int) []byte {
+	key := append(merkleNodePrefix, util.IntToSlice(level)...)
+	return append(key, util.IntToSlice(index)...)
+}
+
+// merkleNodes keeps the nodes of the incremental merkle tree, next to the entities in the same database.
+// The changed nodes are kept in the batch until it is written.
+type merkleNodes struct {
+	db      *leveldb.DB
+	batch   *leveldb.Batch
+	changed map[string]crypto.Hash
+}
+
+func (m *merkleNodes) Node(level, index int) (crypto.Hash, error) {
+	key := merkleNodeKey(level, index)
+	if hash, ok := m.changed[string(key)]; ok {
+		return hash, nil
+	}
+	bs, err := tryGet(m.db, key)
+	if err != nil {
+		return crypto.UndefHash, err
+	}
+	return crypto.HashFromRawBytes(bs)
+}
+
+func (m *merkleNodes) SetNode(level, index int, hash crypto.Hash) error {
+	key := merkleNodeKey(level, index)
+	m.changed[string(key)] = hash
+	m.batch.Put(key, hash.RawBytes())
+	return nil
+}
+
+// merkleTree is an incremental merkle tree that keeps its nodes in the database of the entities
+type merkleTree struct {
+	*simpleMerkle.IncrementalTree
+	nodes *merkleNodes
+}
+
+// loadMerkleTree loads the merkle tree of the entities.
+// If the tree doesn't match the entities, for example in an old database, it is rebuilt.
+func loadMerkleTree(db *leveldb.DB, total int, iterate func(consumer func(number int, hash crypto.Hash))) (*merkleTree, error) {
+	nodes := &merkleNodes{db: db}
+	size := 0
+	if bs, err := tryGet(db, merkleSizeKey); err == nil {
+		size = util.SliceToInt(bs)
+	}
+	if size == total {
+		return &merkleTree{
+			IncrementalTree: simpleMerkle.NewIncrementalTree(nodes, size),
+			nodes:           nodes,
+		}, nil
+	}
+
+	hashes := make(map[int]crypto.Hash)
+	iterate(func(number int, hash crypto.Hash) {
+		hashes[number] = hash
+	})
+	tree := &merkleTree{
+		IncrementalTree: simpleMerkle.NewIncrementalTree(nodes, 0),
+		nodes:           nodes,
+	}
+	for number := 0; len(hashes) > 0; number++ {
+		hash, ok := hashes[number]
+		if !ok {
+			continue
+		}
+		batch := new(leveldb.Batch)
+		if err := tree.update(batch, number, hash); err != nil {
+			return nil, err
+		}
+		if err := db.Write(batch, nil); err != nil {
+			return nil, err
+		}
+		delete(hashes, number)
+	}
+	return tree, nil
+}
+
+// update sets the leaf of the entity and puts the changed nodes and the size of the tree into the batch.
+// The batch should contain the entity itself, so both are written atomically.
+// It should be called once for each batch, just before writing it.
+func (tree *merkleTree) update(batch *leveldb.Batch, number int, hash crypto.Hash) error {
+	size := tree.Size()
+	tree.nodes.batch = batch
+	tree.nodes.changed = make(map[string]crypto.Hash)
+	defer func() {
+		tree.nodes.batch = nil
+		tree.nodes.changed = nil
+	}()
+
+	if err := tree.SetLeaf(number, hash); err != nil {
+		return err
+	}
+	if tree.Size() != size {
+		batch.Put(merkleSizeKey, util.IntToSlice(tree.Size()))
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/util"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+func accountsRoot(accs []*account.Account) crypto.Hash {
+	hashes := make([]crypto.Hash, len(accs))
+	for i, acc := range accs {
+		hashes[i] = acc.Hash()
+	}
+	return simpleMerkle.NewTreeFromHashes(hashes).Root()
+}
+
+func TestAccountsMerkleRoot(t *testing.T) {
+	path := util.TempDirPath()
+	store, err := newAccountStore(path)
+	require.NoError(t, err)
+
+	root, err := store.merkleRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, crypto.UndefHash)
+
+	accs := make([]*account.Account, 0)
+	for i := 0; i < 10; i++ {
+		acc, _ := account.GenerateTestAccount(i)
+		accs = append(accs, acc)
+		require.NoError(t, store.updateAccount(acc))
+
+		root, err := store.merkleRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root, accountsRoot(accs))
+	}
+
+	accs[3].AddToBalance(1)
+	require.NoError(t, store.updateAccount(accs[3]))
+	root, err = store.merkleRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, accountsRoot(accs))
+
+	t.Run("Merkle tree should be loaded after reopening the database", func(t *testing.T) {
+		require.NoError(t, store.close())
+		store2, err := newAccountStore(path)
+		require.NoError(t, err)
+
+		root2, err := store2.merkleRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root2, accountsRoot(accs))
+
+		accs[9].AddToBalance(1)
+		require.NoError(t, store2.updateAccount(accs[9]))
+		root2, err = store2.merkleRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root2, accountsRoot(accs))
+		require.NoError(t, store2.close())
+	})
+
+	t.Run("Merkle tree should be rebuilt if it is not in the database", func(t *testing.T) {
+		store3, err := newAccountStore(path)
+		require.NoError(t, err)
+		require.NoError(t, store3.db.Delete(merkleSizeKey, nil))
+		require.NoError(t, store3.close())
+
+		store3, err = newAccountStore(path)
+		require.NoError(t, err)
+		assert.Equal(t, store3.tree.Size(), 10)
+		root3, err := store3.merkleRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root3, accountsRoot(accs))
+	})
+}
+
+func TestValidatorsMerkleRoot(t *testing.T) {
+	store, err := newValidatorStore(util.TempDirPath())
+	require.NoError(t, err)
+
+	hashes := make([]crypto.Hash, 0)
+	for i := 0; i < 5; i++ {
+		val, _ := validator.GenerateTestValidator(i)
+		hashes = append(hashes, val.Hash())
+		require.NoError(t, store.updateValidator(val))
+	}
+
+	root, err := store.merkleRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, simpleMerkle.NewTreeFromHashes(hashes).Root())
+}
+
+func TestEscrowsMerkleRoot(t *testing.T) {
+	store, err := newEscrowStore(util.TempDirPath())
+	require.NoError(t, err)
+
+	hashes := make([]crypto.Hash, 0)
+	for i := 0; i < 5; i++ {
+		e := escrow.GenerateTestEscrow(i)
+		hashes = append(hashes, e.Hash())
+		require.NoError(t, store.updateEscrow(e))
+	}
+
+	root, err := store.merkleRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, simpleMerkle.NewTreeFromHashes(hashes).Root())
+}
+
+func TestProposalsMerkleRoot(t *testing.T) {
+	path := util.TempDirPath()
+	store, err := newProposalStore(path)
+	require.NoError(t, err)
+
+	hashes := make([]crypto.Hash, 0)
+	for i := 0; i < 5; i++ {
+		p := governance.GenerateTestProposal(i)
+		hashes = append(hashes, p.Hash())
+		require.NoError(t, store.updateProposal(p))
+	}
+
+	root, err := store.merkleRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, simpleMerkle.NewTreeFromHashes(hashes).Root())
+
+	t.Run("Merkle tree should be loaded after reopening the database", func(t *testing.T) {
+		require.NoError(t, store.close())
+		store2, err := newProposalStore(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, store2.total, 5)
+		assert.Equal(t, store2.tree.Size(), 5)
+		root2, err := store2.merkleRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root2, root)
+	})
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
 	"github.com/zarbchain/zarb-go/validator"
@@ -15,7 +17,10 @@ type MockStore struct {
 	Blocks       map[int]*block.Block
 	Accounts     map[crypto.Address]*account.Account
 	Validators   map[crypto.Address]*validator.Validator
+	Escrows      map[crypto.Hash]*escrow.Escrow
+	Proposals    map[crypto.Hash]*governance.Proposal
 	Transactions map[crypto.Hash]*tx.CommittedTx
+	LastPruned   int
 }
 
 func NewMockStore() *MockStore {
@@ -23,6 +28,8 @@ func NewMockStore() *MockStore {
 		Blocks:       make(map[int]*block.Block),
 		Accounts:     make(map[crypto.Address]*account.Account),
 		Validators:   make(map[crypto.Address]*validator.Validator),
+		Escrows:      make(map[crypto.Hash]*escrow.Escrow),
+		Proposals:    make(map[crypto.Hash]*governance.Proposal),
 		Transactions: make(map[crypto.Hash]*tx.CommittedTx),
 	}
 }
@@ -50,7 +57,9 @@ func (m *MockStore) HasAccount(addr crypto.Address) bool {
 func (m *MockStore) Account(addr crypto.Address) (*account.Account, error) {
 	a, ok := m.Accounts[addr]
 	if ok {
-		return a, nil
+		copy := new(account.Account)
+		*copy = *a
+		return copy, nil
 	}
 	return nil, fmt.Errorf("Not found")
 }
@@ -67,7 +76,9 @@ func (m *MockStore) HasValidator(addr crypto.Address) bool {
 func (m *MockStore) Validator(addr crypto.Address) (*validator.Validator, error) {
 	v, ok := m.Validators[addr]
 	if ok {
-		return v, nil
+		copy := new(validator.Validator)
+		*copy = *v
+		return copy, nil
 	}
 	return nil, fmt.Errorf("Not found")
 }
@@ -77,6 +88,56 @@ func (m *MockStore) UpdateValidator(val *validator.Validator) {
 func (m *MockStore) TotalValidators() int {
 	return len(m.Validators)
 }
+func (m *MockStore) HasEscrow(id crypto.Hash) bool {
+	_, ok := m.Escrows[id]
+	return ok
+}
+func (m *MockStore) Escrow(id crypto.Hash) (*escrow.Escrow, error) {
+	e, ok := m.Escrows[id]
+	if ok {
+		copy := new(escrow.Escrow)
+		*copy = *e
+		return copy, nil
+	}
+	return nil, fmt.Errorf("Not found")
+}
+func (m *MockStore) UpdateEscrow(e *escrow.Escrow) {
+	m.Escrows[e.ID()] = e
+}
+func (m *MockStore) TotalEscrows() int {
+	return len(m.Escrows)
+}
+func (m *MockStore) HasProposal(id crypto.Hash) bool {
+	_, ok := m.Proposals[id]
+	return ok
+}
+func (m *MockStore) Proposal(id crypto.Hash) (*governance.Proposal, error) {
+	p, ok := m.Proposals[id]
+	if ok {
+		copy := new(governance.Proposal)
+		*copy = *p
+		return copy, nil
+	}
+	return nil, fmt.Errorf("Not found")
+}
+func (m *MockStore) UpdateProposal(p *governance.Proposal) {
+	m.Proposals[p.ID()] = p
+}
+func (m *MockStore) TotalProposals() int {
+	return len(m.Proposals)
+}
+func (m *MockStore) OpenProposals(proposer crypto.Address, height int) int {
+	count := 0
+	for _, p := range m.Proposals {
+		if p.Proposer().EqualsTo(proposer) && p.ActivationHeight() >= height {
+			count++
+		}
+	}
+	return count
+}
+func (m *MockStore) PrunedHeight() int {
+	return m.LastPruned
+}
 func (m *MockStore) LastBlockHeight() int {
 	max := 0
 	for h := range m.Blocks {
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"github.com/fxamacker/cbor/v2"
+	"github.com/syndtr/goleveldb/leveldb"
+	dbutil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+// paramStore keeps the history of the chain parameters, indexed by their activation height
+type paramStore struct {
+	db *leveldb.DB
+}
+
+var (
+	paramPrefix = []byte{0x01}
+)
+
+func paramKey(height int) []byte { return append(paramPrefix, util.IntToSlice(height)...) }
+
+func newParamStore(path string) (*paramStore, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &paramStore{
+		db: db,
+	}, nil
+}
+
+func (ps *paramStore) close() error {
+	return ps.db.Close()
+}
+
+func (ps *paramStore) saveParams(height int, params param.Params) error {
+	data, err := cbor.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return tryPut(ps.db, paramKey(height), data)
+}
+
+func (ps *paramStore) iterateParams(consumer func(height int, params param.Params) (stop bool)) {
+	r := dbutil.BytesPrefix(paramPrefix)
+	iter := ps.db.NewIterator(r, nil)
+	defer iter.Release()
+	for iter.Next() {
+		height := util.SliceToInt(iter.Key()[len(paramPrefix):])
+
+		var params param.Params
+		if err := cbor.Unmarshal(iter.Value(), &params); err != nil {
+			panic(err)
+		}
+
+		if consumer(height, params) {
+			return
+		}
+	}
+}
+
+// params returns the parameters that are active at the given height.
+// Keys are not sorted by height, so we should check all of them.
+func (ps *paramStore) params(height int) (param.Params, bool) {
+	found := false
+	activation := 0
+	var params param.Params
+	ps.iterateParams(func(h int, p param.Params) bool {
+		if h <= height && (!found || h > activation) {
+			found = true
+			activation = h
+			params = p
+		}
+		return false
+	})
+	return params, found
+}
@@ -0,0 +1,41 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestParamsHistory(t *testing.T) {
+	store, _ := newParamStore(util.TempDirPath())
+
+	_, found := store.params(100)
+	assert.False(t, found)
+
+	p1 := param.MainnetParams()
+	p2 := param.MainnetParams()
+	p2.MinimumFee = 2000
+	p3 := param.MainnetParams()
+	p3.MinimumFee = 3000
+	assert.NoError(t, store.saveParams(300, p3))
+	assert.NoError(t, store.saveParams(1, p1))
+	assert.NoError(t, store.saveParams(256, p2))
+
+	_, found = store.params(0)
+	assert.False(t, found)
+
+	p, found := store.params(1)
+	assert.True(t, found)
+	assert.Equal(t, p, p1)
+
+	p, _ = store.params(255)
+	assert.Equal(t, p, p1)
+
+	p, _ = store.params(256)
+	assert.Equal(t, p, p2)
+
+	p, _ = store.params(1000)
+	assert.Equal(t, p, p3)
+}
@@ -0,0 +1,168 @@
+package store
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	dbutil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/governance"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+type proposalStore struct {
+	db    *leveldb.DB
+	total int
+	tree  *merkleTree
+}
+
+var (
+	proposalPrefix           = []byte{0x01}
+	proposalActivationPrefix = []byte{0x04}
+	proposalProposerPrefix   = []byte{0x05}
+)
+
+func proposalKey(id crypto.Hash) []byte { return append(proposalPrefix, id.RawBytes()...) }
+func proposalActivationKey(height int) []byte {
+	return append(proposalActivationPrefix, util.IntToSlice(height)...)
+}
+func proposalProposerKey(proposer crypto.Address) []byte {
+	return append(proposalProposerPrefix, proposer.RawBytes()...)
+}
+
+func newProposalStore(path string) (*proposalStore, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	ps := &proposalStore{
+		db: db,
+	}
+	ps.total = ps.countProposals()
+	ps.tree, err = loadMerkleTree(db, ps.total, func(consumer func(int, crypto.Hash)) {
+		ps.iterateProposals(func(p *governance.Proposal) bool {
+			consumer(p.Number(), p.Hash())
+			return false
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+func (ps *proposalStore) close() error {
+	return ps.db.Close()
+}
+
+func (ps *proposalStore) hasProposal(id crypto.Hash) bool {
+	has, err := ps.db.Has(proposalKey(id), nil)
+	if err != nil {
+		return false
+	}
+	return has
+}
+
+func (ps *proposalStore) proposal(id crypto.Hash) (*governance.Proposal, error) {
+	bs, err := tryGet(ps.db, proposalKey(id))
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(governance.Proposal)
+	if err := p.Decode(bs); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (ps *proposalStore) iterateProposals(consumer func(*governance.Proposal) (stop bool)) {
+	r := dbutil.BytesPrefix(proposalPrefix)
+	iter := ps.db.NewIterator(r, nil)
+	defer iter.Release()
+	for iter.Next() {
+		value := iter.Value()
+
+		p := new(governance.Proposal)
+		if err := p.Decode(value); err != nil {
+			panic(err)
+		}
+
+		stopped := consumer(p)
+		if stopped {
+			return
+		}
+	}
+}
+
+// iterateProposalsAt iterates over the proposals that are activated at the given height
+func (ps *proposalStore) iterateProposalsAt(height int, consumer func(*governance.Proposal) (stop bool)) {
+	prefix := proposalActivationKey(height)
+	iter := ps.db.NewIterator(dbutil.BytesPrefix(prefix), nil)
+	defer iter.Release()
+	for iter.Next() {
+		id, err := crypto.HashFromRawBytes(iter.Key()[len(prefix):])
+		if err != nil {
+			panic(err)
+		}
+		p, err := ps.proposal(id)
+		if err != nil {
+			panic(err)
+		}
+
+		stopped := consumer(p)
+		if stopped {
+			return
+		}
+	}
+}
+
+// openProposals counts the proposals of the proposer that are not activated before the given height
+func (ps *proposalStore) openProposals(proposer crypto.Address, height int) int {
+	iter := ps.db.NewIterator(dbutil.BytesPrefix(proposalProposerKey(proposer)), nil)
+	defer iter.Release()
+	count := 0
+	for iter.Next() {
+		if util.SliceToInt(iter.Value()) >= height {
+			count++
+		}
+	}
+	return count
+}
+
+// updateProposal saves the proposal, indexes it by the activation height and the proposer and updates the merkle tree
+func (ps *proposalStore) updateProposal(p *governance.Proposal) error {
+	data, err := p.Encode()
+	if err != nil {
+		panic(err)
+	}
+	isNew := !ps.hasProposal(p.ID())
+
+	batch := new(leveldb.Batch)
+	batch.Put(proposalKey(p.ID()), data)
+	batch.Put(append(proposalActivationKey(p.ActivationHeight()), p.ID().RawBytes()...), nil)
+	batch.Put(append(proposalProposerKey(p.Proposer()), p.ID().RawBytes()...), util.IntToSlice(p.ActivationHeight()))
+	if err := ps.tree.update(batch, p.Number(), p.Hash()); err != nil {
+		return err
+	}
+	if err := ps.db.Write(batch, nil); err != nil {
+		return err
+	}
+	if isNew {
+		ps.total++
+	}
+	return nil
+}
+
+func (ps *proposalStore) merkleRoot() (crypto.Hash, error) {
+	return ps.tree.Root()
+}
+
+func (ps *proposalStore) countProposals() int {
+	count := 0
+	ps.iterateProposals(func(p *governance.Proposal) bool {
+		count++
+		return false
+	})
+	return count
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/governance"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestProposalIndexes(t *testing.T) {
+	store, _ := newProposalStore(util.TempDirPath())
+
+	proposer, _, _ := crypto.GenerateTestKeyPair()
+	p1 := governance.NewProposal(crypto.GenerateTestHash(), 1)
+	p1.Propose(proposer, param.MainnetParams(), 100)
+	p2 := governance.NewProposal(crypto.GenerateTestHash(), 2)
+	p2.Propose(proposer, param.MainnetParams(), 200)
+	p3 := governance.GenerateTestProposal(3)
+	p3.Propose(p3.Proposer(), p3.Params(), 100)
+
+	assert.NoError(t, store.updateProposal(p1))
+	assert.NoError(t, store.updateProposal(p2))
+	assert.NoError(t, store.updateProposal(p3))
+
+	t.Run("Updating a proposal should not increase the counter", func(t *testing.T) {
+		p1.AddVote(proposer)
+		assert.NoError(t, store.updateProposal(p1))
+		assert.Equal(t, store.total, 3)
+		assert.Equal(t, store.total, store.countProposals())
+	})
+
+	t.Run("Should iterate over the proposals of the activation height", func(t *testing.T) {
+		ids := []crypto.Hash{}
+		store.iterateProposalsAt(100, func(p *governance.Proposal) bool {
+			ids = append(ids, p.ID())
+			return false
+		})
+		assert.ElementsMatch(t, ids, []crypto.Hash{p1.ID(), p3.ID()})
+
+		count := 0
+		store.iterateProposalsAt(150, func(p *governance.Proposal) bool {
+			count++
+			return false
+		})
+		assert.Zero(t, count)
+	})
+
+	t.Run("Should count the open proposals of the proposer", func(t *testing.T) {
+		assert.Equal(t, store.openProposals(proposer, 100), 2)
+		assert.Equal(t, store.openProposals(proposer, 101), 1)
+		assert.Equal(t, store.openProposals(proposer, 201), 0)
+		assert.Equal(t, store.openProposals(p3.Proposer(), 100), 1)
+	})
+}
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"github.com/fxamacker/cbor/v2"
+	"github.com/syndtr/goleveldb/leveldb"
+	dbutil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+// ReadView is a consistent view of the blocks and the entities at the time it is created.
+// Changes after that are not visible in the view, so it can be read in the background.
+// It should be released after using it.
+type ReadView struct {
+	blocks     *leveldb.Snapshot
+	txs        *leveldb.Snapshot
+	accounts   *leveldb.Snapshot
+	validators *leveldb.Snapshot
+	escrows    *leveldb.Snapshot
+	proposals  *leveldb.Snapshot
+}
+
+// NewReadView creates a read view of the store.
+func (s *Store) NewReadView() (*ReadView, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	rv := new(ReadView)
+	var err error
+	if rv.blocks, err = s.blockStore.db.GetSnapshot(); err != nil {
+		rv.Release()
+		return nil, err
+	}
+	if rv.txs, err = s.txStore.db.GetSnapshot(); err != nil {
+		rv.Release()
+		return nil, err
+	}
+	if rv.accounts, err = s.accountStore.db.GetSnapshot(); err != nil {
+		rv.Release()
+		return nil, err
+	}
+	if rv.validators, err = s.validatorStore.db.GetSnapshot(); err != nil {
+		rv.Release()
+		return nil, err
+	}
+	if rv.escrows, err = s.escrowStore.db.GetSnapshot(); err != nil {
+		rv.Release()
+		return nil, err
+	}
+	if rv.proposals, err = s.proposalStore.db.GetSnapshot(); err != nil {
+		rv.Release()
+		return nil, err
+	}
+	return rv, nil
+}
+
+func (rv *ReadView) Release() {
+	for _, snap := range []*leveldb.Snapshot{rv.blocks, rv.txs, rv.accounts, rv.validators, rv.escrows, rv.proposals} {
+		if snap != nil {
+			snap.Release()
+		}
+	}
+}
+
+func (rv *ReadView) Block(height int) (*block.Block, error) {
+	data, err := rv.blocks.Get(blockKey(height), nil)
+	if err != nil {
+		return nil, err
+	}
+	b := new(block.Block)
+	if err := b.Decode(data); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (rv *ReadView) Transaction(hash crypto.Hash) (*tx.CommittedTx, error) {
+	data, err := rv.txs.Get(txKey(hash), nil)
+	if err != nil {
+		return nil, err
+	}
+	ctrx := new(tx.CommittedTx)
+	if err := cbor.Unmarshal(data, ctrx); err != nil {
+		return nil, err
+	}
+	return ctrx, nil
+}
+
+func (rv *ReadView) IterateAccounts(consumer func(*account.Account) (stop bool)) error {
+	return iterateView(rv.accounts, accountPrefix, func(value []byte) (bool, error) {
+		acc := new(account.Account)
+		if err := acc.Decode(value); err != nil {
+			return true, err
+		}
+		return consumer(acc), nil
+	})
+}
+
+func (rv *ReadView) IterateValidators(consumer func(*validator.Validator) (stop bool)) error {
+	return iterateView(rv.validators, validatorPrefix, func(value []byte) (bool, error) {
+		val := new(validator.Validator)
+		if err := val.Decode(value); err != nil {
+			return true, err
+		}
+		return consumer(val), nil
+	})
+}
+
+func (rv *ReadView) IterateEscrows(consumer func(*escrow.Escrow) (stop bool)) error {
+	return iterateView(rv.escrows, escrowPrefix, func(value []byte) (bool, error) {
+		e := new(escrow.Escrow)
+		if err := e.Decode(value); err != nil {
+			return true, err
+		}
+		return consumer(e), nil
+	})
+}
+
+func (rv *ReadView) IterateProposals(consumer func(*governance.Proposal) (stop bool)) error {
+	return iterateView(rv.proposals, proposalPrefix, func(value []byte) (bool, error) {
+		p := new(governance.Proposal)
+		if err := p.Decode(value); err != nil {
+			return true, err
+		}
+		return consumer(p), nil
+	})
+}
+
+func iterateView(snap *leveldb.Snapshot, prefix []byte, consumer func(value []byte) (stop bool, err error)) error {
+	iter := snap.NewIterator(dbutil.BytesPrefix(prefix), nil)
+	defer iter.Release()
+	for iter.Next() {
+		stop, err := consumer(iter.Value())
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return iter.Error()
+}
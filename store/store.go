@@ -1,11 +1,17 @@
 package store
 
 import (
+	"sync"
+
 	"github.com/sasha-s/go-deadlock"
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/escrow"
+	"github.com/zarbchain/zarb-go/governance"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/validator"
 )
@@ -18,6 +24,12 @@ type Store struct {
 	txStore        *txStore
 	accountStore   *accountStore
 	validatorStore *validatorStore
+	escrowStore    *escrowStore
+	proposalStore  *proposalStore
+	paramStore     *paramStore
+	pruning        bool
+	pruneWG        sync.WaitGroup
+	closed         bool
 }
 
 func NewStore(conf *Config) (*Store, error) {
@@ -37,15 +49,36 @@ func NewStore(conf *Config) (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
+	escrowStore, err := newEscrowStore(conf.EscrowStorePath())
+	if err != nil {
+		return nil, err
+	}
+	proposalStore, err := newProposalStore(conf.ProposalStorePath())
+	if err != nil {
+		return nil, err
+	}
+	paramStore, err := newParamStore(conf.ParamStorePath())
+	if err != nil {
+		return nil, err
+	}
 	return &Store{
 		config:         conf,
 		blockStore:     blockStore,
 		txStore:        txStore,
 		accountStore:   accountStore,
 		validatorStore: validatorStore,
+		escrowStore:    escrowStore,
+		proposalStore:  proposalStore,
+		paramStore:     paramStore,
 	}, nil
 }
 func (s *Store) Close() error {
+	// Wait for pruning to stop
+	s.lk.Lock()
+	s.closed = true
+	s.lk.Unlock()
+	s.pruneWG.Wait()
+
 	if err := s.blockStore.close(); err != nil {
 		return err
 	}
@@ -58,6 +91,15 @@ func (s *Store) Close() error {
 	if err := s.validatorStore.close(); err != nil {
 		return err
 	}
+	if err := s.escrowStore.close(); err != nil {
+		return err
+	}
+	if err := s.proposalStore.close(); err != nil {
+		return err
+	}
+	if err := s.paramStore.close(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -120,6 +162,22 @@ func (s *Store) TotalAccounts() int {
 	return s.accountStore.total
 }
 
+// AccountsMerkleRoot returns the root of the merkle tree of the accounts, ordered by their numbers
+func (s *Store) AccountsMerkleRoot() (crypto.Hash, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.accountStore.merkleRoot()
+}
+
+// AccountMerkleProof returns the inclusion proof of the account in the merkle tree of the accounts
+func (s *Store) AccountMerkleProof(addr crypto.Address) (*simpleMerkle.Proof, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.accountStore.merkleProof(addr)
+}
+
 func (s *Store) IterateAccounts(consumer func(*account.Account) (stop bool)) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -157,6 +215,22 @@ func (s *Store) TotalValidators() int {
 	return s.validatorStore.total
 }
 
+// ValidatorsMerkleRoot returns the root of the merkle tree of the validators, ordered by their numbers
+func (s *Store) ValidatorsMerkleRoot() (crypto.Hash, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.validatorStore.merkleRoot()
+}
+
+// ValidatorMerkleProof returns the inclusion proof of the validator in the merkle tree of the validators
+func (s *Store) ValidatorMerkleProof(addr crypto.Address) (*simpleMerkle.Proof, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.validatorStore.merkleProof(addr)
+}
+
 func (s *Store) IterateValidators(consumer func(*validator.Validator) (stop bool)) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -173,9 +247,204 @@ func (s *Store) UpdateValidator(acc *validator.Validator) {
 	}
 }
 
+func (s *Store) HasEscrow(id crypto.Hash) bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.escrowStore.hasEscrow(id)
+}
+
+func (s *Store) Escrow(id crypto.Hash) (*escrow.Escrow, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.escrowStore.escrow(id)
+}
+
+func (s *Store) TotalEscrows() int {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.escrowStore.total
+}
+
+// EscrowsMerkleRoot returns the root of the merkle tree of the escrows, ordered by their numbers
+func (s *Store) EscrowsMerkleRoot() (crypto.Hash, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.escrowStore.merkleRoot()
+}
+
+func (s *Store) IterateEscrows(consumer func(*escrow.Escrow) (stop bool)) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.escrowStore.iterateEscrows(consumer)
+}
+
+func (s *Store) UpdateEscrow(e *escrow.Escrow) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if err := s.escrowStore.updateEscrow(e); err != nil {
+		logger.Panic("Error on updating an escrow: %v", err)
+	}
+}
+
+func (s *Store) HasProposal(id crypto.Hash) bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.proposalStore.hasProposal(id)
+}
+
+func (s *Store) Proposal(id crypto.Hash) (*governance.Proposal, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.proposalStore.proposal(id)
+}
+
+func (s *Store) TotalProposals() int {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.proposalStore.total
+}
+
+// ProposalsMerkleRoot returns the root of the merkle tree of the proposals, ordered by their numbers
+func (s *Store) ProposalsMerkleRoot() (crypto.Hash, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.proposalStore.merkleRoot()
+}
+
+func (s *Store) IterateProposals(consumer func(*governance.Proposal) (stop bool)) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.proposalStore.iterateProposals(consumer)
+}
+
+// IterateProposalsAt iterates over the proposals that are activated at the given height
+func (s *Store) IterateProposalsAt(height int, consumer func(*governance.Proposal) (stop bool)) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.proposalStore.iterateProposalsAt(height, consumer)
+}
+
+// OpenProposals returns the number of the proposals of the proposer that are not activated before the given height
+func (s *Store) OpenProposals(proposer crypto.Address, height int) int {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.proposalStore.openProposals(proposer, height)
+}
+
+func (s *Store) UpdateProposal(p *governance.Proposal) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if err := s.proposalStore.updateProposal(p); err != nil {
+		logger.Panic("Error on updating a proposal: %v", err)
+	}
+}
+
+// SaveParams keeps the parameters that are activated at the given height
+func (s *Store) SaveParams(height int, params param.Params) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if err := s.paramStore.saveParams(height, params); err != nil {
+		logger.Panic("Error on saving params: %v", err)
+	}
+}
+
+// Params returns the parameters that are active at the given height.
+// It returns false if the parameters are not changed since genesis.
+func (s *Store) Params(height int) (param.Params, bool) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.paramStore.params(height)
+}
+
 func (s *Store) HasAnyBlock() bool {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
 	return s.blockStore.hasAnyBlock()
 }
+
+// PrunedHeight returns the height that all blocks up to it are pruned
+func (s *Store) PrunedHeight() int {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.blockStore.prunedHeight()
+}
+
+// PruneBlocks deletes the blocks up to the given height and their transactions in the background.
+// If pruning is in progress, it does nothing.
+func (s *Store) PruneBlocks(height int) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if s.pruning || s.closed {
+		return
+	}
+	s.pruning = true
+	s.pruneWG.Add(1)
+	go s.pruneBlocks(height)
+}
+
+// MarkPruned marks the blocks up to the given height as pruned, without deleting them.
+// It is used when the blocks are not available, like restoring the state from a snapshot.
+func (s *Store) MarkPruned(height int) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if err := s.blockStore.setPrunedHeight(height); err != nil {
+		logger.Panic("Error on saving pruned height: %v", err)
+	}
+}
+
+func (s *Store) pruneBlocks(height int) {
+	defer s.pruneWG.Done()
+
+	for {
+		s.lk.Lock()
+		h := s.blockStore.prunedHeight() + 1
+		if s.closed || h > height {
+			s.pruning = false
+			s.lk.Unlock()
+			return
+		}
+		if err := s.pruneBlock(h); err != nil {
+			logger.Error("Error on pruning a block", "height", h, "err", err)
+			s.pruning = false
+			s.lk.Unlock()
+			return
+		}
+		s.lk.Unlock()
+	}
+}
+
+func (s *Store) pruneBlock(height int) error {
+	// Block might not exist, if the state is restored from a snapshot
+	b, err := s.blockStore.block(height)
+	if err == nil {
+		for _, id := range b.TxIDs().IDs() {
+			if err := s.txStore.deleteTx(id); err != nil {
+				return err
+			}
+		}
+		if err := s.blockStore.deleteBlock(height, b.Hash()); err != nil {
+			return err
+		}
+	}
+	return s.blockStore.setPrunedHeight(height)
+}
@@ -2,8 +2,10 @@ package store
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
@@ -44,3 +46,92 @@ func TestRetrieveBlockAndTransactions(t *testing.T) {
 		assert.Equal(t, r, ctrx2.Receipt)
 	}
 }
+
+func TestPruneBlocks(t *testing.T) {
+	conf := TestConfig()
+	store, err := NewStore(conf)
+	assert.NoError(t, err)
+
+	blocks := make([]*block.Block, 0)
+	txs := make([]*tx.Tx, 0)
+	for h := 1; h <= 10; h++ {
+		b, trxs := block.GenerateTestBlock(nil)
+		assert.NoError(t, store.SaveBlock(*b, h))
+		for _, trx := range trxs {
+			store.SaveTransaction(tx.CommittedTx{Tx: trx, Receipt: trx.GenerateReceipt(tx.Ok, b.Hash())})
+		}
+		blocks = append(blocks, b)
+		txs = append(txs, trxs[0])
+	}
+	assert.Equal(t, store.PrunedHeight(), 0)
+
+	store.PruneBlocks(6)
+	assert.Eventually(t, func() bool { return store.PrunedHeight() == 6 }, time.Second, 10*time.Millisecond)
+
+	for h := 1; h <= 10; h++ {
+		_, err1 := store.Block(h)
+		_, err2 := store.BlockHeight(blocks[h-1].Hash())
+		_, err3 := store.Transaction(txs[h-1].ID())
+		if h <= 6 {
+			assert.Error(t, err1)
+			assert.Error(t, err2)
+			assert.Error(t, err3)
+		} else {
+			assert.NoError(t, err1)
+			assert.NoError(t, err2)
+			assert.NoError(t, err3)
+		}
+	}
+
+	// Pruned height is kept after reopening the store
+	assert.NoError(t, store.Close())
+	store, err = NewStore(conf)
+	assert.NoError(t, err)
+	assert.Equal(t, store.PrunedHeight(), 6)
+
+	store.MarkPruned(8)
+	assert.Equal(t, store.PrunedHeight(), 8)
+	_, err = store.Block(8)
+	assert.NoError(t, err)
+}
+
+func TestReadView(t *testing.T) {
+	conf := TestConfig()
+	store, err := NewStore(conf)
+	assert.NoError(t, err)
+
+	acc1, _ := account.GenerateTestAccount(0)
+	store.UpdateAccount(acc1)
+	b1, _ := block.GenerateTestBlock(nil)
+	assert.NoError(t, store.SaveBlock(*b1, 1))
+
+	rv, err := store.NewReadView()
+	assert.NoError(t, err)
+
+	// Changes after creating the view are not visible in the view
+	acc2, _ := account.GenerateTestAccount(1)
+	store.UpdateAccount(acc2)
+	acc1.AddToBalance(1)
+	store.UpdateAccount(acc1)
+	b2, _ := block.GenerateTestBlock(nil)
+	assert.NoError(t, store.SaveBlock(*b2, 2))
+	store.PruneBlocks(1)
+	assert.Eventually(t, func() bool { return store.PrunedHeight() == 1 }, time.Second, 10*time.Millisecond)
+
+	accs := []*account.Account{}
+	assert.NoError(t, rv.IterateAccounts(func(acc *account.Account) bool {
+		accs = append(accs, acc)
+		return false
+	}))
+	assert.Equal(t, len(accs), 1)
+	assert.Equal(t, accs[0].Balance(), acc1.Balance()-1)
+
+	b, err := rv.Block(1)
+	assert.NoError(t, err)
+	assert.Equal(t, b.Hash(), b1.Hash())
+	_, err = rv.Block(2)
+	assert.Error(t, err)
+
+	rv.Release()
+	assert.NoError(t, store.Close())
+}
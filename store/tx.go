@@ -63,3 +63,7 @@ func (ts *txStore) tx(hash crypto.Hash) (*tx.CommittedTx, error) {
 	}
 	return ctrs, nil
 }
+
+func (ts *txStore) deleteTx(hash crypto.Hash) error {
+	return ts.db.Delete(txKey(hash), nil)
+}
@@ -4,12 +4,14 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/util"
 	"github.com/zarbchain/zarb-go/crypto"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
 type validatorStore struct {
 	db    *leveldb.DB
 	total int
+	tree  *merkleTree
 }
 
 var (
@@ -27,6 +29,15 @@ func newValidatorStore(path string) (*validatorStore, error) {
 		db: db,
 	}
 	vs.total = vs.countValidators()
+	vs.tree, err = loadMerkleTree(db, vs.total, func(consumer func(int, crypto.Hash)) {
+		vs.iterateValidators(func(val *validator.Validator) bool {
+			consumer(val.Number(), val.Hash())
+			return false
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return vs, nil
 }
@@ -83,11 +94,32 @@ func (vs *validatorStore) updateValidator(val *validator.Validator) error {
 	if err != nil {
 		return err
 	}
-	if !vs.hasValidator(val.Address()) {
+	isNew := !vs.hasValidator(val.Address())
+
+	batch := new(leveldb.Batch)
+	batch.Put(validatorKey(val.Address()), data)
+	if err := vs.tree.update(batch, val.Number(), val.Hash()); err != nil {
+		return err
+	}
+	if err := vs.db.Write(batch, nil); err != nil {
+		return err
+	}
+	if isNew {
 		vs.total++
 	}
+	return nil
+}
+
+func (vs *validatorStore) merkleRoot() (crypto.Hash, error) {
+	return vs.tree.Root()
+}
 
-	return tryPut(vs.db, validatorKey(val.Address()), data)
+func (vs *validatorStore) merkleProof(addr crypto.Address) (*simpleMerkle.Proof, error) {
+	val, err := vs.validator(addr)
+	if err != nil {
+		return nil, err
+	}
+	return vs.tree.Proof(val.Number())
 }
 
 func (vs *validatorStore) countValidators() int {
@@ -1,28 +1,41 @@
 package sync
 
-import "time"
+import (
+	"time"
+
+	"github.com/zarbchain/zarb-go/crypto"
+)
 
 type Config struct {
 	StartingTimeout  time.Duration
 	HeartBeatTimeout time.Duration
 	BlockPerMessage  int
 	CacheSize        int
+	// StateSyncHeight and StateSyncHash are the height and the hash of a trusted block.
+	// If they are set, a fresh node restores the snapshot of the previous height instead of
+	// replaying all the blocks. The trusted block commits the state of the snapshot.
+	StateSyncHeight int
+	StateSyncHash   crypto.Hash
+	// SnapshotRequestLimit is the number of snapshot requests of each peer that are served in each heart-beat interval
+	SnapshotRequestLimit int
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		StartingTimeout:  time.Second * 10,
-		HeartBeatTimeout: time.Second * 10,
-		BlockPerMessage:  500,
-		CacheSize:        10000,
+		StartingTimeout:      time.Second * 10,
+		HeartBeatTimeout:     time.Second * 10,
+		BlockPerMessage:      500,
+		CacheSize:            10000,
+		SnapshotRequestLimit: 100,
 	}
 }
 
 func TestConfig() *Config {
 	return &Config{
-		StartingTimeout:  time.Second * 1,
-		HeartBeatTimeout: time.Second * 5,
-		BlockPerMessage:  10,
-		CacheSize:        100,
+		StartingTimeout:      time.Second * 1,
+		HeartBeatTimeout:     time.Second * 5,
+		BlockPerMessage:      10,
+		CacheSize:            100,
+		SnapshotRequestLimit: 4,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/message"
+	"github.com/zarbchain/zarb-go/snapshot"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
 	"github.com/zarbchain/zarb-go/vote"
@@ -51,27 +52,70 @@ func (syncer *Synchronizer) sendBlocks(from, to int) {
 func (syncer *Synchronizer) broadcastSalam() {
 	msg := message.NewSalamMessage(
 		syncer.state.GenesisHash(),
-		syncer.state.LastBlockHeight())
+		syncer.state.LastBlockHeight(),
+		syncer.handshakeFlags())
 	syncer.publishMessage(msg)
 }
 
 func (syncer *Synchronizer) broadcastAleyk() {
 	msg := message.NewAleykMessage(
 		syncer.state.GenesisHash(),
-		syncer.state.LastBlockHeight())
+		syncer.state.LastBlockHeight(),
+		syncer.handshakeFlags())
 	syncer.publishMessage(msg)
 }
 
+func (syncer *Synchronizer) handshakeFlags() int {
+	flags := 0
+	if syncer.state.StoreReader().PrunedHeight() > 0 {
+		flags |= message.FlagPruned
+	}
+	return flags
+}
+
 func (syncer *Synchronizer) broadcastBlocksReq(from, to int, hash crypto.Hash) {
 	msg := message.NewBlocksReqMessage(from, to, hash)
 	syncer.publishMessage(msg)
 }
 
+// broadcastBlocksReqTo asks the target node for the blocks, other nodes ignore the request
+func (syncer *Synchronizer) broadcastBlocksReqTo(target crypto.Address, from, to int, hash crypto.Hash) {
+	msg := message.NewBlocksReqMessage(from, to, hash)
+	msg.Target = target
+	syncer.publishMessage(msg)
+}
+
+func (syncer *Synchronizer) broadcastPrunedBlocks(target crypto.Address, from, prunedHeight int) {
+	msg := message.NewPrunedBlocksMessage(from, prunedHeight)
+	msg.Target = target
+	syncer.publishMessage(msg)
+}
+
 func (syncer *Synchronizer) broadcastBlocks(from int, blocks []*block.Block, lastCommit *block.Commit) {
 	msg := message.NewBlocksMessage(from, blocks, lastCommit)
 	syncer.publishMessage(msg)
 }
 
+func (syncer *Synchronizer) broadcastSnapshotReq() {
+	msg := message.NewSnapshotReqMessage(syncer.config.StateSyncHeight - 1)
+	syncer.publishMessage(msg)
+}
+
+func (syncer *Synchronizer) broadcastSnapshot(manifest snapshot.Manifest, nextHeader *block.Header) {
+	msg := message.NewSnapshotMessage(manifest, nextHeader)
+	syncer.publishMessage(msg)
+}
+
+func (syncer *Synchronizer) broadcastSnapshotChunkReq(height, index int) {
+	msg := message.NewSnapshotChunkReqMessage(height, index)
+	syncer.publishMessage(msg)
+}
+
+func (syncer *Synchronizer) broadcastSnapshotChunk(height, index int, data []byte) {
+	msg := message.NewSnapshotChunkMessage(height, index, data)
+	syncer.publishMessage(msg)
+}
+
 func (syncer *Synchronizer) broadcastTxs(txs []*tx.Tx) {
 	msg := message.NewTxsMessage(txs)
 	syncer.publishMessage(msg)
@@ -1,8 +1,13 @@
 package sync
 
 import (
+	"time"
+
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/message"
+	"github.com/zarbchain/zarb-go/snapshot"
 	"github.com/zarbchain/zarb-go/tx"
 )
 
@@ -14,6 +19,11 @@ func (syncer *Synchronizer) ParsMessage(data []byte, from peer.ID) {
 
 	syncer.logger.Trace("Received a message", "from", from.ShortString(), "message", msg)
 
+	if msg.HasTarget() && !msg.Target.EqualsTo(syncer.selfAddress) {
+		// The message is targeted to another node
+		return
+	}
+
 	switch msg.PayloadType() {
 	case message.PayloadTypeSalam:
 		pld := msg.Payload.(*message.SalamPayload)
@@ -29,7 +39,7 @@ func (syncer *Synchronizer) ParsMessage(data []byte, from peer.ID) {
 
 	case message.PayloadTypeBlocksReq:
 		pld := msg.Payload.(*message.BlocksReqPayload)
-		syncer.processBlocksReqPayload(pld)
+		syncer.processBlocksReqPayload(pld, msg.Initiator)
 
 	case message.PayloadTypeBlocks:
 		pld := msg.Payload.(*message.BlocksPayload)
@@ -41,7 +51,7 @@ func (syncer *Synchronizer) ParsMessage(data []byte, from peer.ID) {
 
 	case message.PayloadTypeTxs:
 		pld := msg.Payload.(*message.TxsPayload)
-		syncer.processTxsPayload(pld)
+		syncer.processTxsPayload(pld, from)
 
 	case message.PayloadTypeProposalReq:
 		pld := msg.Payload.(*message.ProposalReqPayload)
@@ -59,6 +69,22 @@ func (syncer *Synchronizer) ParsMessage(data []byte, from peer.ID) {
 		pld := msg.Payload.(*message.VoteSetPayload)
 		syncer.processVoteSetPayload(pld)
 
+	case message.PayloadTypeSnapshotReq:
+		pld := msg.Payload.(*message.SnapshotReqPayload)
+		syncer.processSnapshotReqPayload(pld, from)
+
+	case message.PayloadTypeSnapshot:
+		pld := msg.Payload.(*message.SnapshotPayload)
+		syncer.processSnapshotPayload(pld)
+
+	case message.PayloadTypeSnapshotChunkReq:
+		pld := msg.Payload.(*message.SnapshotChunkReqPayload)
+		syncer.processSnapshotChunkReqPayload(pld, from)
+
+	case message.PayloadTypeSnapshotChunk:
+		pld := msg.Payload.(*message.SnapshotChunkPayload)
+		syncer.processSnapshotChunkPayload(pld)
+
 	default:
 		syncer.logger.Error("Unknown message type", "type", msg.PayloadType())
 	}
@@ -84,7 +110,7 @@ func (syncer *Synchronizer) processAleykPayload(pld *message.AleykPayload) {
 	syncer.sendBlocksReqIfWeAreBehind(pld.Height)
 }
 
-func (syncer *Synchronizer) processBlocksReqPayload(pld *message.BlocksReqPayload) {
+func (syncer *Synchronizer) processBlocksReqPayload(pld *message.BlocksReqPayload, initiator crypto.Address) {
 	syncer.logger.Trace("Process blocks request payload", "pld", pld)
 
 	ourHeight := syncer.state.LastBlockHeight()
@@ -92,6 +118,15 @@ func (syncer *Synchronizer) processBlocksReqPayload(pld *message.BlocksReqPayloa
 		return
 	}
 
+	prunedHeight := syncer.state.StoreReader().PrunedHeight()
+	if pld.From <= prunedHeight {
+		syncer.logger.Info("The requested blocks are pruned, we can't send them",
+			"from", pld.From, "to", pld.To, "pruned", prunedHeight)
+		// Let the requester know, so it can ask the nodes that keep all the blocks
+		syncer.broadcastPrunedBlocks(initiator, pld.From, prunedHeight)
+		return
+	}
+
 	b := syncer.cache.GetBlock(pld.From)
 	if b != nil {
 		if !b.Header().LastBlockHash().EqualsTo(pld.LastBlockHash) {
@@ -110,6 +145,16 @@ func (syncer *Synchronizer) processBlocksReqPayload(pld *message.BlocksReqPayloa
 func (syncer *Synchronizer) processBlocksPayload(pld *message.BlocksPayload) {
 	syncer.logger.Trace("Process blocks payload", "pld", pld)
 
+	if syncer.isStateSyncing() {
+		// We don't commit any block before restoring the snapshot
+		return
+	}
+
+	if len(pld.Blocks) == 0 {
+		syncer.processPrunedBlocks(pld)
+		return
+	}
+
 	ourHeight := syncer.state.LastBlockHeight()
 	if ourHeight >= pld.To() {
 		return
@@ -154,6 +199,32 @@ func (syncer *Synchronizer) processBlocksPayload(pld *message.BlocksPayload) {
 	}
 }
 
+// processPrunedBlocks asks a node that keeps all the blocks, when a peer has pruned the blocks that we need.
+// Requests are not sent again before the heart-beat timeout, since more than one peer might have pruned them.
+func (syncer *Synchronizer) processPrunedBlocks(pld *message.BlocksPayload) {
+	ourHeight := syncer.state.LastBlockHeight()
+	if pld.From != ourHeight+1 {
+		return
+	}
+	if time.Since(syncer.prunedRetryTime) < syncer.config.HeartBeatTimeout {
+		return
+	}
+
+	target, ok := syncer.stats.NodeWithoutFlags(message.FlagPruned)
+	if !ok {
+		syncer.logger.Warn("All known nodes have pruned the blocks, the state should be restored from a snapshot",
+			"height", pld.From, "pruned", pld.PrunedHeight)
+		return
+	}
+	networkMaxHeight := syncer.stats.MaxHeight()
+	if networkMaxHeight < pld.From {
+		return
+	}
+
+	syncer.prunedRetryTime = time.Now()
+	syncer.broadcastBlocksReqTo(target, pld.From, networkMaxHeight, syncer.state.LastBlockHash())
+}
+
 func (syncer *Synchronizer) processTxsReqPayload(pld *message.TxsReqPayload) {
 	syncer.logger.Trace("Process txs request Payload", "pld", pld)
 
@@ -168,11 +239,17 @@ func (syncer *Synchronizer) processTxsReqPayload(pld *message.TxsReqPayload) {
 	syncer.broadcastTxs(txs)
 }
 
-func (syncer *Synchronizer) processTxsPayload(pld *message.TxsPayload) {
+func (syncer *Synchronizer) processTxsPayload(pld *message.TxsPayload, from peer.ID) {
 	syncer.logger.Trace("Process txs payload", "pld", pld)
 
 	for _, trx := range pld.Txs {
 		syncer.cache.AddTransaction(trx)
+
+		err := syncer.txPool.AppendTxFrom(trx, from)
+		if errors.Code(err) == errors.ErrTxPoolLimit {
+			syncer.logger.Debug("Transaction rejected by the pool limits", "from", from.ShortString(), "tx", trx, "err", err)
+			syncer.stats.IncRejectedTx(from)
+		}
 	}
 }
 
@@ -248,6 +325,113 @@ func (syncer *Synchronizer) processHeartBeatPayload(pld *message.HeartBeatPayloa
 	}
 }
 
+func (syncer *Synchronizer) processSnapshotReqPayload(pld *message.SnapshotReqPayload, from peer.ID) {
+	syncer.logger.Trace("Process snapshot request payload", "pld", pld)
+
+	snap := syncer.state.LastSnapshot()
+	if snap == nil || snap.Height() != pld.Height {
+		return
+	}
+
+	// The state of the snapshot is committed in the header of the next block
+	b := syncer.cache.GetBlock(pld.Height + 1)
+	if b == nil {
+		return
+	}
+	if !syncer.snapshotLimiter.allow(from, pld.Height, manifestIndex) {
+		syncer.logger.Debug("Snapshot request is ignored", "from", from.ShortString(), "height", pld.Height)
+		return
+	}
+
+	syncer.broadcastSnapshot(snap.Manifest(), b.Header())
+}
+
+func (syncer *Synchronizer) processSnapshotPayload(pld *message.SnapshotPayload) {
+	syncer.logger.Trace("Process snapshot payload", "pld", pld)
+
+	if !syncer.isStateSyncing() {
+		return
+	}
+	if pld.Manifest.Height != syncer.config.StateSyncHeight-1 {
+		return
+	}
+	if !pld.NextHeader.Hash().EqualsTo(syncer.config.StateSyncHash) {
+		syncer.logger.Warn("The snapshot is not committed by the trusted block",
+			"trusted", syncer.config.StateSyncHash, "header", pld.NextHeader.Hash())
+		return
+	}
+	if !pld.NextHeader.LastBlockHash().EqualsTo(pld.Manifest.BlockHash) {
+		syncer.logger.Warn("The snapshot doesn't belong to the trusted block",
+			"snapshot", pld.Manifest.Fingerprint(), "header", pld.NextHeader.Hash())
+		return
+	}
+
+	if syncer.snapshot != nil {
+		// We are downloading a snapshot, the missing chunks are requested again later
+		return
+	}
+
+	snap, err := snapshot.NewSnapshotFromManifest(pld.Manifest)
+	if err != nil {
+		syncer.logger.Warn("Invalid snapshot manifest", "err", err)
+		return
+	}
+	syncer.logger.Info("Start downloading the snapshot", "snapshot", snap)
+	syncer.snapshot = snap
+	syncer.snapshotNextHeader = *pld.NextHeader
+	syncer.snapshotReqTime = time.Time{}
+	syncer.requestSnapshot()
+}
+
+func (syncer *Synchronizer) processSnapshotChunkReqPayload(pld *message.SnapshotChunkReqPayload, from peer.ID) {
+	syncer.logger.Trace("Process snapshot chunk request payload", "pld", pld)
+
+	snap := syncer.state.LastSnapshot()
+	if snap == nil || snap.Height() != pld.Height {
+		return
+	}
+
+	data := snap.Chunk(pld.Index)
+	if data == nil {
+		return
+	}
+	if !syncer.snapshotLimiter.allow(from, pld.Height, pld.Index) {
+		syncer.logger.Debug("Snapshot chunk request is ignored", "from", from.ShortString(), "index", pld.Index)
+		return
+	}
+
+	syncer.broadcastSnapshotChunk(pld.Height, pld.Index, data)
+}
+
+func (syncer *Synchronizer) processSnapshotChunkPayload(pld *message.SnapshotChunkPayload) {
+	syncer.logger.Trace("Process snapshot chunk payload", "pld", pld)
+
+	snap := syncer.snapshot
+	if snap == nil || snap.Height() != pld.Height {
+		return
+	}
+
+	if err := snap.AddChunk(pld.Index, pld.Data); err != nil {
+		syncer.logger.Warn("Invalid snapshot chunk", "index", pld.Index, "err", err)
+		return
+	}
+	if !snap.IsComplete() {
+		return
+	}
+
+	syncer.snapshot = nil
+	if err := syncer.state.RestoreSnapshot(snap, syncer.snapshotNextHeader); err != nil {
+		syncer.logger.Error("Restoring the snapshot failed", "snapshot", snap, "err", err)
+		// Let's try again
+		syncer.snapshotReqTime = time.Time{}
+		syncer.requestSnapshot()
+		return
+	}
+
+	syncer.logger.Info("State is restored from the snapshot", "height", snap.Height())
+	syncer.sendBlocksReqIfWeAreBehind(syncer.stats.MaxHeight())
+}
+
 func (syncer *Synchronizer) tryCommitBlocks() {
 	for {
 		ourHeight := syncer.state.LastBlockHeight()
@@ -269,6 +453,10 @@ func (syncer *Synchronizer) tryCommitBlocks() {
 }
 
 func (syncer *Synchronizer) sendBlocksReqIfWeAreBehind(peerHeight int) {
+	if syncer.isStateSyncing() {
+		syncer.requestSnapshot()
+		return
+	}
 
 	ourHeight := syncer.state.LastBlockHeight()
 	if peerHeight > ourHeight {
@@ -277,3 +465,20 @@ func (syncer *Synchronizer) sendBlocksReqIfWeAreBehind(peerHeight int) {
 		syncer.broadcastBlocksReq(ourHeight+1, peerHeight, hash)
 	}
 }
+
+// requestSnapshot asks for the snapshot, or for the missing chunks if we are downloading it.
+// Requests are broadcasted to all peers, so they are not sent again before the heart-beat timeout.
+func (syncer *Synchronizer) requestSnapshot() {
+	if time.Since(syncer.snapshotReqTime) < syncer.config.HeartBeatTimeout {
+		return
+	}
+	syncer.snapshotReqTime = time.Now()
+
+	if syncer.snapshot == nil {
+		syncer.broadcastSnapshotReq()
+		return
+	}
+	for _, index := range syncer.snapshot.MissingChunks() {
+		syncer.broadcastSnapshotChunkReq(syncer.snapshot.Height(), index)
+	}
+}
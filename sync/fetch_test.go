@@ -3,11 +3,16 @@ package sync
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/fxamacker/cbor/v2"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/message"
+	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/vote"
 )
 
@@ -53,6 +58,78 @@ func TestRequestForBlocksWithLastCommist(t *testing.T) {
 	tNetAPI.waitingForMessage(t, expectedMsg)
 }
 
+func TestRequestForPrunedBlocks(t *testing.T) {
+	setup(t)
+
+	tState.Store.LastPruned = 7
+
+	h := tState.Store.Blocks[7].Header().LastBlockHash()
+	tSync.broadcastBlocksReq(7, 11, h)
+	tNetAPI.waitingForMessage(t, message.NewPrunedBlocksMessage(7, 7))
+
+	// Pruned node marks itself in the handshake
+	msg := message.NewSalamMessage(tState.GenHash, 0, 0)
+	data, _ := cbor.Marshal(msg)
+	tSync.ParsMessage(data, tPeerID)
+	tNetAPI.waitingForMessage(t, message.NewAleykMessage(tState.GenHash, tState.LastBlockHeight(), message.FlagPruned))
+}
+
+func TestRequestTargetedToAnotherNode(t *testing.T) {
+	setup(t)
+
+	other, _, _ := crypto.GenerateTestKeyPair()
+	h := tState.Store.Blocks[7].Header().LastBlockHash()
+	tSync.broadcastBlocksReqTo(other, 7, 11, h)
+	tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeBlocks)
+
+	tSync.broadcastBlocksReqTo(tSync.selfAddress, 7, 11, h)
+	tNetAPI.shouldReceiveMessageWithThisType(t, message.PayloadTypeBlocks)
+}
+
+func TestAskNonPrunedNode(t *testing.T) {
+	setup(t)
+
+	ourHeight := tState.LastBlockHeight()
+	pruned, _, _ := crypto.GenerateTestKeyPair()
+	nonPruned, _, _ := crypto.GenerateTestKeyPair()
+	parsMessage := func(msg *message.Message, initiator crypto.Address) {
+		msg.Initiator = initiator
+		data, _ := cbor.Marshal(msg)
+		tSync.ParsMessage(data, tPeerID)
+	}
+
+	t.Run("No node keeps all the blocks", func(t *testing.T) {
+		parsMessage(message.NewAleykMessage(tState.GenHash, ourHeight+10, message.FlagPruned), pruned)
+		tNetAPI.waitingForMessage(t, message.NewBlocksReqMessage(ourHeight+1, ourHeight+10, tState.LastBlockHash()))
+
+		parsMessage(message.NewPrunedBlocksMessage(ourHeight+1, ourHeight+5), pruned)
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeBlocksReq)
+	})
+
+	t.Run("Ask the node that keeps all the blocks", func(t *testing.T) {
+		parsMessage(message.NewAleykMessage(tState.GenHash, ourHeight+10, 0), nonPruned)
+		tNetAPI.waitingForMessage(t, message.NewBlocksReqMessage(ourHeight+1, ourHeight+10, tState.LastBlockHash()))
+
+		parsMessage(message.NewPrunedBlocksMessage(ourHeight+1, ourHeight+5), pruned)
+		expectedMsg := message.NewBlocksReqMessage(ourHeight+1, ourHeight+10, tState.LastBlockHash())
+		expectedMsg.Target = nonPruned
+		tNetAPI.waitingForMessage(t, expectedMsg)
+	})
+
+	t.Run("Not before the heart-beat timeout", func(t *testing.T) {
+		parsMessage(message.NewPrunedBlocksMessage(ourHeight+1, ourHeight+5), pruned)
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeBlocksReq)
+	})
+
+	t.Run("Pruned response for another node is ignored", func(t *testing.T) {
+		tSync.prunedRetryTime = time.Time{}
+		msg := message.NewPrunedBlocksMessage(ourHeight+1, ourHeight+5)
+		msg.Target = nonPruned
+		parsMessage(msg, pruned)
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeBlocksReq)
+	})
+}
+
 func TestUpdateConsensus(t *testing.T) {
 	setup(t)
 
@@ -73,7 +150,7 @@ func TestMoveToConsensus(t *testing.T) {
 	setup(t)
 
 	// Bad peer send us invalid height
-	msg := message.NewSalamMessage(tState.GenHash, 100000000)
+	msg := message.NewSalamMessage(tState.GenHash, 100000000, 0)
 	tSync.publishMessage(msg)
 	tNetAPI.shouldReceiveMessageWithThisType(t, message.PayloadTypeAleyk)
 	tNetAPI.shouldReceiveMessageWithThisType(t, message.PayloadTypeBlocksReq)
@@ -108,7 +185,7 @@ func TestSendInvalidBlock(t *testing.T) {
 
 	fmt.Println(tState.LastBlockHeight())
 	networkHeight := tState.LastBlockHeight() + 15
-	msg := message.NewSalamMessage(tState.GenHash, networkHeight)
+	msg := message.NewSalamMessage(tState.GenHash, networkHeight, 0)
 	tSync.publishMessage(msg)
 	tNetAPI.shouldReceiveMessageWithThisType(t, message.PayloadTypeAleyk)
 	tNetAPI.shouldReceiveMessageWithThisType(t, message.PayloadTypeBlocksReq)
@@ -138,3 +215,24 @@ func TestSendInvalidBlock(t *testing.T) {
 
 	assert.False(t, tConsensus.Moved)
 }
+
+func TestRejectedTxByPoolLimits(t *testing.T) {
+	setup(t)
+
+	peer1 := peer.ID("peer-1")
+	peer2 := peer.ID("peer-2")
+	trx1, _ := tx.GenerateTestSendTx()
+	trx2, _ := tx.GenerateTestSendTx()
+
+	tSync.processTxsPayload(&message.TxsPayload{Txs: []*tx.Tx{trx1}}, peer1)
+	assert.Equal(t, tSync.stats.RejectedTx(peer1), 0)
+
+	tTxPool.AppendErr = errors.Errorf(errors.ErrTxPoolLimit, "Peer relayed too many transactions")
+	tSync.processTxsPayload(&message.TxsPayload{Txs: []*tx.Tx{trx2}}, peer1)
+	assert.Equal(t, tSync.stats.RejectedTx(peer1), 1)
+	assert.Equal(t, tSync.stats.RejectedTx(peer2), 0)
+
+	tTxPool.AppendErr = errors.Errorf(errors.ErrInvalidTx, "Invalid transaction")
+	tSync.processTxsPayload(&message.TxsPayload{Txs: []*tx.Tx{trx2}}, peer1)
+	assert.Equal(t, tSync.stats.RejectedTx(peer1), 1)
+}
@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// manifestIndex is the index of the snapshot manifest, it is served next to the chunks
+const manifestIndex = -1
+
+type snapshotPart struct {
+	height int
+	index  int
+}
+
+type peerRequests struct {
+	start time.Time
+	count int
+}
+
+// snapshotLimiter limits the snapshot requests that we serve.
+// Responses are broadcasted to all peers, so a response that is sent recently is not sent again,
+// and each peer can ask for a limited number of responses in each window.
+type snapshotLimiter struct {
+	window    time.Duration
+	limit     int
+	served    map[snapshotPart]time.Time
+	requests  map[peer.ID]*peerRequests
+	lastPurge time.Time
+}
+
+func newSnapshotLimiter(window time.Duration, limit int) *snapshotLimiter {
+	return &snapshotLimiter{
+		window:    window,
+		limit:     limit,
+		served:    make(map[snapshotPart]time.Time),
+		requests:  make(map[peer.ID]*peerRequests),
+		lastPurge: time.Now(),
+	}
+}
+
+// allow returns true if we can serve the part of the snapshot that the peer has requested
+func (l *snapshotLimiter) allow(from peer.ID, height, index int) bool {
+	now := time.Now()
+	if now.Sub(l.lastPurge) >= l.window {
+		l.purge(now)
+	}
+
+	part := snapshotPart{height: height, index: index}
+	if t, ok := l.served[part]; ok && now.Sub(t) < l.window {
+		return false
+	}
+	r, ok := l.requests[from]
+	if !ok || now.Sub(r.start) >= l.window {
+		r = &peerRequests{start: now}
+		l.requests[from] = r
+	}
+	if r.count >= l.limit {
+		return false
+	}
+	r.count++
+	l.served[part] = now
+	return true
+}
+
+func (l *snapshotLimiter) purge(now time.Time) {
+	for part, t := range l.served {
+		if now.Sub(t) >= l.window {
+			delete(l.served, part)
+		}
+	}
+	for id, r := range l.requests {
+		if now.Sub(r.start) >= l.window {
+			delete(l.requests, id)
+		}
+	}
+	l.lastPurge = now
+}
@@ -178,7 +178,11 @@ func (api *networkAPI) topic(msg *message.Message) *pubsub.Topic {
 		return api.generalTopic
 
 	case message.PayloadTypeBlocksReq,
-		message.PayloadTypeBlocks:
+		message.PayloadTypeBlocks,
+		message.PayloadTypeSnapshotReq,
+		message.PayloadTypeSnapshot,
+		message.PayloadTypeSnapshotChunkReq,
+		message.PayloadTypeSnapshotChunk:
 		return api.blockTopic
 
 	case message.PayloadTypeTxsReq,
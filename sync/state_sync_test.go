@@ -0,0 +1,123 @@
+package sync
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/message"
+	"github.com/zarbchain/zarb-go/snapshot"
+	"github.com/zarbchain/zarb-go/state"
+)
+
+func makeTestSnapshot() *snapshot.Snapshot {
+	data := []byte(strings.Repeat("snapshot", 40))
+	// Test blocks are not chained, so the block hash of the snapshot is taken from the next block
+	return snapshot.NewSnapshot(5, tState.Store.Blocks[6].Header().LastBlockHash(), data, 100)
+}
+
+func TestServeSnapshot(t *testing.T) {
+	setup(t)
+
+	snap := makeTestSnapshot()
+	tState.Snapshot = snap
+
+	tSync.publishMessage(message.NewSnapshotReqMessage(4))
+	tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeSnapshot)
+
+	tSync.publishMessage(message.NewSnapshotReqMessage(5))
+	tNetAPI.waitingForMessage(t, message.NewSnapshotMessage(snap.Manifest(), tState.Store.Blocks[6].Header()))
+
+	tSync.publishMessage(message.NewSnapshotChunkReqMessage(5, 3))
+	tNetAPI.waitingForMessage(t, message.NewSnapshotChunkMessage(5, 3, snap.Chunk(3)))
+
+	tSync.publishMessage(message.NewSnapshotChunkReqMessage(5, 4))
+	tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeSnapshotChunk)
+
+	t.Run("Repeated request is not served again", func(t *testing.T) {
+		tSync.publishMessage(message.NewSnapshotReqMessage(5))
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeSnapshot)
+
+		tSync.publishMessage(message.NewSnapshotChunkReqMessage(5, 3))
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeSnapshotChunk)
+	})
+
+	t.Run("Requests of each peer are limited", func(t *testing.T) {
+		tSync.publishMessage(message.NewSnapshotChunkReqMessage(5, 0))
+		tNetAPI.waitingForMessage(t, message.NewSnapshotChunkMessage(5, 0, snap.Chunk(0)))
+		tSync.publishMessage(message.NewSnapshotChunkReqMessage(5, 1))
+		tNetAPI.waitingForMessage(t, message.NewSnapshotChunkMessage(5, 1, snap.Chunk(1)))
+
+		tSync.publishMessage(message.NewSnapshotChunkReqMessage(5, 2))
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeSnapshotChunk)
+	})
+}
+
+func TestStateSync(t *testing.T) {
+	setup(t)
+
+	snap := makeTestSnapshot()
+	nextHeader := tState.Store.Blocks[6].Header()
+
+	// We are a fresh node, and we trust the block at height 6
+	fresh := state.NewMockStore()
+	fresh.GenHash = tState.GenHash
+	tSync.state = fresh
+	tSync.config.StateSyncHeight = 6
+	tSync.config.StateSyncHash = nextHeader.Hash()
+	assert.True(t, tSync.isStateSyncing())
+
+	t.Run("Ask for snapshot instead of blocks", func(t *testing.T) {
+		tSync.publishMessage(message.NewAleykMessage(tState.GenHash, 12, 0))
+		tNetAPI.waitingForMessage(t, message.NewSnapshotReqMessage(5))
+
+		// Not before the heart-beat timeout
+		tSync.publishMessage(message.NewAleykMessage(tState.GenHash, 12, 0))
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeSnapshotReq)
+	})
+
+	t.Run("Snapshot is not committed by the trusted block", func(t *testing.T) {
+		tSync.publishMessage(message.NewSnapshotMessage(snap.Manifest(), tState.Store.Blocks[7].Header()))
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeSnapshotChunkReq)
+	})
+
+	tSync.publishMessage(message.NewSnapshotMessage(snap.Manifest(), nextHeader))
+	for i := 0; i < 4; i++ {
+		tNetAPI.waitingForMessage(t, message.NewSnapshotChunkReqMessage(5, i))
+	}
+
+	t.Run("Manifest is ignored while downloading", func(t *testing.T) {
+		tSync.publishMessage(message.NewSnapshotMessage(snap.Manifest(), nextHeader))
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeSnapshotChunkReq)
+	})
+
+	// Invalid chunk is ignored
+	tSync.publishMessage(message.NewSnapshotChunkMessage(5, 0, snap.Chunk(1)))
+	tNetAPI.shouldReceiveMessageWithThisType(t, message.PayloadTypeSnapshotChunk)
+	assert.Equal(t, tSync.snapshot.MissingChunks(), []int{0, 1, 2, 3})
+
+	t.Run("Missing chunks are requested again after the heart-beat timeout", func(t *testing.T) {
+		tSync.publishMessage(message.NewSnapshotChunkMessage(5, 0, snap.Chunk(0)))
+		tNetAPI.shouldReceiveMessageWithThisType(t, message.PayloadTypeSnapshotChunk)
+
+		tSync.publishMessage(message.NewAleykMessage(tState.GenHash, 12, 0))
+		tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeSnapshotChunkReq)
+
+		tSync.snapshotReqTime = time.Now().Add(-tSync.config.HeartBeatTimeout)
+		tSync.publishMessage(message.NewAleykMessage(tState.GenHash, 12, 0))
+		for i := 1; i < 4; i++ {
+			tNetAPI.waitingForMessage(t, message.NewSnapshotChunkReqMessage(5, i))
+		}
+	})
+
+	for i := 1; i < 4; i++ {
+		tSync.publishMessage(message.NewSnapshotChunkMessage(5, i, snap.Chunk(i)))
+		tNetAPI.shouldReceiveMessageWithThisType(t, message.PayloadTypeSnapshotChunk)
+	}
+
+	assert.Nil(t, tSync.snapshot)
+	assert.False(t, tSync.isStateSyncing())
+	assert.Equal(t, fresh.LastBlockHeight(), 5)
+	assert.Equal(t, fresh.LastSnapshot(), snap)
+}
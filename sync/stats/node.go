@@ -10,6 +10,7 @@ type Node struct {
 	Version     version.Version
 	GenesisHash crypto.Hash
 	HRS         hrs.HRS
+	Flags       int
 }
 
 func NewNode() *Node {
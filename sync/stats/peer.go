@@ -3,6 +3,7 @@ package stats
 type Peer struct {
 	ReceivedMsg int
 	InvalidMsg  int
+	RejectedTx  int
 }
 
 func NewPeer() *Peer {
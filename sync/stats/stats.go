@@ -50,6 +50,24 @@ func (s *Stats) MaxHeight() int {
 	return s.maxHeight
 }
 
+// NodeWithoutFlags returns a node that doesn't have the given flags in its handshake,
+// like a node that has not pruned the old blocks.
+func (s *Stats) NodeWithoutFlags(flags int) (crypto.Address, bool) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	for addr, node := range s.nodes {
+		if addr.EqualsTo(crypto.Address{}) || node.GenesisHash.IsUndef() {
+			// We have not received any handshake from this node
+			continue
+		}
+		if node.Flags&flags == 0 {
+			return addr, true
+		}
+	}
+	return crypto.Address{}, false
+}
+
 func (s *Stats) getPeer(peerID peer.ID) *Peer {
 	if peer, ok := s.peers[peerID]; ok {
 		return peer
@@ -110,14 +128,22 @@ func (s *Stats) ParsMessage(data []byte, from peer.ID) *message.Message {
 		pld := msg.Payload.(*message.SalamPayload)
 		node.Version = pld.Version
 		node.GenesisHash = pld.GenesisHash
+		node.Flags = pld.Flags
 		s.updateMaxHeight(pld.Height)
 
 	case message.PayloadTypeAleyk:
 		pld := msg.Payload.(*message.AleykPayload)
 		node.Version = pld.Version
 		node.GenesisHash = pld.GenesisHash
+		node.Flags = pld.Flags
 		s.updateMaxHeight(pld.Height)
 
+	case message.PayloadTypeBlocks:
+		pld := msg.Payload.(*message.BlocksPayload)
+		if pld.PrunedHeight > 0 {
+			node.Flags |= message.FlagPruned
+		}
+
 	case message.PayloadTypeHeartBeat:
 		pld := msg.Payload.(*message.HeartBeatPayload)
 		node.HRS = pld.Pulse
@@ -138,6 +164,25 @@ func (s *Stats) ParsMessage(data []byte, from peer.ID) *message.Message {
 	return msg
 }
 
+// IncRejectedTx counts the transactions of the peer that are rejected by the pool limits
+func (s *Stats) IncRejectedTx(from peer.ID) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	peer := s.getPeer(from)
+	peer.RejectedTx = peer.RejectedTx + 1
+}
+
+func (s *Stats) RejectedTx(from peer.ID) int {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	if peer, ok := s.peers[from]; ok {
+		return peer.RejectedTx
+	}
+	return 0
+}
+
 func (s *Stats) badNode(node *Node) bool {
 
 	return false
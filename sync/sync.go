@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/consensus"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/message"
 	"github.com/zarbchain/zarb-go/network"
+	"github.com/zarbchain/zarb-go/snapshot"
 	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/sync/cache"
 	"github.com/zarbchain/zarb-go/sync/stats"
@@ -22,6 +24,7 @@ type Synchronizer struct {
 
 	ctx             context.Context
 	config          *Config
+	selfAddress     crypto.Address
 	state           state.State
 	txPool          txpool.TxPool
 	consensus       consensus.Consensus
@@ -31,6 +34,16 @@ type Synchronizer struct {
 	networkAPI      NetworkAPI
 	heartBeatTicker *time.Ticker
 	logger          *logger.Logger
+
+	// The snapshot that we are downloading and the snapshot requests that we serve,
+	// they are only accessed by the block topic's messages
+	snapshot           *snapshot.Snapshot
+	snapshotNextHeader block.Header
+	snapshotReqTime    time.Time
+	snapshotLimiter    *snapshotLimiter
+
+	// The last time that we asked a node which keeps all the blocks, because the others have pruned them
+	prunedRetryTime time.Time
 }
 
 func NewSynchronizer(
@@ -44,6 +57,7 @@ func NewSynchronizer(
 	syncer := &Synchronizer{
 		ctx:         context.Background(),
 		config:      conf,
+		selfAddress: addr,
 		state:       state,
 		consensus:   consensus,
 		txPool:      txPool,
@@ -66,6 +80,7 @@ func NewSynchronizer(
 	syncer.cache = cache
 	syncer.stats = stats.NewStats(state.GenesisHash())
 	syncer.networkAPI = api
+	syncer.snapshotLimiter = newSnapshotLimiter(conf.HeartBeatTimeout, conf.SnapshotRequestLimit)
 
 	return syncer, nil
 }
@@ -81,6 +96,9 @@ func (syncer *Synchronizer) Start() error {
 	go syncer.heartBeatTickerLoop()
 
 	syncer.broadcastSalam()
+	if syncer.isStateSyncing() {
+		syncer.requestSnapshot()
+	}
 
 	timer := time.NewTimer(syncer.config.StartingTimeout)
 	go func() {
@@ -98,6 +116,9 @@ func (syncer *Synchronizer) Stop() {
 }
 
 func (syncer *Synchronizer) maybeSynced(force bool) {
+	if syncer.isStateSyncing() {
+		return
+	}
 	lastHeight := syncer.state.LastBlockHeight()
 	networkHeight := syncer.stats.MaxHeight()
 
@@ -107,6 +128,11 @@ func (syncer *Synchronizer) maybeSynced(force bool) {
 	}
 }
 
+// isStateSyncing returns true if we are a fresh node and should restore the state from a snapshot
+func (syncer *Synchronizer) isStateSyncing() bool {
+	return syncer.config.StateSyncHeight > 1 && syncer.state.LastBlockHeight() == 0
+}
+
 func (syncer *Synchronizer) heartBeatTickerLoop() {
 	for {
 		select {
@@ -150,6 +176,10 @@ func (syncer *Synchronizer) broadcastLoop() {
 		}
 	}
 }
+func (syncer *Synchronizer) Stats() *stats.Stats {
+	return syncer.stats
+}
+
 func (syncer *Synchronizer) Fingerprint() string {
 	return fmt.Sprintf("{☍ %d ⛲ %d ↥ %d}",
 		syncer.stats.PeersCount(),
@@ -63,6 +63,8 @@ func setup(t *testing.T) {
 		broadcastCh: tBroadcastCh,
 		networkAPI:  tNetAPI,
 	}
+	tSync.snapshotLimiter = newSnapshotLimiter(syncConf.HeartBeatTimeout, syncConf.SnapshotRequestLimit)
+	tSync.selfAddress, _, _ = crypto.GenerateTestKeyPair()
 
 	logger := logger.NewLogger("_sync", tSync)
 
@@ -71,15 +73,15 @@ func setup(t *testing.T) {
 
 	assert.NoError(t, tSync.Start())
 
-	tNetAPI.waitingForMessage(t, message.NewSalamMessage(tState.GenHash, tState.LastBlockHeight()))
-	tNetAPI.waitingForMessage(t, message.NewAleykMessage(tState.GenHash, tState.LastBlockHeight()))
+	tNetAPI.waitingForMessage(t, message.NewSalamMessage(tState.GenHash, tState.LastBlockHeight(), 0))
+	tNetAPI.waitingForMessage(t, message.NewAleykMessage(tState.GenHash, tState.LastBlockHeight(), 0))
 }
 
 func TestSendSalamBadGenesisHash(t *testing.T) {
 	setup(t)
 
 	invGenHash := crypto.GenerateTestHash()
-	msg := message.NewSalamMessage(invGenHash, 0)
+	msg := message.NewSalamMessage(invGenHash, 0, 0)
 	data, _ := cbor.Marshal(msg)
 	tSync.ParsMessage(data, tPeerID)
 	tNetAPI.shouldNotReceiveAnyMessageWithThisType(t, message.PayloadTypeAleyk)
@@ -88,26 +90,26 @@ func TestSendSalamBadGenesisHash(t *testing.T) {
 func TestSendSalamPeerAhead(t *testing.T) {
 	setup(t)
 
-	msg := message.NewSalamMessage(tState.GenHash, 0)
+	msg := message.NewSalamMessage(tState.GenHash, 0, 0)
 	data, _ := cbor.Marshal(msg)
 	tSync.ParsMessage(data, tPeerID)
-	tNetAPI.waitingForMessage(t, message.NewAleykMessage(tState.GenHash, tState.LastBlockHeight()))
+	tNetAPI.waitingForMessage(t, message.NewAleykMessage(tState.GenHash, tState.LastBlockHeight(), 0))
 }
 
 func TestSendSalamPeerBehind(t *testing.T) {
 	setup(t)
 
-	msg := message.NewSalamMessage(tState.GenHash, 111)
+	msg := message.NewSalamMessage(tState.GenHash, 111, 0)
 	data, _ := cbor.Marshal(msg)
 	tSync.ParsMessage(data, tPeerID)
-	tNetAPI.waitingForMessage(t, message.NewAleykMessage(tState.GenHash, tState.LastBlockHeight()))
+	tNetAPI.waitingForMessage(t, message.NewAleykMessage(tState.GenHash, tState.LastBlockHeight(), 0))
 	tNetAPI.waitingForMessage(t, message.NewBlocksReqMessage(tState.LastBlockHeight()+1, 111, tState.LastBlockHash()))
 }
 
 func TestSendAleykPeerBehind(t *testing.T) {
 	setup(t)
 
-	msg := message.NewAleykMessage(tState.GenHash, 111)
+	msg := message.NewAleykMessage(tState.GenHash, 111, 0)
 	data, _ := cbor.Marshal(msg)
 	tSync.ParsMessage(data, tPeerID)
 	tNetAPI.waitingForMessage(t, message.NewBlocksReqMessage(tState.LastBlockHeight()+1, 111, tState.LastBlockHash()))
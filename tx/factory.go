@@ -2,11 +2,13 @@ package tx
 
 import (
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/tx/payload"
 )
 
-func NewSubsidyTx(stamp crypto.Hash, sequence int, receiver crypto.Address, amount int64, memo string) *Tx {
-	return NewSendTx(
+// NewSubsidyTx creates a subsidy transaction with the given protocol version
+func NewSubsidyTx(version int, stamp crypto.Hash, sequence int, receiver crypto.Address, amount int64, memo string) *Tx {
+	trx := NewSendTx(
 		stamp,
 		sequence,
 		crypto.TreasuryAddress,
@@ -16,6 +18,23 @@ func NewSubsidyTx(stamp crypto.Hash, sequence int, receiver crypto.Address, amou
 		memo,
 		nil,
 		nil)
+	trx.data.Version = version
+	return trx
+}
+
+// NewBatchSubsidyTx creates a subsidy transaction that splits the block reward between the receivers
+func NewBatchSubsidyTx(version int, stamp crypto.Hash, sequence int, receivers []payload.BatchReceiver, memo string) *Tx {
+	trx := NewBatchSendTx(
+		stamp,
+		sequence,
+		crypto.TreasuryAddress,
+		receivers,
+		0,
+		memo,
+		nil,
+		nil)
+	trx.data.Version = version
+	return trx
 }
 
 func NewSendTx(stamp crypto.Hash,
@@ -67,7 +86,9 @@ func NewBondTx(stamp crypto.Hash,
 	}
 }
 
-func NewSortitionTx(stamp crypto.Hash,
+// NewSortitionTx creates a sortition transaction with the given protocol version
+func NewSortitionTx(version int,
+	stamp crypto.Hash,
 	sequence int,
 	addr crypto.Address,
 	proof []byte,
@@ -77,7 +98,7 @@ func NewSortitionTx(stamp crypto.Hash,
 		data: txData{
 			Stamp:    stamp,
 			Sequence: sequence,
-			Version:  1,
+			Version:  version,
 			Type:     payload.PayloadTypeSortition,
 			Payload: &payload.SortitionPayload{
 				Address: addr,
@@ -90,3 +111,204 @@ func NewSortitionTx(stamp crypto.Hash,
 		},
 	}
 }
+
+func NewUnbondTx(stamp crypto.Hash,
+	sequence int,
+	val crypto.Address,
+	memo string,
+	publicKey *crypto.PublicKey, signature *crypto.Signature) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: sequence,
+			Version:  1,
+			Type:     payload.PayloadTypeUnbond,
+			Payload: &payload.UnbondPayload{
+				Validator: val,
+			},
+			Fee:       0,
+			Memo:      memo,
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	}
+}
+
+func NewWithdrawTx(stamp crypto.Hash,
+	sequence int,
+	val crypto.Address,
+	acc crypto.Address,
+	amount int64, memo string,
+	publicKey *crypto.PublicKey, signature *crypto.Signature) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: sequence,
+			Version:  1,
+			Type:     payload.PayloadTypeWithdraw,
+			Payload: &payload.WithdrawPayload{
+				From:   val,
+				To:     acc,
+				Amount: amount,
+			},
+			Fee:       0,
+			Memo:      memo,
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	}
+}
+
+func NewEvidenceTx(stamp crypto.Hash,
+	sequence int,
+	reporter, offender crypto.Address,
+	vote1, vote2 []byte,
+	memo string,
+	publicKey *crypto.PublicKey, signature *crypto.Signature) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: sequence,
+			Version:  1,
+			Type:     payload.PayloadTypeEvidence,
+			Payload: &payload.EvidencePayload{
+				Reporter: reporter,
+				Offender: offender,
+				Vote1:    vote1,
+				Vote2:    vote2,
+			},
+			Fee:       0,
+			Memo:      memo,
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	}
+}
+
+func NewBatchSendTx(stamp crypto.Hash,
+	sequence int,
+	sender crypto.Address,
+	receivers []payload.BatchReceiver,
+	fee int64, memo string,
+	publicKey *crypto.PublicKey, signature *crypto.Signature) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: sequence,
+			Version:  1,
+			Type:     payload.PayloadTypeBatchSend,
+			Payload: &payload.BatchSendPayload{
+				Sender:    sender,
+				Receivers: receivers,
+			},
+			Fee:       fee,
+			Memo:      memo,
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	}
+}
+
+func NewLockTx(stamp crypto.Hash,
+	sequence int,
+	sender, receiver crypto.Address,
+	amount int64, unlockHeight int,
+	refund *crypto.Address, deadline int,
+	fee int64, memo string,
+	publicKey *crypto.PublicKey, signature *crypto.Signature) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: sequence,
+			Version:  1,
+			Type:     payload.PayloadTypeLock,
+			Payload: &payload.LockPayload{
+				Sender:       sender,
+				Receiver:     receiver,
+				Amount:       amount,
+				UnlockHeight: unlockHeight,
+				Refund:       refund,
+				Deadline:     deadline,
+			},
+			Fee:       fee,
+			Memo:      memo,
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	}
+}
+
+func NewClaimTx(stamp crypto.Hash,
+	sequence int,
+	claimer crypto.Address,
+	escrowID crypto.Hash,
+	amount int64, memo string,
+	publicKey *crypto.PublicKey, signature *crypto.Signature) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: sequence,
+			Version:  1,
+			Type:     payload.PayloadTypeClaim,
+			Payload: &payload.ClaimPayload{
+				Claimer: claimer,
+				Escrow:  escrowID,
+				Amount:  amount,
+			},
+			Fee:       0,
+			Memo:      memo,
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	}
+}
+
+func NewParamProposalTx(stamp crypto.Hash,
+	sequence int,
+	proposer crypto.Address,
+	params param.Params,
+	activationHeight int,
+	memo string,
+	publicKey *crypto.PublicKey, signature *crypto.Signature) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: sequence,
+			Version:  1,
+			Type:     payload.PayloadTypeParamProposal,
+			Payload: &payload.ParamProposalPayload{
+				Proposer:         proposer,
+				Params:           params,
+				ActivationHeight: activationHeight,
+			},
+			Fee:       0,
+			Memo:      memo,
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	}
+}
+
+func NewParamVoteTx(stamp crypto.Hash,
+	sequence int,
+	voter crypto.Address,
+	proposal crypto.Hash,
+	memo string,
+	publicKey *crypto.PublicKey, signature *crypto.Signature) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: sequence,
+			Version:  1,
+			Type:     payload.PayloadTypeParamVote,
+			Payload: &payload.ParamVotePayload{
+				Voter:    voter,
+				Proposal: proposal,
+			},
+			Fee:       0,
+			Memo:      memo,
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	}
+}
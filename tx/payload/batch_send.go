@@ -0,0 +1,76 @@
+package payload
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// MaximumBatchSize is the maximum number of receivers in a batch send transaction
+const MaximumBatchSize = 1000
+
+type BatchReceiver struct {
+	Address crypto.Address `cbor:"1,keyasint"`
+	Amount  int64          `cbor:"2,keyasint"`
+}
+
+type BatchSendPayload struct {
+	Sender    crypto.Address  `cbor:"1,keyasint"`
+	Receivers []BatchReceiver `cbor:"2,keyasint"`
+}
+
+func (p *BatchSendPayload) Type() PayloadType {
+	return PayloadTypeBatchSend
+}
+
+func (p *BatchSendPayload) Signer() crypto.Address {
+	return p.Sender
+}
+
+// Value returns the total amount that is sent to the receivers
+func (p *BatchSendPayload) Value() int64 {
+	total := int64(0)
+	for _, r := range p.Receivers {
+		total += r.Amount
+	}
+	return total
+}
+
+func (p *BatchSendPayload) SanityCheck() error {
+	if len(p.Receivers) == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "No receiver")
+	}
+	if len(p.Receivers) > MaximumBatchSize {
+		return errors.Errorf(errors.ErrInvalidTx, "Too many receivers")
+	}
+
+	total := int64(0)
+	receivers := make(map[crypto.Address]bool)
+	for _, r := range p.Receivers {
+		if r.Amount < 0 || r.Amount > math.MaxInt64-total {
+			return errors.Errorf(errors.ErrInvalidTx, "Invalid amount")
+		}
+		if err := r.Address.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidTx, "Invalid receiver address")
+		}
+		if r.Address.EqualsTo(p.Sender) {
+			return errors.Errorf(errors.ErrInvalidTx, "Sender can't be a receiver")
+		}
+		if receivers[r.Address] {
+			return errors.Errorf(errors.ErrInvalidTx, "Duplicated receiver: %v", r.Address)
+		}
+		receivers[r.Address] = true
+		total += r.Amount
+	}
+
+	return nil
+}
+
+func (p *BatchSendPayload) Fingerprint() string {
+	return fmt.Sprintf("{BatchSend: %v->%v receivers 💸 %v",
+		p.Sender.Fingerprint(),
+		len(p.Receivers),
+		p.Value())
+}
@@ -0,0 +1,46 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// ClaimPayload releases the locked funds to the claimer.
+// Claimer is either the receiver or the refund address of the escrow.
+type ClaimPayload struct {
+	Claimer crypto.Address `cbor:"1,keyasint"`
+	Escrow  crypto.Hash    `cbor:"2,keyasint"` // Id of the lock transaction
+	Amount  int64          `cbor:"3,keyasint"`
+}
+
+func (p *ClaimPayload) Type() PayloadType {
+	return PayloadTypeClaim
+}
+
+func (p *ClaimPayload) Signer() crypto.Address {
+	return p.Claimer
+}
+
+func (p *ClaimPayload) Value() int64 {
+	return p.Amount
+}
+
+func (p *ClaimPayload) SanityCheck() error {
+	if p.Amount < 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid amount")
+	}
+	if p.Escrow.IsUndef() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid escrow id")
+	}
+
+	return nil
+}
+
+func (p *ClaimPayload) Fingerprint() string {
+	return fmt.Sprintf("{Claim: %v->%v 💸 %v",
+		p.Escrow.Fingerprint(),
+		p.Claimer.Fingerprint(),
+		p.Amount)
+}
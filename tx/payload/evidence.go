@@ -0,0 +1,52 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// EvidencePayload carries two conflicting votes signed by the offender.
+// Votes are kept in their encoded form and decoded by the executor.
+type EvidencePayload struct {
+	Reporter crypto.Address `cbor:"1,keyasint"`
+	Offender crypto.Address `cbor:"2,keyasint"`
+	Vote1    []byte         `cbor:"3,keyasint"`
+	Vote2    []byte         `cbor:"4,keyasint"`
+}
+
+func (p *EvidencePayload) Type() PayloadType {
+	return PayloadTypeEvidence
+}
+
+func (p *EvidencePayload) Signer() crypto.Address {
+	return p.Reporter
+}
+
+func (p *EvidencePayload) Value() int64 {
+	return 0
+}
+
+func (p *EvidencePayload) SanityCheck() error {
+	if err := p.Reporter.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid reporter address")
+	}
+	if err := p.Offender.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid offender address")
+	}
+	if p.Reporter.EqualsTo(p.Offender) {
+		return errors.Errorf(errors.ErrInvalidTx, "Reporter and offender are same")
+	}
+	if len(p.Vote1) == 0 || len(p.Vote2) == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid votes")
+	}
+
+	return nil
+}
+
+func (p *EvidencePayload) Fingerprint() string {
+	return fmt.Sprintf("{Evidence: %v->%v",
+		p.Reporter.Fingerprint(),
+		p.Offender.Fingerprint())
+}
@@ -0,0 +1,65 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// LockPayload locks the funds for the receiver until the unlock height.
+// If the refund address and the deadline are set, the refund address can claim the funds
+// once the deadline is reached and the receiver hasn't claimed them yet.
+type LockPayload struct {
+	Sender       crypto.Address  `cbor:"1,keyasint"`
+	Receiver     crypto.Address  `cbor:"2,keyasint"`
+	Amount       int64           `cbor:"3,keyasint"`
+	UnlockHeight int             `cbor:"4,keyasint"`
+	Refund       *crypto.Address `cbor:"5,keyasint,omitempty"`
+	Deadline     int             `cbor:"6,keyasint,omitempty"`
+}
+
+func (p *LockPayload) Type() PayloadType {
+	return PayloadTypeLock
+}
+
+func (p *LockPayload) Signer() crypto.Address {
+	return p.Sender
+}
+
+func (p *LockPayload) Value() int64 {
+	return p.Amount
+}
+
+func (p *LockPayload) SanityCheck() error {
+	if p.Amount <= 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid amount")
+	}
+	if err := p.Receiver.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid receiver address")
+	}
+	if p.UnlockHeight <= 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid unlock height")
+	}
+	if (p.Refund == nil) != (p.Deadline == 0) {
+		return errors.Errorf(errors.ErrInvalidTx, "Refund address and deadline should be set together")
+	}
+	if p.Refund != nil {
+		if err := p.Refund.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidTx, "Invalid refund address")
+		}
+		if p.Deadline <= p.UnlockHeight {
+			return errors.Errorf(errors.ErrInvalidTx, "Deadline should be after the unlock height")
+		}
+	}
+
+	return nil
+}
+
+func (p *LockPayload) Fingerprint() string {
+	return fmt.Sprintf("{Lock: %v->%v 💸 %v ⏳ %v",
+		p.Sender.Fingerprint(),
+		p.Receiver.Fingerprint(),
+		p.Amount,
+		p.UnlockHeight)
+}
@@ -0,0 +1,49 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/param"
+)
+
+// ParamProposalPayload proposes new chain parameters that are activated at the activation height,
+// if the proposal passes.
+type ParamProposalPayload struct {
+	Proposer         crypto.Address `cbor:"1,keyasint"` // validator address
+	Params           param.Params   `cbor:"2,keyasint"`
+	ActivationHeight int            `cbor:"3,keyasint"`
+}
+
+func (p *ParamProposalPayload) Type() PayloadType {
+	return PayloadTypeParamProposal
+}
+
+func (p *ParamProposalPayload) Signer() crypto.Address {
+	return p.Proposer
+}
+
+func (p *ParamProposalPayload) Value() int64 {
+	return 0
+}
+
+func (p *ParamProposalPayload) SanityCheck() error {
+	if err := p.Proposer.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid proposer address")
+	}
+	if p.ActivationHeight <= 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid activation height")
+	}
+	if err := p.Params.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+
+	return nil
+}
+
+func (p *ParamProposalPayload) Fingerprint() string {
+	return fmt.Sprintf("{ParamProposal: %v ⏳ %v",
+		p.Proposer.Fingerprint(),
+		p.ActivationHeight)
+}
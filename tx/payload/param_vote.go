@@ -0,0 +1,43 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// ParamVotePayload is a validator's vote in favor of a parameter change proposal
+type ParamVotePayload struct {
+	Voter    crypto.Address `cbor:"1,keyasint"` // validator address
+	Proposal crypto.Hash    `cbor:"2,keyasint"` // Id of the proposal transaction
+}
+
+func (p *ParamVotePayload) Type() PayloadType {
+	return PayloadTypeParamVote
+}
+
+func (p *ParamVotePayload) Signer() crypto.Address {
+	return p.Voter
+}
+
+func (p *ParamVotePayload) Value() int64 {
+	return 0
+}
+
+func (p *ParamVotePayload) SanityCheck() error {
+	if err := p.Voter.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid voter address")
+	}
+	if p.Proposal.IsUndef() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid proposal id")
+	}
+
+	return nil
+}
+
+func (p *ParamVotePayload) Fingerprint() string {
+	return fmt.Sprintf("{ParamVote: %v 🗳 %v",
+		p.Voter.Fingerprint(),
+		p.Proposal.Fingerprint())
+}
@@ -9,10 +9,17 @@ import (
 type PayloadType int
 
 const (
-	PayloadTypeSend      = PayloadType(1)
-	PayloadTypeBond      = PayloadType(2)
-	PayloadTypeSortition = PayloadType(3)
-	PayloadTypeUnbond    = PayloadType(4)
+	PayloadTypeSend          = PayloadType(1)
+	PayloadTypeBond          = PayloadType(2)
+	PayloadTypeSortition     = PayloadType(3)
+	PayloadTypeUnbond        = PayloadType(4)
+	PayloadTypeWithdraw      = PayloadType(5)
+	PayloadTypeEvidence      = PayloadType(6)
+	PayloadTypeBatchSend     = PayloadType(7)
+	PayloadTypeLock          = PayloadType(8)
+	PayloadTypeClaim         = PayloadType(9)
+	PayloadTypeParamProposal = PayloadType(10)
+	PayloadTypeParamVote     = PayloadType(11)
 )
 
 func (t PayloadType) String() string {
@@ -25,6 +32,20 @@ func (t PayloadType) String() string {
 		return "unbond"
 	case PayloadTypeSortition:
 		return "sortition"
+	case PayloadTypeWithdraw:
+		return "withdraw"
+	case PayloadTypeEvidence:
+		return "evidence"
+	case PayloadTypeBatchSend:
+		return "batch-send"
+	case PayloadTypeLock:
+		return "lock"
+	case PayloadTypeClaim:
+		return "claim"
+	case PayloadTypeParamProposal:
+		return "param-proposal"
+	case PayloadTypeParamVote:
+		return "param-vote"
 	}
 	return fmt.Sprintf("%d", t)
 }
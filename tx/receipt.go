@@ -13,19 +13,52 @@ const (
 	Failed = 1
 )
 
+// BalanceChange is the change of an address's balance and stake, caused by executing a transaction
+type BalanceChange struct {
+	Address crypto.Address `cbor:"1,keyasint"`
+	Balance int64          `cbor:"2,keyasint,omitempty"`
+	Stake   int64          `cbor:"3,keyasint,omitempty"`
+}
+
 // Transaction receipt
 type Receipt struct {
 	data receiptData
 }
 type receiptData struct {
-	Status    int         `cbor:"1,keyasint"`
-	TxID      crypto.Hash `cbor:"2,keyasint"`
-	BlockHash crypto.Hash `cbor:"3,keyasint"`
+	Status    int             `cbor:"1,keyasint"`
+	TxID      crypto.Hash     `cbor:"2,keyasint"`
+	BlockHash crypto.Hash     `cbor:"3,keyasint"`
+	Fee       int64           `cbor:"4,keyasint,omitempty"`
+	Height    int             `cbor:"5,keyasint,omitempty"`
+	Index     int             `cbor:"6,keyasint,omitempty"`
+	Changes   []BalanceChange `cbor:"7,keyasint,omitempty"`
+	ErrorCode int             `cbor:"8,keyasint,omitempty"`
 }
 
-func (r *Receipt) Status() int            { return r.data.Status }
-func (r *Receipt) TxID() crypto.Hash      { return r.data.TxID }
-func (r *Receipt) BlockHash() crypto.Hash { return r.data.BlockHash }
+func (r *Receipt) Status() int                     { return r.data.Status }
+func (r *Receipt) TxID() crypto.Hash               { return r.data.TxID }
+func (r *Receipt) BlockHash() crypto.Hash          { return r.data.BlockHash }
+func (r *Receipt) Fee() int64                      { return r.data.Fee }
+func (r *Receipt) Height() int                     { return r.data.Height }
+func (r *Receipt) Index() int                      { return r.data.Index }
+func (r *Receipt) BalanceChanges() []BalanceChange { return r.data.Changes }
+func (r *Receipt) ErrorCode() int                  { return r.data.ErrorCode }
+
+// SetExecutionResult sets the position of the transaction in the block, the charged fee and the balance changes
+func (r *Receipt) SetExecutionResult(height, index int, fee int64, changes []BalanceChange) {
+	r.data.Height = height
+	r.data.Index = index
+	r.data.Fee = fee
+	r.data.Changes = changes
+}
+
+// SetError marks the receipt as failed and keeps the error code
+func (r *Receipt) SetError(err error) {
+	r.data.Status = Failed
+	r.data.ErrorCode = errors.Code(err)
+	r.data.Fee = 0
+	r.data.Changes = nil
+}
 
 func (r *Receipt) Hash() crypto.Hash {
 	bz, _ := r.MarshalCBOR()
@@ -33,9 +66,25 @@ func (r *Receipt) Hash() crypto.Hash {
 }
 
 func (r *Receipt) SanityCheck() error {
-	if r.data.Status != Ok {
+	switch r.data.Status {
+	case Ok:
+		if r.data.ErrorCode != errors.ErrNone {
+			return errors.Errorf(errors.ErrInvalidTx, "Successful receipt can't have an error code")
+		}
+	case Failed:
+		if r.data.ErrorCode == errors.ErrNone {
+			return errors.Errorf(errors.ErrInvalidTx, "Failed receipt should have an error code")
+		}
+	default:
 		return errors.Errorf(errors.ErrInvalidTx, "Invalid status")
 	}
+	if r.data.Fee < 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid fee")
+	}
+	if r.data.Height < 0 || r.data.Index < 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid position")
+	}
+
 	if err := r.data.BlockHash.SanityCheck(); err != nil {
 		return errors.Errorf(errors.ErrInvalidTx, "Invalid block hash")
 	}
@@ -2,12 +2,14 @@ package tx
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
 )
 
 func TestEncodingReceipt(t *testing.T) {
@@ -41,10 +43,55 @@ func TestSanityCheck(t *testing.T) {
 	assert.NoError(t, r.SanityCheck())
 	r.data.Status = 1
 	assert.Error(t, r.SanityCheck())
+	r.data.Status = 2
+	assert.Error(t, r.SanityCheck())
 	r.data.Status = 0
+	r.data.Fee = -1
+	assert.Error(t, r.SanityCheck())
+	r.data.Fee = 0
+	r.data.ErrorCode = errors.ErrInvalidTx
+	assert.Error(t, r.SanityCheck())
+	r.data.ErrorCode = 0
 	r.data.BlockHash = crypto.UndefHash
 	assert.Error(t, r.SanityCheck())
 }
+
+func TestFailedReceipt(t *testing.T) {
+	trx, _ := GenerateTestSendTx()
+	r := trx.GenerateReceipt(Ok, crypto.GenerateTestHash())
+	r.SetExecutionResult(10, 1, trx.Fee(), []BalanceChange{{Address: crypto.TreasuryAddress, Balance: 1}})
+	r.SetError(errors.Errorf(errors.ErrInsufficientFunds, ""))
+
+	assert.Equal(t, r.Status(), Failed)
+	assert.Equal(t, r.ErrorCode(), errors.ErrInsufficientFunds)
+	assert.Equal(t, r.Fee(), int64(0))
+	assert.Empty(t, r.BalanceChanges())
+	assert.NoError(t, r.SanityCheck())
+}
+
+func TestEncodingRichReceipt(t *testing.T) {
+	trx, _ := GenerateTestSendTx()
+	a1, _, _ := crypto.GenerateTestKeyPair()
+	a2, _, _ := crypto.GenerateTestKeyPair()
+	r1 := trx.GenerateReceipt(Ok, crypto.GenerateTestHash())
+	r1.SetExecutionResult(10, 1, trx.Fee(), []BalanceChange{{Address: a1, Balance: -110}, {Address: a2, Balance: 100, Stake: 1}})
+
+	bz, err := r1.Encode()
+	require.NoError(t, err)
+	r2 := new(Receipt)
+	require.NoError(t, r2.Decode(bz))
+	assert.Equal(t, r1, r2)
+	assert.Equal(t, r2.Height(), 10)
+	assert.Equal(t, r2.Index(), 1)
+	assert.Equal(t, r2.Fee(), trx.Fee())
+	assert.Equal(t, r2.BalanceChanges()[1].Stake, int64(1))
+
+	js, err := json.Marshal(r1)
+	require.NoError(t, err)
+	r3 := new(Receipt)
+	require.NoError(t, json.Unmarshal(js, r3))
+	assert.Equal(t, r1, r3)
+}
 func TestReceiptDecodingAndHash(t *testing.T) {
 	d, _ := hex.DecodeString("a30100025820fa62c80a6e5a929d89acc2d5b169c47e2f12dd79b8ee9ccb209f38abaacc510f035820b3f91e81559252054698b20e658c25b2dd7b4f6e4cb928641921e7cef19de346")
 	h, _ := crypto.HashFromString("5aef9dfba6969624095dd4eb593cd0212a1500f82d48a82c77f622941de5692b")
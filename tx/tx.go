@@ -7,7 +7,9 @@ import (
 	"github.com/fxamacker/cbor/v2"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/tx/payload"
+	"github.com/zarbchain/zarb-go/version"
 )
 
 type Tx struct {
@@ -27,6 +29,8 @@ type txData struct {
 	Memo      string
 	PublicKey *crypto.PublicKey
 	Signature *crypto.Signature
+	MultiSig  *crypto.MultiSig
+	Bitmap    []byte
 }
 
 func (tx *Tx) Version() int                     { return tx.data.Version }
@@ -38,6 +42,8 @@ func (tx *Tx) Fee() int64                       { return tx.data.Fee }
 func (tx *Tx) Memo() string                     { return tx.data.Memo }
 func (tx *Tx) PublicKey() *crypto.PublicKey     { return tx.data.PublicKey }
 func (tx *Tx) Signature() *crypto.Signature     { return tx.data.Signature }
+func (tx *Tx) MultiSig() *crypto.MultiSig       { return tx.data.MultiSig }
+func (tx *Tx) SignerBitmap() []byte             { return tx.data.Bitmap }
 
 func (tx *Tx) SetSignature(sig *crypto.Signature) {
 	tx.sanityChecked = false
@@ -49,11 +55,35 @@ func (tx *Tx) SetPublicKey(pub *crypto.PublicKey) {
 	tx.data.PublicKey = pub
 }
 
+// SetMultiSig sets the key set of a multisig signer and the participants in the aggregated signature
+func (tx *Tx) SetMultiSig(ms *crypto.MultiSig, bitmap []byte) {
+	tx.sanityChecked = false
+	tx.data.MultiSig = ms
+	tx.data.Bitmap = bitmap
+}
+
 func (tx *Tx) SanityCheck() error {
 	if tx.sanityChecked {
 		return nil
 	}
-	if tx.data.Version != 1 {
+	if err := tx.sanityCheck(true); err != nil {
+		return err
+	}
+
+	tx.sanityChecked = true
+
+	return nil
+}
+
+// SanityCheckUnsigned checks the transaction without verifying the signature.
+// It is useful to check a transaction before signing it.
+func (tx *Tx) SanityCheckUnsigned() error {
+	return tx.sanityCheck(false)
+}
+
+func (tx *Tx) sanityCheck(checkSignature bool) error {
+	// The version should match the protocol version at the execution height, that is checked on execution
+	if tx.data.Version < param.InitialProtocolVersion || tx.data.Version > version.ProtocolVersion {
 		return errors.Errorf(errors.ErrInvalidTx, "Invalid version")
 	}
 	if tx.data.Sequence < 0 {
@@ -67,6 +97,9 @@ func (tx *Tx) SanityCheck() error {
 		if tx.data.Signature != nil {
 			return errors.Errorf(errors.ErrInvalidTx, "Subsidy transaction should not have signature")
 		}
+		if tx.data.MultiSig != nil || tx.data.Bitmap != nil {
+			return errors.Errorf(errors.ErrInvalidTx, "Subsidy transaction should not have multisig")
+		}
 		if tx.data.Fee != 0 {
 			return errors.Errorf(errors.ErrInvalidTx, "Fee for Subsidy transaction should set to zero")
 		}
@@ -74,8 +107,10 @@ func (tx *Tx) SanityCheck() error {
 		if tx.data.Fee < 0 {
 			return errors.Errorf(errors.ErrInvalidTx, "Invalid fee")
 		}
-		if err := tx.CheckSignature(); err != nil {
-			return err
+		if checkSignature {
+			if err := tx.CheckSignature(); err != nil {
+				return err
+			}
 		}
 	}
 	if tx.data.Type != tx.data.Payload.Type() {
@@ -85,12 +120,16 @@ func (tx *Tx) SanityCheck() error {
 		return err
 	}
 
-	tx.sanityChecked = true
-
 	return nil
 }
 
 func (tx *Tx) CheckSignature() error {
+	if tx.data.MultiSig != nil {
+		return tx.checkMultiSignature()
+	}
+	if tx.data.Bitmap != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Signer bitmap without multisig")
+	}
 	if tx.data.PublicKey == nil {
 		return errors.Errorf(errors.ErrInvalidTx, "No public key")
 	}
@@ -113,6 +152,29 @@ func (tx *Tx) CheckSignature() error {
 	return nil
 }
 
+// checkMultiSignature verifies the aggregated signature of a multisig signer
+func (tx *Tx) checkMultiSignature() error {
+	if tx.data.PublicKey != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Multisig transaction should not have public key")
+	}
+	if tx.data.Signature == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "No signature")
+	}
+	if err := tx.data.Signature.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid signature")
+	}
+	if err := tx.data.MultiSig.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid multisig: %v", err)
+	}
+	if !tx.data.Payload.Signer().EqualsTo(tx.data.MultiSig.Address()) {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid multisig")
+	}
+	if err := tx.data.MultiSig.Verify(tx.SignBytes(), tx.data.Signature, tx.data.Bitmap); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+	return nil
+}
+
 type _txData struct {
 	Version   int                 `cbor:"1,keyasint"`
 	Stamp     crypto.Hash         `cbor:"2,keyasint"`
@@ -123,6 +185,8 @@ type _txData struct {
 	Memo      string              `cbor:"7,keyasint,omitempty"`
 	PublicKey *crypto.PublicKey   `cbor:"20,keyasint,omitempty"`
 	Signature *crypto.Signature   `cbor:"21,keyasint,omitempty"`
+	MultiSig  *crypto.MultiSig    `cbor:"22,keyasint,omitempty"`
+	Bitmap    []byte              `cbor:"23,keyasint,omitempty"`
 }
 
 func (tx *Tx) MarshalCBOR() ([]byte, error) {
@@ -141,6 +205,8 @@ func (tx *Tx) MarshalCBOR() ([]byte, error) {
 		Memo:      tx.data.Memo,
 		PublicKey: tx.data.PublicKey,
 		Signature: tx.data.Signature,
+		MultiSig:  tx.data.MultiSig,
+		Bitmap:    tx.data.Bitmap,
 	}
 
 	return cbor.Marshal(_data)
@@ -161,6 +227,22 @@ func (tx *Tx) UnmarshalCBOR(bs []byte) error {
 		p = &payload.BondPayload{}
 	case payload.PayloadTypeSortition:
 		p = &payload.SortitionPayload{}
+	case payload.PayloadTypeUnbond:
+		p = &payload.UnbondPayload{}
+	case payload.PayloadTypeWithdraw:
+		p = &payload.WithdrawPayload{}
+	case payload.PayloadTypeEvidence:
+		p = &payload.EvidencePayload{}
+	case payload.PayloadTypeBatchSend:
+		p = &payload.BatchSendPayload{}
+	case payload.PayloadTypeLock:
+		p = &payload.LockPayload{}
+	case payload.PayloadTypeClaim:
+		p = &payload.ClaimPayload{}
+	case payload.PayloadTypeParamProposal:
+		p = &payload.ParamProposalPayload{}
+	case payload.PayloadTypeParamVote:
+		p = &payload.ParamVotePayload{}
 
 	default:
 		return errors.Errorf(errors.ErrInvalidMessage, "Invalid payload")
@@ -175,6 +257,8 @@ func (tx *Tx) UnmarshalCBOR(bs []byte) error {
 	tx.data.Memo = _data.Memo
 	tx.data.PublicKey = _data.PublicKey
 	tx.data.Signature = _data.Signature
+	tx.data.MultiSig = _data.MultiSig
+	tx.data.Bitmap = _data.Bitmap
 
 	return cbor.Unmarshal(_data.Payload, p)
 }
@@ -216,6 +300,8 @@ func (tx Tx) SignBytes() []byte {
 	tx2 := tx
 	tx2.data.PublicKey = nil
 	tx2.data.Signature = nil
+	tx2.data.MultiSig = nil
+	tx2.data.Bitmap = nil
 
 	bz, _ := tx2.MarshalCBOR()
 	return bz
@@ -231,7 +317,7 @@ func (tx *Tx) ID() crypto.Hash {
 }
 
 func (tx *Tx) IsSubsidyTx() bool {
-	return tx.data.Type == payload.PayloadTypeSend &&
+	return (tx.data.Type == payload.PayloadTypeSend || tx.data.Type == payload.PayloadTypeBatchSend) &&
 		tx.data.Payload.Signer().EqualsTo(crypto.TreasuryAddress)
 }
 
@@ -261,7 +347,88 @@ func GenerateTestSortitionTx() (*Tx, crypto.PrivateKey) {
 	h := crypto.GenerateTestHash()
 	a1, pb1, pv1 := crypto.GenerateTestKeyPair()
 	proof := [48]byte{}
-	tx := NewSortitionTx(h, 110, a1, proof[:], "test sortition-tx", &pb1, nil)
+	tx := NewSortitionTx(1, h, 110, a1, proof[:], "test sortition-tx", &pb1, nil)
+	sig := pv1.Sign(tx.SignBytes())
+	tx.data.Signature = sig
+	return tx, pv1
+}
+
+func GenerateTestUnbondTx() (*Tx, crypto.PrivateKey) {
+	h := crypto.GenerateTestHash()
+	a1, pb1, pv1 := crypto.GenerateTestKeyPair()
+	tx := NewUnbondTx(h, 110, a1, "test unbond-tx", &pb1, nil)
+	sig := pv1.Sign(tx.SignBytes())
+	tx.data.Signature = sig
+	return tx, pv1
+}
+
+func GenerateTestWithdrawTx() (*Tx, crypto.PrivateKey) {
+	h := crypto.GenerateTestHash()
+	a1, pb1, pv1 := crypto.GenerateTestKeyPair()
+	a2, _, _ := crypto.GenerateTestKeyPair()
+	tx := NewWithdrawTx(h, 110, a1, a2, 100, "test withdraw-tx", &pb1, nil)
+	sig := pv1.Sign(tx.SignBytes())
+	tx.data.Signature = sig
+	return tx, pv1
+}
+
+func GenerateTestBatchSendTx() (*Tx, crypto.PrivateKey) {
+	h := crypto.GenerateTestHash()
+	a1, pb1, pv1 := crypto.GenerateTestKeyPair()
+	a2, _, _ := crypto.GenerateTestKeyPair()
+	a3, _, _ := crypto.GenerateTestKeyPair()
+	receivers := []payload.BatchReceiver{{Address: a2, Amount: 100}, {Address: a3, Amount: 200}}
+	tx := NewBatchSendTx(h, 110, a1, receivers, 10, "test batch-send-tx", &pb1, nil)
+	sig := pv1.Sign(tx.SignBytes())
+	tx.data.Signature = sig
+	return tx, pv1
+}
+
+func GenerateTestLockTx() (*Tx, crypto.PrivateKey) {
+	h := crypto.GenerateTestHash()
+	a1, pb1, pv1 := crypto.GenerateTestKeyPair()
+	a2, _, _ := crypto.GenerateTestKeyPair()
+	a3, _, _ := crypto.GenerateTestKeyPair()
+	tx := NewLockTx(h, 110, a1, a2, 100, 1000, &a3, 2000, 10, "test lock-tx", &pb1, nil)
+	sig := pv1.Sign(tx.SignBytes())
+	tx.data.Signature = sig
+	return tx, pv1
+}
+
+func GenerateTestClaimTx() (*Tx, crypto.PrivateKey) {
+	h := crypto.GenerateTestHash()
+	a1, pb1, pv1 := crypto.GenerateTestKeyPair()
+	tx := NewClaimTx(h, 110, a1, crypto.GenerateTestHash(), 100, "test claim-tx", &pb1, nil)
+	sig := pv1.Sign(tx.SignBytes())
+	tx.data.Signature = sig
+	return tx, pv1
+}
+
+func GenerateTestParamProposalTx() (*Tx, crypto.PrivateKey) {
+	h := crypto.GenerateTestHash()
+	a1, pb1, pv1 := crypto.GenerateTestKeyPair()
+	params := param.MainnetParams()
+	params.MinimumFee = 2000
+	tx := NewParamProposalTx(h, 110, a1, params, 1000, "test param-proposal-tx", &pb1, nil)
+	sig := pv1.Sign(tx.SignBytes())
+	tx.data.Signature = sig
+	return tx, pv1
+}
+
+func GenerateTestParamVoteTx() (*Tx, crypto.PrivateKey) {
+	h := crypto.GenerateTestHash()
+	a1, pb1, pv1 := crypto.GenerateTestKeyPair()
+	tx := NewParamVoteTx(h, 110, a1, crypto.GenerateTestHash(), "test param-vote-tx", &pb1, nil)
+	sig := pv1.Sign(tx.SignBytes())
+	tx.data.Signature = sig
+	return tx, pv1
+}
+
+func GenerateTestEvidenceTx() (*Tx, crypto.PrivateKey) {
+	h := crypto.GenerateTestHash()
+	a1, pb1, pv1 := crypto.GenerateTestKeyPair()
+	a2, _, _ := crypto.GenerateTestKeyPair()
+	tx := NewEvidenceTx(h, 110, a1, a2, []byte{1}, []byte{2}, "test evidence-tx", &pb1, nil)
 	sig := pv1.Sign(tx.SignBytes())
 	tx.data.Signature = sig
 	return tx, pv1
@@ -3,6 +3,7 @@ package tx
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/zarbchain/zarb-go/tx/payload"
@@ -48,6 +49,21 @@ func TestBondEncodingTx(t *testing.T) {
 	require.Equal(t, tx.ID(), tx2.ID())
 }
 
+func TestUnbondEncodingTx(t *testing.T) {
+	tx, _ := GenerateTestUnbondTx()
+
+	bz, err := tx.MarshalCBOR()
+	require.NoError(t, err)
+	var tx2 Tx
+	err = tx2.UnmarshalCBOR(bz)
+
+	bz2, _ := tx2.MarshalCBOR()
+
+	require.NoError(t, err)
+	require.Equal(t, bz, bz2)
+	require.Equal(t, tx.ID(), tx2.ID())
+}
+
 func TestEncodingTxNoMemo(t *testing.T) {
 	tx, _ := GenerateTestSendTx()
 	tx.data.Memo = ""
@@ -123,9 +139,21 @@ func TestTxSanityCheck(t *testing.T) {
 	})
 }
 
+func TestSanityCheckUnsigned(t *testing.T) {
+	tx, _ := GenerateTestSendTx()
+	tx.SetPublicKey(nil)
+	tx.SetSignature(nil)
+
+	assert.Error(t, tx.SanityCheck())
+	assert.NoError(t, tx.SanityCheckUnsigned())
+
+	tx.data.Version = 2
+	assert.Error(t, tx.SanityCheckUnsigned())
+}
+
 func TestSubsidyTx(t *testing.T) {
 	a, pub, priv := crypto.GenerateTestKeyPair()
-	trx := NewSubsidyTx(crypto.GenerateTestHash(), 111, a, 1111, "subsidy")
+	trx := NewSubsidyTx(1, crypto.GenerateTestHash(), 111, a, 1111, "subsidy")
 
 	trx.data.Fee = 1
 	assert.Error(t, trx.SanityCheck())
@@ -138,6 +166,22 @@ func TestSubsidyTx(t *testing.T) {
 	assert.Error(t, trx.SanityCheck())
 }
 
+func TestBatchSubsidyTx(t *testing.T) {
+	a1, _, _ := crypto.GenerateTestKeyPair()
+	a2, _, _ := crypto.GenerateTestKeyPair()
+	trx := NewBatchSubsidyTx(1, crypto.GenerateTestHash(), 88, []payload.BatchReceiver{
+		{Address: a1, Amount: 100},
+		{Address: a2, Amount: 200},
+	}, "subsidy")
+	assert.True(t, trx.IsSubsidyTx())
+	assert.NoError(t, trx.SanityCheck())
+	assert.Equal(t, trx.Payload().Value(), int64(300))
+
+	trx2 := NewBatchSubsidyTx(1, crypto.GenerateTestHash(), 88, []payload.BatchReceiver{{Address: a1, Amount: 100}}, "")
+	trx2.data.Fee = 1
+	assert.Error(t, trx2.SanityCheck())
+}
+
 func TestInvalidSignature(t *testing.T) {
 	tx, pv := GenerateTestSendTx()
 	assert.NoError(t, tx.SanityCheck())
@@ -249,6 +293,69 @@ func TestSortitionSanityCheck(t *testing.T) {
 
 }
 
+func TestUnbondSanityCheck(t *testing.T) {
+	invAddr, _, _ := crypto.GenerateTestKeyPair()
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestUnbondTx()
+		assert.NoError(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid validator", func(t *testing.T) {
+		trx, priv := GenerateTestUnbondTx()
+		pld := trx.data.Payload.(*payload.UnbondPayload)
+		pld.Validator = invAddr
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
+func TestWithdrawSanityCheck(t *testing.T) {
+	invAddr, _, _ := crypto.GenerateTestKeyPair()
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestWithdrawTx()
+		assert.NoError(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid amount", func(t *testing.T) {
+		trx, priv := GenerateTestWithdrawTx()
+		pld := trx.data.Payload.(*payload.WithdrawPayload)
+		pld.Amount = -1
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid validator", func(t *testing.T) {
+		trx, priv := GenerateTestWithdrawTx()
+		pld := trx.data.Payload.(*payload.WithdrawPayload)
+		pld.From = invAddr
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
+func TestEvidenceSanityCheck(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestEvidenceTx()
+		assert.NoError(t, trx.SanityCheck())
+	})
+
+	t.Run("Reporter is offender", func(t *testing.T) {
+		trx, priv := GenerateTestEvidenceTx()
+		pld := trx.data.Payload.(*payload.EvidencePayload)
+		pld.Offender = pld.Reporter
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("No votes", func(t *testing.T) {
+		trx, priv := GenerateTestEvidenceTx()
+		pld := trx.data.Payload.(*payload.EvidencePayload)
+		pld.Vote2 = nil
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
 func TestSendDecodingAndHash(t *testing.T) {
 	d, _ := hex.DecodeString("a9010102582008f7d9c21fdaa4a4147e60a0f3933c850b0c0d9af6b2a308c0a7b5639a7e49d603186e040a050106a301548dfaf698d3889b13251529ff971277305fbf1f440254bdd1540a13d82c38e5b4dfbd7b5b2bcab5fb5f290318640767746573742074781458603846ed5d519e51f6dd63e552ac410c531d5436c726475f6f8fb51c1133b07e32bd3bc4c674359546a1145cb1935a3c0621fc5329c6039707445e472a73d857d8eff832b971838b21c53baa090d90b02f6d2c5a1e9358e46f4f4955ff737c08991558309e6b99c60cf5ccb551efc793ec2bedd66070bde8fbddeb8305c5f670a21532304775637f9e622d5212f34c9479d12d11")
 	s, _ := hex.DecodeString("a7010102582008f7d9c21fdaa4a4147e60a0f3933c850b0c0d9af6b2a308c0a7b5639a7e49d603186e040a050106a301548dfaf698d3889b13251529ff971277305fbf1f440254bdd1540a13d82c38e5b4dfbd7b5b2bcab5fb5f29031864076774657374207478")
@@ -274,3 +381,243 @@ func TestBondDecodingAndHash(t *testing.T) {
 	assert.Equal(t, trx.SignBytes(), s)
 	assert.Equal(t, trx.ID(), h)
 }
+
+func TestBatchSendEncodingTx(t *testing.T) {
+	tx, _ := GenerateTestBatchSendTx()
+
+	bz, err := tx.MarshalCBOR()
+	require.NoError(t, err)
+	var tx2 Tx
+	require.NoError(t, tx2.UnmarshalCBOR(bz))
+	require.Equal(t, tx.ID(), tx2.ID())
+	require.Equal(t, tx2.Payload().Value(), int64(300))
+}
+
+func TestBatchSendSanityCheck(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestBatchSendTx()
+		assert.NoError(t, trx.SanityCheck())
+	})
+
+	t.Run("No receiver", func(t *testing.T) {
+		trx, priv := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers = nil
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid amount", func(t *testing.T) {
+		trx, priv := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers[1].Amount = -1
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Overflowed amount", func(t *testing.T) {
+		trx, priv := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers[1].Amount = math.MaxInt64
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid receiver", func(t *testing.T) {
+		trx, priv := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers[0].Address = crypto.TreasuryAddress
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Sender is a receiver", func(t *testing.T) {
+		trx, priv := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers[0].Address = pld.Sender
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Duplicated receiver", func(t *testing.T) {
+		trx, priv := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers[1].Address = pld.Receivers[0].Address
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
+func TestLockEncodingTx(t *testing.T) {
+	trx1, _ := GenerateTestLockTx()
+	bz, err := trx1.MarshalCBOR()
+	require.NoError(t, err)
+	var trx2 Tx
+	require.NoError(t, trx2.UnmarshalCBOR(bz))
+	require.Equal(t, trx1.ID(), trx2.ID())
+	require.Equal(t, trx1.Payload(), trx2.Payload())
+
+	trx3, _ := GenerateTestClaimTx()
+	bz, err = trx3.MarshalCBOR()
+	require.NoError(t, err)
+	var trx4 Tx
+	require.NoError(t, trx4.UnmarshalCBOR(bz))
+	require.Equal(t, trx3.ID(), trx4.ID())
+}
+
+func TestLockSanityCheck(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestLockTx()
+		assert.NoError(t, trx.SanityCheck())
+	})
+
+	t.Run("Without refund address", func(t *testing.T) {
+		trx, priv := GenerateTestLockTx()
+		pld := trx.data.Payload.(*payload.LockPayload)
+		pld.Refund = nil
+		pld.Deadline = 0
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.NoError(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid amount", func(t *testing.T) {
+		trx, priv := GenerateTestLockTx()
+		pld := trx.data.Payload.(*payload.LockPayload)
+		pld.Amount = 0
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid unlock height", func(t *testing.T) {
+		trx, priv := GenerateTestLockTx()
+		pld := trx.data.Payload.(*payload.LockPayload)
+		pld.UnlockHeight = 0
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Refund address without deadline", func(t *testing.T) {
+		trx, priv := GenerateTestLockTx()
+		pld := trx.data.Payload.(*payload.LockPayload)
+		pld.Deadline = 0
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Deadline before unlock height", func(t *testing.T) {
+		trx, priv := GenerateTestLockTx()
+		pld := trx.data.Payload.(*payload.LockPayload)
+		pld.Deadline = pld.UnlockHeight
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid escrow id", func(t *testing.T) {
+		trx, priv := GenerateTestClaimTx()
+		pld := trx.data.Payload.(*payload.ClaimPayload)
+		pld.Escrow = crypto.UndefHash
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
+func TestParamProposalEncodingTx(t *testing.T) {
+	trx1, _ := GenerateTestParamProposalTx()
+	bz, err := trx1.MarshalCBOR()
+	require.NoError(t, err)
+	var trx2 Tx
+	require.NoError(t, trx2.UnmarshalCBOR(bz))
+	require.Equal(t, trx1.ID(), trx2.ID())
+	require.Equal(t, trx1.Payload(), trx2.Payload())
+
+	trx3, _ := GenerateTestParamVoteTx()
+	bz, err = trx3.MarshalCBOR()
+	require.NoError(t, err)
+	var trx4 Tx
+	require.NoError(t, trx4.UnmarshalCBOR(bz))
+	require.Equal(t, trx3.ID(), trx4.ID())
+	require.Equal(t, trx3.Payload(), trx4.Payload())
+}
+
+func TestParamProposalSanityCheck(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestParamProposalTx()
+		assert.NoError(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid parameters", func(t *testing.T) {
+		trx, priv := GenerateTestParamProposalTx()
+		pld := trx.data.Payload.(*payload.ParamProposalPayload)
+		pld.Params.MaximumPower = 0
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid activation height", func(t *testing.T) {
+		trx, priv := GenerateTestParamProposalTx()
+		pld := trx.data.Payload.(*payload.ParamProposalPayload)
+		pld.ActivationHeight = 0
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid proposal id", func(t *testing.T) {
+		trx, priv := GenerateTestParamVoteTx()
+		pld := trx.data.Payload.(*payload.ParamVotePayload)
+		pld.Proposal = crypto.UndefHash
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
+func TestMultiSigTx(t *testing.T) {
+	ms, privs := crypto.GenerateTestMultiSig(2, 3)
+	receiver, _, _ := crypto.GenerateTestKeyPair()
+	trx := NewSendTx(crypto.GenerateTestHash(), 1, ms.Address(), receiver, 1000, 1000, "multisig", nil, nil)
+	sig := crypto.Aggregate([]*crypto.Signature{privs[0].Sign(trx.SignBytes()), privs[1].Sign(trx.SignBytes())})
+	trx.SetSignature(&sig)
+	trx.SetMultiSig(ms, ms.Bitmap(0, 1))
+	assert.NoError(t, trx.SanityCheck())
+
+	t.Run("Encoding", func(t *testing.T) {
+		bz, err := trx.Encode()
+		require.NoError(t, err)
+		trx2 := new(Tx)
+		require.NoError(t, trx2.Decode(bz))
+		assert.Equal(t, trx.ID(), trx2.ID())
+		assert.NoError(t, trx2.SanityCheck())
+	})
+
+	t.Run("Wrong bitmap", func(t *testing.T) {
+		trx.SetMultiSig(ms, ms.Bitmap(0, 2))
+		assert.Error(t, trx.SanityCheck())
+		trx.SetMultiSig(ms, nil)
+		assert.Error(t, trx.SanityCheck())
+		trx.SetMultiSig(ms, ms.Bitmap(0, 1))
+	})
+
+	t.Run("Threshold is not reached", func(t *testing.T) {
+		sig0 := crypto.Aggregate([]*crypto.Signature{privs[0].Sign(trx.SignBytes())})
+		trx.SetSignature(&sig0)
+		trx.SetMultiSig(ms, ms.Bitmap(0))
+		assert.Error(t, trx.SanityCheck())
+		trx.SetSignature(&sig)
+		trx.SetMultiSig(ms, ms.Bitmap(0, 1))
+	})
+
+	t.Run("Different key set", func(t *testing.T) {
+		ms2, _ := crypto.GenerateTestMultiSig(1, 3)
+		trx.SetMultiSig(ms2, ms2.Bitmap(0, 1))
+		assert.Error(t, trx.SanityCheck())
+		trx.SetMultiSig(ms, ms.Bitmap(0, 1))
+	})
+
+	t.Run("Having public key", func(t *testing.T) {
+		pub := privs[0].PublicKey()
+		trx.SetPublicKey(&pub)
+		assert.Error(t, trx.SanityCheck())
+		trx.SetPublicKey(nil)
+	})
+
+	assert.NoError(t, trx.SanityCheck())
+}
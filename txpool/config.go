@@ -3,20 +3,35 @@ package txpool
 import "time"
 
 type Config struct {
-	WaitingTimeout time.Duration
-	MaxSize        int
+	WaitingTimeout           time.Duration
+	MaxSize                  int
+	JournalCompactInterval   time.Duration
+	MaxPendingPerSigner      int
+	MaxPendingPerPeer        int
+	MinFeeBump               int // In percent
+	MaxReplacementsPerSigner int // Per block
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		WaitingTimeout: 2 * time.Second,
-		MaxSize:        2000,
+		WaitingTimeout:           2 * time.Second,
+		MaxSize:                  2000,
+		JournalCompactInterval:   1 * time.Hour,
+		MaxPendingPerSigner:      64,
+		MaxPendingPerPeer:        512,
+		MinFeeBump:               10,
+		MaxReplacementsPerSigner: 4,
 	}
 }
 
 func TestConfig() *Config {
 	return &Config{
-		WaitingTimeout: 100 * time.Millisecond,
-		MaxSize:        10,
+		WaitingTimeout:           100 * time.Millisecond,
+		MaxSize:                  10,
+		JournalCompactInterval:   1 * time.Minute,
+		MaxPendingPerSigner:      10,
+		MaxPendingPerPeer:        10,
+		MinFeeBump:               10,
+		MaxReplacementsPerSigner: 2,
 	}
 }
@@ -0,0 +1,108 @@
+package txpool
+
+import (
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/libs/linkedmap"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+type EvictionReason int
+
+const (
+	EvictionInvalid     = EvictionReason(1)
+	EvictionExpired     = EvictionReason(2)
+	EvictionUnderpriced = EvictionReason(3)
+	EvictionReplaced    = EvictionReason(4)
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictionInvalid:
+		return "invalid"
+	case EvictionExpired:
+		return "expired"
+	case EvictionUnderpriced:
+		return "underpriced"
+	case EvictionReplaced:
+		return "replaced"
+	}
+	return "unknown"
+}
+
+// EvictionEvent is published when a transaction is dropped from the pool
+type EvictionEvent struct {
+	Tx     *tx.Tx
+	Reason EvictionReason
+	Error  error
+}
+
+const evictionChannelSize = 100
+
+// SubscribeEvictions returns a channel that receives the eviction events.
+// Events are dropped if the subscriber doesn't read them on time.
+func (pool *txPool) SubscribeEvictions() <-chan *EvictionEvent {
+	pool.lk.Lock()
+	defer pool.lk.Unlock()
+
+	ch := make(chan *EvictionEvent, evictionChannelSize)
+	pool.subscribers = append(pool.subscribers, ch)
+	return ch
+}
+
+func (pool *txPool) publishEviction(trx *tx.Tx, reason EvictionReason, err error) {
+	pool.logger.Debug("Transaction is evicted", "tx", trx, "reason", reason, "err", err)
+
+	event := &EvictionEvent{
+		Tx:     trx,
+		Reason: reason,
+		Error:  err,
+	}
+	for _, ch := range pool.subscribers {
+		select {
+		case ch <- event:
+		default:
+			pool.logger.Warn("Eviction subscriber is busy, event dropped", "tx", trx)
+		}
+	}
+}
+
+// evictionReason distinguishes the expired transactions from the invalid ones
+func (pool *txPool) evictionReason(trx *tx.Tx) EvictionReason {
+	if err := pool.checker.CheckStamp(trx); err != nil {
+		return EvictionExpired
+	}
+	return EvictionInvalid
+}
+
+// Recheck executes the pending transactions again against the cleared sandbox.
+// It should be called after committing a block.
+// Invalid and expired transactions are evicted from the pool.
+func (pool *txPool) Recheck() {
+	pool.lk.Lock()
+	defer pool.lk.Unlock()
+
+	trxs := make([]*tx.Tx, 0, pool.pendings.Size())
+	for e := pool.pendings.FirstElement(); e != nil; e = e.Next() {
+		trxs = append(trxs, e.Value.(*linkedmap.Pair).Second.(*tx.Tx))
+	}
+
+	failed := pool.rebuild(trxs)
+	for _, trx := range trxs {
+		if err, ok := failed[trx.ID()]; ok {
+			pool.publishEviction(trx, pool.evictionReason(trx), err)
+		}
+	}
+	pool.replaced = make(map[crypto.Address]int)
+
+	pool.pruneFutureTxs()
+	for signer := range pool.futures {
+		pool.promoteFutureTxs(signer)
+	}
+
+	if pool.journal != nil && util.Now().Sub(pool.lastCompact) >= pool.config.JournalCompactInterval {
+		if err := pool.compactJournal(); err != nil {
+			pool.logger.Error("Unable to compact the journal", "err", err)
+		}
+	}
+}
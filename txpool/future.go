@@ -0,0 +1,118 @@
+package txpool
+
+import (
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/libs/linkedmap"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+// Future transactions are the ones that their sequence is ahead of the signer's sequence.
+// They are kept in a queue per signer and they will be promoted to the pending list once the gap is filled.
+
+func (pool *txPool) isFutureTx(trx *tx.Tx) bool {
+	expected := pool.checker.ExpectedSequence(trx)
+	return expected > 0 && trx.Sequence() > expected
+}
+
+func (pool *txPool) hasFutureTx(trx *tx.Tx) bool {
+	queue, ok := pool.futures[trx.Payload().Signer()]
+	if !ok {
+		return false
+	}
+	return queue.Has(trx.ID())
+}
+
+func (pool *txPool) appendFutureTx(trx *tx.Tx) error {
+	if err := trx.SanityCheck(); err != nil {
+		return err
+	}
+	if err := pool.checker.CheckStamp(trx); err != nil {
+		return err
+	}
+
+	pool.pruneFutureTxs()
+	if pool.futureSize >= pool.config.MaxSize {
+		return errors.Errorf(errors.ErrInvalidTx, "Future queue is full")
+	}
+
+	signer := trx.Payload().Signer()
+	queue, ok := pool.futures[signer]
+	if !ok {
+		queue = linkedmap.NewLinkedMap(pool.config.MaxSize)
+		pool.futures[signer] = queue
+	}
+	queue.PushBack(trx.ID(), trx)
+	pool.priorities[trx.ID()] = feePerByte(trx)
+	pool.futureSize++
+
+	pool.logger.Debug("Transaction is queued", "tx", trx, "expected sequence", pool.checker.ExpectedSequence(trx))
+
+	return nil
+}
+
+// promoteFutureTxs moves the queued transactions of the signer to the pending list,
+// as long as their sequence matches the signer's sequence
+func (pool *txPool) promoteFutureTxs(signer crypto.Address) {
+	queue, ok := pool.futures[signer]
+	if !ok {
+		return
+	}
+
+	for promoted := true; promoted; {
+		promoted = false
+		for e := queue.FirstElement(); e != nil; {
+			next := e.Next()
+			trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+			expected := pool.checker.ExpectedSequence(trx)
+
+			switch {
+			case trx.Sequence() < expected:
+				pool.removeFutureTx(signer, trx.ID())
+				pool.publishEviction(trx, EvictionInvalid,
+					errors.Errorf(errors.ErrInvalidTx, "Queued transaction is outdated"))
+
+			case trx.Sequence() == expected:
+				pool.removeFutureTx(signer, trx.ID())
+				if err := pool.appendPendingTx(trx, false); err != nil {
+					pool.publishEviction(trx, pool.evictionReason(trx), err)
+				} else {
+					promoted = true
+				}
+
+			default:
+				if err := pool.checker.CheckStamp(trx); err != nil {
+					pool.removeFutureTx(signer, trx.ID())
+					pool.publishEviction(trx, EvictionExpired, err)
+				}
+			}
+			e = next
+		}
+	}
+}
+
+// pruneFutureTxs removes the queued transactions that their stamp is expired
+func (pool *txPool) pruneFutureTxs() {
+	for signer, queue := range pool.futures {
+		for e := queue.FirstElement(); e != nil; {
+			next := e.Next()
+			trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+			if err := pool.checker.CheckStamp(trx); err != nil {
+				pool.removeFutureTx(signer, trx.ID())
+				pool.publishEviction(trx, EvictionExpired, err)
+			}
+			e = next
+		}
+	}
+}
+
+func (pool *txPool) removeFutureTx(signer crypto.Address, id crypto.Hash) {
+	queue := pool.futures[signer]
+	queue.Remove(id)
+	delete(pool.priorities, id)
+	pool.futureSize--
+
+	if queue.Empty() {
+		delete(pool.futures, signer)
+	}
+}
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/tx"
@@ -10,6 +11,7 @@ type TxPoolReader interface {
 	PendingTx(id crypto.Hash) *tx.Tx
 	HasTx(id crypto.Hash) bool
 	Size() int
+	ExpectedSequence(trx *tx.Tx) int
 
 	Fingerprint() string
 }
@@ -20,6 +22,12 @@ type TxPool interface {
 	SetSandbox(sandbox sandbox.Sandbox)
 	AppendTx(tx *tx.Tx) error
 	AppendTxAndBroadcast(trx *tx.Tx) error
+	AppendTxFrom(trx *tx.Tx, from peer.ID) error
 	RemoveTx(id crypto.Hash)
 	AllTransactions() []*tx.Tx
+	PrioritizedTransactions() []*tx.Tx
+	Recheck()
+	SubscribeEvictions() <-chan *EvictionEvent
+	OpenJournal(path string) error
+	CloseJournal()
 }
@@ -0,0 +1,218 @@
+package txpool
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/zarbchain/zarb-go/libs/linkedmap"
+	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+// maxJournalRecordSize is the maximum size of an encoded transaction in the journal.
+// A bigger record means the journal is corrupted.
+const maxJournalRecordSize = 512 * 1024
+
+// journal is an append-only file that keeps the accepted transactions of the pool,
+// so they can be loaded again after restarting the node.
+// Each record is the encoded transaction, prefixed by its length.
+type journal struct {
+	path   string
+	writer *os.File
+	count  int
+	logger *logger.Logger
+}
+
+func newJournal(path string, logger *logger.Logger) *journal {
+	return &journal{
+		path:   path,
+		logger: logger,
+	}
+}
+
+// load reads the transactions from the journal and passes them to the add function.
+// A broken record at the end of the file (i.e. an unfinished write) is ignored.
+// If a record is too large, loading stops and the loaded transactions are kept.
+func (j *journal) load(add func(trx *tx.Tx)) error {
+	f, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for {
+		var length uint32
+		if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+			break
+		}
+		if length > maxJournalRecordSize {
+			j.logger.Warn("Journal is corrupted, the rest of it is ignored", "length", length)
+			break
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(r, data); err != nil {
+			break
+		}
+		trx := new(tx.Tx)
+		if err := trx.Decode(data); err != nil {
+			continue
+		}
+		add(trx)
+	}
+
+	return nil
+}
+
+func (j *journal) insert(trx *tx.Tx) error {
+	if j.writer == nil {
+		return nil
+	}
+	if err := writeJournalRecord(j.writer, trx); err != nil {
+		return err
+	}
+	j.count++
+	return nil
+}
+
+// rotate rewrites the journal with the given transactions, removing the stale records
+func (j *journal) rotate(trxs []*tx.Tx) error {
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+		j.writer = nil
+	}
+
+	tmp := j.path + ".new"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	for _, trx := range trxs {
+		if err := writeJournalRecord(f, trx); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		return err
+	}
+
+	j.writer, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	j.count = len(trxs)
+	return nil
+}
+
+func (j *journal) close() error {
+	if j.writer == nil {
+		return nil
+	}
+	err := j.writer.Close()
+	j.writer = nil
+	return err
+}
+
+func writeJournalRecord(w io.Writer, trx *tx.Tx) error {
+	data, err := trx.Encode()
+	if err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.BigEndian, uint32(len(data))); err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// OpenJournal loads the transactions from the journal and validates them again.
+// It should be called after setting the sandbox.
+// From now on, all accepted transactions are recorded in the journal.
+func (pool *txPool) OpenJournal(path string) error {
+	pool.lk.Lock()
+	defer pool.lk.Unlock()
+
+	pool.journal = nil
+	j := newJournal(path, pool.logger)
+	loaded := 0
+	err := j.load(func(trx *tx.Tx) {
+		if err := pool.appendTx(trx); err != nil {
+			pool.logger.Debug("Journaled transaction is invalid", "tx", trx, "err", err)
+			return
+		}
+		loaded++
+	})
+	if err != nil {
+		return err
+	}
+	pool.logger.Info("Transactions are loaded from the journal", "loaded", loaded)
+
+	pool.journal = j
+	return pool.compactJournal()
+}
+
+func (pool *txPool) CloseJournal() {
+	pool.lk.Lock()
+	defer pool.lk.Unlock()
+
+	if pool.journal == nil {
+		return
+	}
+	if err := pool.journal.close(); err != nil {
+		pool.logger.Error("Unable to close the journal", "err", err)
+	}
+	pool.journal = nil
+}
+
+func (pool *txPool) journalTx(trx *tx.Tx) {
+	// Subsidy transactions are only valid for the proposed block
+	if pool.journal == nil || trx.IsSubsidyTx() {
+		return
+	}
+	if err := pool.journal.insert(trx); err != nil {
+		pool.logger.Error("Unable to write to the journal", "err", err)
+	}
+}
+
+// compactJournal rewrites the journal with the transactions that are in the pool right now
+func (pool *txPool) compactJournal() error {
+	trxs := make([]*tx.Tx, 0, pool.pendings.Size()+pool.futureSize)
+	for e := pool.pendings.FirstElement(); e != nil; e = e.Next() {
+		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+		if !trx.IsSubsidyTx() {
+			trxs = append(trxs, trx)
+		}
+	}
+	// Future transactions are sorted by signer and sequence, to keep the journal deterministic
+	futures := make([]*tx.Tx, 0, pool.futureSize)
+	for _, queue := range pool.futures {
+		for e := queue.FirstElement(); e != nil; e = e.Next() {
+			futures = append(futures, e.Value.(*linkedmap.Pair).Second.(*tx.Tx))
+		}
+	}
+	sort.SliceStable(futures, func(i, j int) bool {
+		cmp := bytes.Compare(futures[i].Payload().Signer().RawBytes(), futures[j].Payload().Signer().RawBytes())
+		if cmp == 0 {
+			return futures[i].Sequence() < futures[j].Sequence()
+		}
+		return cmp < 0
+	})
+	trxs = append(trxs, futures...)
+
+	pool.lastCompact = util.Now()
+	return pool.journal.rotate(trxs)
+}
@@ -0,0 +1,84 @@
+package txpool
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/libs/linkedmap"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+// relay keeps the peer who relayed a transaction to us
+type relay struct {
+	from peer.ID
+	trx  *tx.Tx
+}
+
+// checkLimits prevents one signer or one peer to fill the pool.
+// A signer who is over the quota can still add a transaction,
+// if it pays more than its pending transactions, at least by the fee bump.
+func (pool *txPool) checkLimits(trx *tx.Tx, from peer.ID) error {
+	if trx.IsSubsidyTx() {
+		return nil
+	}
+
+	if from != "" && pool.config.MaxPendingPerPeer > 0 {
+		if pool.relayedBy(from) >= pool.config.MaxPendingPerPeer {
+			return errors.Errorf(errors.ErrTxPoolLimit, "Peer %v relayed too many transactions", from.ShortString())
+		}
+	}
+
+	if pool.config.MaxPendingPerSigner > 0 {
+		count, highest := pool.signerPendings(trx.Payload().Signer())
+		if count >= pool.config.MaxPendingPerSigner {
+			required := highest * (1 + float64(pool.config.MinFeeBump)/100)
+			if feePerByte(trx) < required {
+				return errors.Errorf(errors.ErrTxPoolLimit, "Signer has too many transactions in the pool. Fee per byte should be at least %.4f", required)
+			}
+		}
+	}
+
+	return nil
+}
+
+// relayedBy returns the number of transactions in the pool that are relayed by the peer
+func (pool *txPool) relayedBy(from peer.ID) int {
+	count := 0
+	for id, r := range pool.relays {
+		if !pool.pendings.Has(id) && !pool.hasFutureTx(r.trx) {
+			delete(pool.relays, id)
+			continue
+		}
+		if r.from == from {
+			count++
+		}
+	}
+	return count
+}
+
+// signerPendings returns the number of pending and queued transactions of the signer,
+// and the highest fee per byte between them
+func (pool *txPool) signerPendings(signer crypto.Address) (int, float64) {
+	count := 0
+	highest := float64(0)
+	add := func(trx *tx.Tx) {
+		count++
+		if p := pool.priorities[trx.ID()]; p > highest {
+			highest = p
+		}
+	}
+
+	for e := pool.pendings.FirstElement(); e != nil; e = e.Next() {
+		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+		if trx.Payload().Signer().EqualsTo(signer) {
+			add(trx)
+		}
+	}
+	if queue, ok := pool.futures[signer]; ok {
+		for e := queue.FirstElement(); e != nil; e = e.Next() {
+			add(e.Value.(*linkedmap.Pair).Second.(*tx.Tx))
+		}
+	}
+
+	return count, highest
+}
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/tx"
@@ -10,7 +11,8 @@ var _ TxPool = &MockTxPool{}
 
 // MockTxPool is a testing mock
 type MockTxPool struct {
-	Txs []*tx.Tx
+	Txs       []*tx.Tx
+	AppendErr error // Returned by AppendTxFrom, if it is set
 }
 
 func NewMockTxPool() *MockTxPool {
@@ -39,6 +41,16 @@ func (m *MockTxPool) HasTx(id crypto.Hash) bool {
 	return false
 }
 
+func (m *MockTxPool) ExpectedSequence(trx *tx.Tx) int {
+	seq := 1
+	for _, t := range m.Txs {
+		if t.Payload().Signer().EqualsTo(trx.Payload().Signer()) {
+			seq++
+		}
+	}
+	return seq
+}
+
 func (m *MockTxPool) Size() int {
 	return len(m.Txs)
 }
@@ -55,6 +67,13 @@ func (m *MockTxPool) AppendTxAndBroadcast(t *tx.Tx) error {
 	m.Txs = append(m.Txs, t)
 	return nil
 }
+func (m *MockTxPool) AppendTxFrom(t *tx.Tx, from peer.ID) error {
+	if m.AppendErr != nil {
+		return m.AppendErr
+	}
+	m.Txs = append(m.Txs, t)
+	return nil
+}
 
 func (m *MockTxPool) RemoveTx(hash crypto.Hash) {
 	// This pools is shared between different instances
@@ -65,3 +84,21 @@ func (m *MockTxPool) RemoveTx(hash crypto.Hash) {
 func (m *MockTxPool) AllTransactions() []*tx.Tx {
 	return m.Txs
 }
+
+func (m *MockTxPool) PrioritizedTransactions() []*tx.Tx {
+	return m.Txs
+}
+
+func (m *MockTxPool) Recheck() {
+}
+
+func (m *MockTxPool) SubscribeEvictions() <-chan *EvictionEvent {
+	return make(chan *EvictionEvent)
+}
+
+func (m *MockTxPool) OpenJournal(path string) error {
+	return nil
+}
+
+func (m *MockTxPool) CloseJournal() {
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/zarbchain/zarb-go/sandbox"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/sasha-s/go-deadlock"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/errors"
@@ -21,10 +22,20 @@ type txPool struct {
 	lk deadlock.RWMutex
 
 	config      *Config
+	sandbox     sandbox.Sandbox
 	checker     *execution.Execution
 	pendings    *linkedmap.LinkedMap
+	priorities  map[crypto.Hash]float64 // Fee per byte of pending and queued transactions
+	futures     map[crypto.Address]*linkedmap.LinkedMap
+	futureSize  int
 	appendTxCh  chan *tx.Tx
 	broadcastCh chan *message.Message
+	subscribers []chan *EvictionEvent
+	journal     *journal
+	relays      map[crypto.Hash]*relay
+	replaced    map[crypto.Address]int
+	requested   map[crypto.Hash]chan *tx.Tx // Transactions that are requested for validating a block
+	lastCompact time.Time
 	logger      *logger.Logger
 }
 
@@ -34,6 +45,11 @@ func NewTxPool(
 	pool := &txPool{
 		config:      conf,
 		pendings:    linkedmap.NewLinkedMap(conf.MaxSize),
+		priorities:  make(map[crypto.Hash]float64),
+		futures:     make(map[crypto.Address]*linkedmap.LinkedMap),
+		relays:      make(map[crypto.Hash]*relay),
+		replaced:    make(map[crypto.Address]int),
+		requested:   make(map[crypto.Hash]chan *tx.Tx),
 		appendTxCh:  make(chan *tx.Tx, 5),
 		broadcastCh: broadcastCh,
 	}
@@ -43,18 +59,18 @@ func NewTxPool(
 }
 
 func (pool *txPool) SetSandbox(sb sandbox.Sandbox) {
+	pool.sandbox = sb
 	pool.checker = execution.NewExecution(sb)
 }
 
 func (pool *txPool) AppendTx(trx *tx.Tx) error {
-	pool.lk.Lock()
-	defer pool.lk.Unlock()
-
-	if err := pool.appendTx(trx); err != nil {
+	replacing, err := pool.appendTxAndNotify(trx, "", true)
+	if err != nil {
 		return err
 	}
-
-	pool.appendTxCh <- trx
+	if replacing {
+		pool.broadcastReplacement(trx)
+	}
 
 	return nil
 }
@@ -66,6 +82,7 @@ func (pool *txPool) AppendTxAndBroadcast(trx *tx.Tx) error {
 	if err := pool.appendTx(trx); err != nil {
 		return err
 	}
+	pool.journalTx(trx)
 
 	msg := message.NewTxsMessage([]*tx.Tx{trx})
 	pool.broadcastCh <- msg
@@ -73,17 +90,117 @@ func (pool *txPool) AppendTxAndBroadcast(trx *tx.Tx) error {
 	return nil
 }
 
+// AppendTxFrom appends the transaction that is relayed by a peer
+func (pool *txPool) AppendTxFrom(trx *tx.Tx, from peer.ID) error {
+	replacing, err := pool.appendTxAndNotify(trx, from, false)
+	if err != nil {
+		return err
+	}
+	if replacing {
+		pool.broadcastReplacement(trx)
+	}
+
+	return nil
+}
+
+// appendTxAndNotify appends the transaction and notifies who is waiting for it.
+// It returns true if the transaction replaces a pending one.
+// Transactions that are requested for validating a block are always given to the waiter,
+// because the block is validated by the state, not by the pool.
+func (pool *txPool) appendTxAndNotify(trx *tx.Tx, from peer.ID, wait bool) (bool, error) {
+	pool.lk.Lock()
+	defer pool.lk.Unlock()
+
+	ch, blockTx := pool.requested[trx.ID()]
+	if blockTx {
+		if err := pool.appendTxFrom(trx, from, true); err != nil {
+			pool.logger.Warn("Transaction of the block is not added to the pool", "tx", trx, "err", err)
+		} else {
+			pool.journalTx(trx)
+		}
+		delete(pool.requested, trx.ID())
+		ch <- trx
+		return false, nil
+	}
+
+	replacing := pool.replaceableTx(trx) != nil
+	if err := pool.appendTxFrom(trx, from, false); err != nil {
+		return false, err
+	}
+	pool.journalTx(trx)
+
+	if wait {
+		pool.appendTxCh <- trx
+	} else {
+		// Don't block if no one is waiting for it
+		select {
+		case pool.appendTxCh <- trx:
+		default:
+		}
+	}
+
+	return replacing, nil
+}
+
+// broadcastReplacement broadcasts the transaction that replaced a pending one.
+// It should be called without holding the lock, and it doesn't block,
+// because AppendTx is called by the goroutine that drains the broadcast channel.
+func (pool *txPool) broadcastReplacement(trx *tx.Tx) {
+	msg := message.NewTxsMessage([]*tx.Tx{trx})
+	select {
+	case pool.broadcastCh <- msg:
+	default:
+		go func() { pool.broadcastCh <- msg }()
+	}
+}
+
 func (pool *txPool) appendTx(trx *tx.Tx) error {
-	if pool.pendings.Has(trx.ID()) {
+	return pool.appendTxFrom(trx, "", false)
+}
+
+func (pool *txPool) appendTxFrom(trx *tx.Tx, from peer.ID, blockTx bool) error {
+	if pool.pendings.Has(trx.ID()) || pool.hasFutureTx(trx) {
 		return errors.Errorf(errors.ErrInvalidTx, "Transaction is already in pool. id: %v", trx.ID())
 	}
 
-	if err := pool.checker.Execute(trx); err != nil {
+	if err := pool.doAppendTx(trx, from, blockTx); err != nil {
+		return err
+	}
+
+	if from != "" {
+		pool.relays[trx.ID()] = &relay{from: from, trx: trx}
+	}
+	return nil
+}
+
+// doAppendTx appends the transaction to the pool.
+// A transaction of a block replaces the pending transaction with the same signer and sequence,
+// without paying higher fee, and it is not limited by the quotas.
+func (pool *txPool) doAppendTx(trx *tx.Tx, from peer.ID, blockTx bool) error {
+	if old := pool.replaceableTx(trx); old != nil {
+		if blockTx {
+			return pool.swapTx(old, trx,
+				errors.Errorf(errors.ErrInvalidTx, "Replaced by a transaction of the proposed block"))
+		}
+		return pool.replaceTx(old, trx)
+	}
+
+	if !blockTx {
+		if err := pool.checkLimits(trx, from); err != nil {
+			return err
+		}
+	}
+
+	if pool.isFutureTx(trx) {
+		return pool.appendFutureTx(trx)
+	}
+
+	if err := pool.appendPendingTx(trx, blockTx); err != nil {
 		pool.logger.Error("Invalid transaction", "tx", trx, "err", err)
 		return err
 	}
 
-	pool.pendings.PushBack(trx.ID(), trx)
+	pool.promoteFutureTxs(trx.Payload().Signer())
 
 	return nil
 }
@@ -92,21 +209,31 @@ func (pool *txPool) RemoveTx(id crypto.Hash) {
 	pool.lk.Lock()
 	defer pool.lk.Unlock()
 
-	pool.pendings.Remove(id)
+	pool.removePendingTx(id)
 }
 
+// PendingTx returns the pending transaction. If the transaction is not in the pool, it asks the peers for it.
+// It is used for validating a block, so the requested transaction is accepted regardless of the pool's policies.
 func (pool *txPool) PendingTx(id crypto.Hash) *tx.Tx {
-	pool.lk.RLock()
+	pool.lk.Lock()
 
 	val, found := pool.pendings.Get(id)
 	if found {
 		trx := val.(*tx.Tx)
-		pool.lk.RUnlock()
+		pool.lk.Unlock()
 		return trx
 	}
 
 	pool.logger.Debug("Request transaction from peers", "id", id)
-	pool.lk.RUnlock()
+	ch := make(chan *tx.Tx, 1)
+	pool.requested[id] = ch
+	pool.lk.Unlock()
+
+	defer func() {
+		pool.lk.Lock()
+		delete(pool.requested, id)
+		pool.lk.Unlock()
+	}()
 
 	msg := message.NewTxsReqMessage([]crypto.Hash{id})
 	pool.broadcastCh <- msg
@@ -118,6 +245,9 @@ func (pool *txPool) PendingTx(id crypto.Hash) *tx.Tx {
 		case <-timeout.C:
 			pool.logger.Warn("Transaction not received", "id", id, "timeout", pool.config.WaitingTimeout)
 			return nil
+		case trx := <-ch:
+			pool.logger.Debug("Transaction found", "id", id)
+			return trx
 		case trx := <-pool.appendTxCh:
 			pool.logger.Debug("Transaction found", "id", id)
 			if trx.ID().EqualsTo(id) {
@@ -147,6 +277,15 @@ func (pool *txPool) HasTx(id crypto.Hash) bool {
 	return pool.pendings.Has(id)
 }
 
+// ExpectedSequence returns the sequence that the transaction should have, after the pending transactions of its signer.
+// It is zero if the signer doesn't exist.
+func (pool *txPool) ExpectedSequence(trx *tx.Tx) int {
+	pool.lk.RLock()
+	defer pool.lk.RUnlock()
+
+	return pool.checker.ExpectedSequence(trx)
+}
+
 func (pool *txPool) Size() int {
 	pool.lk.RLock()
 	defer pool.lk.RUnlock()
@@ -155,5 +294,5 @@ func (pool *txPool) Size() int {
 }
 
 func (pool *txPool) Fingerprint() string {
-	return fmt.Sprintf("{%v}", pool.pendings.Size())
+	return fmt.Sprintf("{%v %v}", pool.pendings.Size(), pool.futureSize)
 }
@@ -1,17 +1,26 @@
 package txpool
 
 import (
+	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/message"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/store"
 
 	"github.com/zarbchain/zarb-go/crypto"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
+	"github.com/zarbchain/zarb-go/validator"
 
 	"github.com/zarbchain/zarb-go/logger"
 )
@@ -96,7 +105,7 @@ func TestSendTxValidity(t *testing.T) {
 	trx9.SetSignature(senderPriv.Sign(trx9.SignBytes()))
 	assert.NoError(t, tPool.appendTx(trx9))
 
-	trx10 := tx.NewSendTx(stamp, tSandbox.AccSeq(tAcc1Addr)+2, senderAddr, receiverAddr, 10000000, 10000, "invalid-sequence", &senderPub, nil)
+	trx10 := tx.NewSendTx(stamp, tSandbox.AccSeq(tAcc1Addr), senderAddr, receiverAddr, 10000000, 10000, "invalid-sequence", &senderPub, nil)
 	trx10.SetSignature(senderPriv.Sign(trx10.SignBytes()))
 	assert.Error(t, tPool.appendTx(trx10))
 
@@ -195,3 +204,638 @@ func TestGetAllTransaction(t *testing.T) {
 
 	assert.Equal(t, tPool.Size(), 10)
 }
+
+func TestFutureTx(t *testing.T) {
+	setup(t)
+
+	stamp1 := crypto.GenerateTestHash()
+	stamp2 := crypto.GenerateTestHash()
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp1)
+	tSandbox.AppendStampAndUpdateHeight(101, stamp2)
+	seq := tSandbox.AccSeq(tAcc1Addr)
+
+	trx1 := tx.NewSendTx(stamp1, seq+1, tAcc1Addr, receiverAddr, 1000, 1000, "ok", &tAcc1Pub, nil)
+	trx1.SetSignature(tAcc1Priv.Sign(trx1.SignBytes()))
+	trx2 := tx.NewSendTx(stamp2, seq+2, tAcc1Addr, receiverAddr, 1000, 1000, "ok", &tAcc1Pub, nil)
+	trx2.SetSignature(tAcc1Priv.Sign(trx2.SignBytes()))
+	trx3 := tx.NewSendTx(stamp1, seq+3, tAcc1Addr, receiverAddr, 1000, 1000, "ok", &tAcc1Pub, nil)
+	trx3.SetSignature(tAcc1Priv.Sign(trx3.SignBytes()))
+	trx4 := tx.NewSendTx(stamp2, seq+5, tAcc1Addr, receiverAddr, 1000, 1000, "gap", &tAcc1Pub, nil)
+	trx4.SetSignature(tAcc1Priv.Sign(trx4.SignBytes()))
+	trx5 := tx.NewSendTx(stamp2, seq+3, tAcc1Addr, receiverAddr, 1000, 999, "invalid fee", &tAcc1Pub, nil)
+	trx5.SetSignature(tAcc1Priv.Sign(trx5.SignBytes()))
+
+	// Out of order transactions are queued
+	assert.NoError(t, tPool.appendTx(trx3))
+	assert.NoError(t, tPool.appendTx(trx4))
+	assert.NoError(t, tPool.appendTx(trx2))
+	assert.Error(t, tPool.appendTx(trx2))
+	assert.Equal(t, tPool.futureSize, 3)
+	assert.Equal(t, tPool.Size(), 0)
+	assert.False(t, tPool.HasTx(trx2.ID()))
+
+	// Filling the gap promotes the queued transactions
+	assert.NoError(t, tPool.appendTx(trx1))
+	assert.Equal(t, tPool.Size(), 3)
+	assert.True(t, tPool.HasTx(trx2.ID()))
+	assert.True(t, tPool.HasTx(trx3.ID()))
+	assert.Equal(t, tSandbox.AccSeq(tAcc1Addr), seq+3)
+	assert.Equal(t, tPool.futureSize, 1)
+
+	// Invalid transactions are not queued
+	assert.Error(t, tPool.appendTx(trx5))
+
+	// Expired transactions are removed from the queue
+	stamp3 := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(101+tSandbox.TransactionToLiveInterval(), stamp3)
+	trx6 := tx.NewSendTx(stamp3, seq+6, tAcc1Addr, receiverAddr, 1000, 1000, "ok", &tAcc1Pub, nil)
+	trx6.SetSignature(tAcc1Priv.Sign(trx6.SignBytes()))
+	assert.NoError(t, tPool.appendTx(trx6))
+	assert.Equal(t, tPool.futureSize, 1)
+	assert.False(t, tPool.hasFutureTx(trx4))
+}
+
+func makeFundedAccount() (crypto.Address, crypto.PublicKey, crypto.PrivateKey) {
+	addr, pub, priv := crypto.GenerateTestKeyPair()
+	acc := account.NewAccount(addr, tSandbox.TotalAccount)
+	acc.AddToBalance(10000000000)
+	tSandbox.UpdateAccount(acc)
+	return addr, pub, priv
+}
+
+func TestPrioritizedTransactions(t *testing.T) {
+	setup(t)
+
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	acc2Addr, acc2Pub, acc2Priv := makeFundedAccount()
+
+	seq1 := tSandbox.AccSeq(tAcc1Addr)
+	trx1 := tx.NewSendTx(stamp, seq1+1, tAcc1Addr, receiverAddr, 1000000, 1000, "cheap", &tAcc1Pub, nil)
+	trx1.SetSignature(tAcc1Priv.Sign(trx1.SignBytes()))
+	trx2 := tx.NewSendTx(stamp, seq1+2, tAcc1Addr, receiverAddr, 100000000, 100000, "expensive", &tAcc1Pub, nil)
+	trx2.SetSignature(tAcc1Priv.Sign(trx2.SignBytes()))
+	trx3 := tx.NewSendTx(stamp, tSandbox.AccSeq(acc2Addr)+1, acc2Addr, receiverAddr, 10000000, 10000, "normal", &acc2Pub, nil)
+	trx3.SetSignature(acc2Priv.Sign(trx3.SignBytes()))
+
+	assert.NoError(t, tPool.appendTx(trx1))
+	assert.NoError(t, tPool.appendTx(trx2))
+	assert.NoError(t, tPool.appendTx(trx3))
+
+	// trx2 is the most valuable one, but it should be after trx1
+	trxs := tPool.PrioritizedTransactions()
+	assert.Equal(t, len(trxs), 3)
+	assert.Equal(t, trxs[0].ID(), trx3.ID())
+	assert.Equal(t, trxs[1].ID(), trx1.ID())
+	assert.Equal(t, trxs[2].ID(), trx2.ID())
+
+	// Insertion order is kept
+	all := tPool.AllTransactions()
+	assert.Equal(t, all[0].ID(), trx1.ID())
+}
+
+// setupWithStore sets up the pool with a sandbox on top of a mock store.
+// Unlike the mock sandbox, it can be cleared, so the pool can be rebuilt.
+func setupWithStore(t *testing.T) *store.MockStore {
+	setup(t)
+
+	st := store.NewMockStore()
+	valSet, _ := validator.GenerateTestValidatorSet()
+	sb, err := sandbox.NewSandbox(st, param.MainnetParams(), 0, nil, valSet)
+	require.NoError(t, err)
+	tPool.SetSandbox(sb)
+	return st
+}
+
+func makeFundedAccountInStore(st *store.MockStore) (crypto.Address, crypto.PublicKey, crypto.PrivateKey) {
+	addr, pub, priv := crypto.GenerateTestKeyPair()
+	acc := account.NewAccount(addr, st.TotalAccounts())
+	acc.AddToBalance(10000000000)
+	st.UpdateAccount(acc)
+	return addr, pub, priv
+}
+
+// fillPool fills the pool with send transactions of different signers, the first one is the cheapest
+func fillPool(t *testing.T, st *store.MockStore, receiverAddr crypto.Address) ([]*tx.Tx, []crypto.Address) {
+	trxs := make([]*tx.Tx, tPool.config.MaxSize)
+	signers := make([]crypto.Address, tPool.config.MaxSize)
+	for i := 0; i < len(trxs); i++ {
+		addr, pub, priv := makeFundedAccountInStore(st)
+		amount := int64(i+1) * 1000000
+		trx := tx.NewSendTx(crypto.UndefHash, 1, addr, receiverAddr, amount, amount/1000, "ok", &pub, nil)
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		assert.NoError(t, tPool.appendTx(trx))
+		trxs[i] = trx
+		signers[i] = addr
+	}
+	assert.Equal(t, tPool.Size(), tPool.config.MaxSize)
+	return trxs, signers
+}
+
+func TestEvictCheapestTransaction(t *testing.T) {
+	st := setupWithStore(t)
+
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	trxs, signers := fillPool(t, st, receiverAddr)
+
+	addr, pub, priv := makeFundedAccountInStore(st)
+	cheap := tx.NewSendTx(crypto.UndefHash, 1, addr, receiverAddr, 1000000, 1000, "ok", &pub, nil)
+	cheap.SetSignature(priv.Sign(cheap.SignBytes()))
+	assert.Error(t, tPool.appendTx(cheap))
+	assert.Equal(t, tPool.sandbox.Account(addr).Sequence(), 0)
+
+	expensive := tx.NewSendTx(crypto.UndefHash, 1, addr, receiverAddr, 100000000, 100000, "ok", &pub, nil)
+	expensive.SetSignature(priv.Sign(expensive.SignBytes()))
+	assert.NoError(t, tPool.appendTx(expensive))
+	assert.Equal(t, tPool.Size(), tPool.config.MaxSize)
+	assert.True(t, tPool.HasTx(expensive.ID()))
+	assert.False(t, tPool.HasTx(trxs[0].ID()))
+	assert.True(t, tPool.HasTx(trxs[1].ID()))
+
+	// The evicted transaction has no effect on the pool sandbox
+	assert.Equal(t, tPool.sandbox.Account(signers[0]).Sequence(), 0)
+	assert.Equal(t, tPool.sandbox.Account(signers[0]).Balance(), int64(10000000000))
+	assert.Equal(t, tPool.sandbox.Account(receiverAddr).Balance(), int64(154000000))
+}
+
+func TestSystemTxInFullPool(t *testing.T) {
+	st := setupWithStore(t)
+
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	treasury := account.NewAccount(crypto.TreasuryAddress, st.TotalAccounts())
+	treasury.AddToBalance(21 * 1e14)
+	st.UpdateAccount(treasury)
+	trxs, _ := fillPool(t, st, receiverAddr)
+
+	// Subsidy transaction pays no fee, but it evicts the cheapest transaction
+	subsidy := tx.NewSubsidyTx(1, crypto.UndefHash, 1, receiverAddr, 1000, "subsidy")
+	assert.NoError(t, tPool.appendTx(subsidy))
+	assert.Equal(t, tPool.Size(), tPool.config.MaxSize)
+	assert.True(t, tPool.HasTx(subsidy.ID()))
+	assert.False(t, tPool.HasTx(trxs[0].ID()))
+
+	// Unbond transaction pays no fee too
+	valAddr, valPub, valPriv := crypto.GenerateTestKeyPair()
+	val := validator.NewValidator(valPub, st.TotalValidators(), 0)
+	st.UpdateValidator(val)
+	unbond := tx.NewUnbondTx(crypto.UndefHash, val.Sequence()+1, valAddr, "unbond", &valPub, nil)
+	unbond.SetSignature(valPriv.Sign(unbond.SignBytes()))
+	assert.NoError(t, tPool.appendTx(unbond))
+	assert.True(t, tPool.HasTx(unbond.ID()))
+	assert.False(t, tPool.HasTx(trxs[1].ID()))
+
+	// System transactions are never evicted
+	addr, pub, priv := makeFundedAccountInStore(st)
+	expensive := tx.NewSendTx(crypto.UndefHash, 1, addr, receiverAddr, 100000000, 100000, "ok", &pub, nil)
+	expensive.SetSignature(priv.Sign(expensive.SignBytes()))
+	assert.NoError(t, tPool.appendTx(expensive))
+	assert.True(t, tPool.HasTx(subsidy.ID()))
+	assert.True(t, tPool.HasTx(unbond.ID()))
+	assert.False(t, tPool.HasTx(trxs[2].ID()))
+}
+
+func TestBlockTxInFullPool(t *testing.T) {
+	st := setupWithStore(t)
+
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	trxs, signers := fillPool(t, st, receiverAddr)
+
+	addr, pub, priv := makeFundedAccountInStore(st)
+	cheap := tx.NewSendTx(crypto.UndefHash, 1, addr, receiverAddr, 1000000, 1000, "block", &pub, nil)
+	cheap.SetSignature(priv.Sign(cheap.SignBytes()))
+	assert.Error(t, tPool.appendTx(cheap))
+
+	// The transaction of a block is accepted, even if it is the cheapest one
+	go func() {
+		<-tCh
+		assert.NoError(t, tPool.AppendTxFrom(cheap, peer.ID("peer-1")))
+	}()
+	trx := tPool.PendingTx(cheap.ID())
+	require.NotNil(t, trx)
+	assert.True(t, tPool.HasTx(cheap.ID()))
+	assert.False(t, tPool.HasTx(trxs[0].ID()))
+	assert.Equal(t, tPool.Size(), tPool.config.MaxSize)
+	assert.Equal(t, tPool.sandbox.Account(signers[0]).Sequence(), 0)
+	assert.Equal(t, tPool.sandbox.Account(addr).Sequence(), 1)
+}
+
+func TestReplaceByFee(t *testing.T) {
+	setup(t)
+
+	ch := make(chan *message.Message, 10)
+	p, _ := NewTxPool(TestConfig(), ch)
+	pool := p.(*txPool)
+	st := store.NewMockStore()
+	sb, err := sandbox.NewSandbox(st, param.MainnetParams(), 0, nil, nil)
+	assert.NoError(t, err)
+	acc1 := account.NewAccount(tAcc1Addr, 0)
+	acc1.AddToBalance(10000000000)
+	st.UpdateAccount(acc1)
+	pool.SetSandbox(sb)
+
+	stamp := crypto.UndefHash
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+
+	trx1 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "first", &tAcc1Pub, nil)
+	trx1.SetSignature(tAcc1Priv.Sign(trx1.SignBytes()))
+	trx2 := tx.NewSendTx(stamp, 2, tAcc1Addr, receiverAddr, 1000000, 1000, "second", &tAcc1Pub, nil)
+	trx2.SetSignature(tAcc1Priv.Sign(trx2.SignBytes()))
+	assert.NoError(t, pool.AppendTx(trx1))
+	assert.NoError(t, pool.AppendTx(trx2))
+	assert.Equal(t, sb.Account(tAcc1Addr).Balance(), int64(10000000000-2*1001000))
+
+	// Same fee, rejected
+	trx3 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "same-fee", &tAcc1Pub, nil)
+	trx3.SetSignature(tAcc1Priv.Sign(trx3.SignBytes()))
+	assert.Error(t, pool.AppendTx(trx3))
+
+	// Higher fee but invalid, rejected and the pool is unchanged
+	trx4 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 10000000000, 10000000, "invalid", &tAcc1Pub, nil)
+	trx4.SetSignature(tAcc1Priv.Sign(trx4.SignBytes()))
+	assert.Error(t, pool.AppendTx(trx4))
+	assert.True(t, pool.HasTx(trx1.ID()))
+	assert.True(t, pool.HasTx(trx2.ID()))
+	assert.Equal(t, sb.Account(tAcc1Addr).Balance(), int64(10000000000-2*1001000))
+
+	// Higher fee, replaced and rebroadcasted
+	trx5 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 2000000, 2000, "replaced", &tAcc1Pub, nil)
+	trx5.SetSignature(tAcc1Priv.Sign(trx5.SignBytes()))
+	assert.NoError(t, pool.AppendTx(trx5))
+	assert.False(t, pool.HasTx(trx1.ID()))
+	assert.True(t, pool.HasTx(trx2.ID()))
+	assert.True(t, pool.HasTx(trx5.ID()))
+	assert.Equal(t, pool.AllTransactions()[0].ID(), trx5.ID())
+	assert.Equal(t, sb.Account(tAcc1Addr).Balance(), int64(10000000000-2002000-1001000))
+	assert.Equal(t, sb.Account(tAcc1Addr).Sequence(), 2)
+
+	msg := <-ch
+	assert.Equal(t, msg.Payload.(*message.TxsPayload).Txs[0].ID(), trx5.ID())
+
+	// Fee should be bumped at least by the minimum fee bump
+	trx6 := tx.NewSendTx(stamp, 2, tAcc1Addr, receiverAddr, 1050000, 1050, "low-bump", &tAcc1Pub, nil)
+	trx6.SetSignature(tAcc1Priv.Sign(trx6.SignBytes()))
+	assert.Error(t, pool.AppendTx(trx6))
+
+	// Replaced by a peer, rebroadcasted too
+	trx7 := tx.NewSendTx(stamp, 2, tAcc1Addr, receiverAddr, 1100000, 1100, "relayed", &tAcc1Pub, nil)
+	trx7.SetSignature(tAcc1Priv.Sign(trx7.SignBytes()))
+	require.NoError(t, pool.AppendTxFrom(trx7, peer.ID("peer-1")))
+	msg = <-ch
+	assert.Equal(t, msg.Payload.(*message.TxsPayload).Txs[0].ID(), trx7.ID())
+
+	// Signer can't replace more transactions in this block
+	trx8 := tx.NewSendTx(stamp, 2, tAcc1Addr, receiverAddr, 2000000, 2000, "too-many", &tAcc1Pub, nil)
+	trx8.SetSignature(tAcc1Priv.Sign(trx8.SignBytes()))
+	err = pool.AppendTx(trx8)
+	assert.Equal(t, errors.Code(err), errors.ErrTxPoolLimit)
+
+	pool.Recheck()
+	assert.NoError(t, pool.AppendTx(trx8))
+	<-ch
+}
+
+func TestReplaceWithoutBlocking(t *testing.T) {
+	setup(t)
+
+	// No one drains the broadcast channel
+	ch := make(chan *message.Message)
+	p, _ := NewTxPool(TestConfig(), ch)
+	pool := p.(*txPool)
+	pool.config.MaxReplacementsPerSigner = 0
+	st := store.NewMockStore()
+	sb, err := sandbox.NewSandbox(st, param.MainnetParams(), 0, nil, nil)
+	require.NoError(t, err)
+	acc1 := account.NewAccount(tAcc1Addr, 0)
+	acc1.AddToBalance(10000000000)
+	st.UpdateAccount(acc1)
+	pool.SetSandbox(sb)
+	stamp := crypto.UndefHash
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+
+	fee := int64(1000)
+	for i := 0; i < 5; i++ {
+		trx := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, fee*1000, fee, "replacing", &tAcc1Pub, nil)
+		trx.SetSignature(tAcc1Priv.Sign(trx.SignBytes()))
+		require.NoError(t, pool.AppendTx(trx))
+		<-pool.appendTxCh
+		fee *= 2
+	}
+	for i := 0; i < 4; i++ {
+		<-ch
+	}
+}
+
+func TestBlockTxReplacesPendingTx(t *testing.T) {
+	setup(t)
+
+	ch := make(chan *message.Message, 10)
+	p, _ := NewTxPool(TestConfig(), ch)
+	pool := p.(*txPool)
+	st := store.NewMockStore()
+	sb, err := sandbox.NewSandbox(st, param.MainnetParams(), 0, nil, nil)
+	require.NoError(t, err)
+	acc1 := account.NewAccount(tAcc1Addr, 0)
+	acc1.AddToBalance(10000000000)
+	st.UpdateAccount(acc1)
+	pool.SetSandbox(sb)
+
+	stamp := crypto.UndefHash
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+
+	pending := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "pending", &tAcc1Pub, nil)
+	pending.SetSignature(tAcc1Priv.Sign(pending.SignBytes()))
+	blockTx := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "block", &tAcc1Pub, nil)
+	blockTx.SetSignature(tAcc1Priv.Sign(blockTx.SignBytes()))
+	require.NoError(t, pool.AppendTxFrom(pending, peer.ID("peer-1")))
+
+	// Not requested, same fee is rejected
+	assert.Error(t, pool.AppendTxFrom(blockTx, peer.ID("peer-2")))
+
+	go func() {
+		msg := <-ch
+		assert.Equal(t, msg.Payload.(*message.TxsReqPayload).IDs[0], blockTx.ID())
+		assert.NoError(t, pool.AppendTxFrom(blockTx, peer.ID("peer-2")))
+	}()
+	trx := pool.PendingTx(blockTx.ID())
+	require.NotNil(t, trx)
+	assert.Equal(t, trx.ID(), blockTx.ID())
+	assert.False(t, pool.HasTx(pending.ID()))
+	assert.True(t, pool.HasTx(blockTx.ID()))
+	assert.Equal(t, sb.Account(tAcc1Addr).Balance(), int64(10000000000-1001000))
+	assert.Empty(t, pool.requested)
+}
+
+func TestRecheck(t *testing.T) {
+	setup(t)
+
+	params := param.MainnetParams()
+	st := store.NewMockStore()
+	sb, err := sandbox.NewSandbox(st, params, 0, nil, nil)
+	assert.NoError(t, err)
+	acc1 := account.NewAccount(tAcc1Addr, 0)
+	acc1.AddToBalance(10000000000)
+	st.UpdateAccount(acc1)
+	acc2Addr, acc2Pub, acc2Priv := crypto.GenerateTestKeyPair()
+	acc2 := account.NewAccount(acc2Addr, 1)
+	acc2.AddToBalance(10000000000)
+	st.UpdateAccount(acc2)
+	tPool.SetSandbox(sb)
+	evictions := tPool.SubscribeEvictions()
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+
+	trx1 := tx.NewSendTx(crypto.UndefHash, 1, acc2Addr, receiverAddr, 1000000, 1000, "expiring", &acc2Pub, nil)
+	trx1.SetSignature(acc2Priv.Sign(trx1.SignBytes()))
+	assert.NoError(t, tPool.appendTx(trx1))
+
+	stamp := crypto.GenerateTestHash()
+	for i := 1; i <= params.TransactionToLiveInterval; i++ {
+		stamp = crypto.GenerateTestHash()
+		sb.AppendNewBlock(stamp, i)
+	}
+
+	trx2 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "conflicted", &tAcc1Pub, nil)
+	trx2.SetSignature(tAcc1Priv.Sign(trx2.SignBytes()))
+	trx3 := tx.NewSendTx(stamp, 2, tAcc1Addr, receiverAddr, 1000000, 1000, "ok", &tAcc1Pub, nil)
+	trx3.SetSignature(tAcc1Priv.Sign(trx3.SignBytes()))
+	assert.NoError(t, tPool.appendTx(trx2))
+	assert.NoError(t, tPool.appendTx(trx3))
+
+	// Another transaction with the same sequence is committed
+	acc1.IncSequence()
+	st.UpdateAccount(acc1)
+
+	tPool.Recheck()
+	assert.False(t, tPool.HasTx(trx1.ID()))
+	assert.False(t, tPool.HasTx(trx2.ID()))
+	assert.True(t, tPool.HasTx(trx3.ID()))
+	assert.Equal(t, sb.Account(tAcc1Addr).Sequence(), 2)
+
+	e := <-evictions
+	assert.Equal(t, e.Tx.ID(), trx1.ID())
+	assert.Equal(t, e.Reason, EvictionExpired)
+	e = <-evictions
+	assert.Equal(t, e.Tx.ID(), trx2.ID())
+	assert.Equal(t, e.Reason, EvictionInvalid)
+	assert.Error(t, e.Error)
+	assert.Empty(t, evictions)
+}
+
+func TestJournal(t *testing.T) {
+	setup(t)
+
+	path := util.TempFilePath()
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	assert.NoError(t, tPool.OpenJournal(path))
+
+	trx1 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "pending", &tAcc1Pub, nil)
+	trx1.SetSignature(tAcc1Priv.Sign(trx1.SignBytes()))
+	trx2 := tx.NewSendTx(stamp, 3, tAcc1Addr, receiverAddr, 1000000, 1000, "future", &tAcc1Pub, nil)
+	trx2.SetSignature(tAcc1Priv.Sign(trx2.SignBytes()))
+	trx3 := tx.NewSubsidyTx(1, stamp, 1, receiverAddr, 1000, "subsidy")
+	assert.NoError(t, tPool.AppendTx(trx1))
+	assert.NoError(t, tPool.AppendTx(trx2))
+	tPool.journalTx(trx3)
+	assert.Equal(t, tPool.journal.count, 2)
+	tPool.CloseJournal()
+
+	// Unfinished write at the end of the journal
+	f, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	_, _ = f.Write([]byte{0, 0, 1})
+	f.Close()
+
+	restart := func() *txPool {
+		p, _ := NewTxPool(TestConfig(), tCh)
+		sb := sandbox.NewMockSandbox()
+		acc := account.NewAccount(tAcc1Addr, 0)
+		acc.AddToBalance(10000000000)
+		sb.UpdateAccount(acc)
+		sb.AppendStampAndUpdateHeight(100, stamp)
+		p.SetSandbox(sb)
+		return p.(*txPool)
+	}
+
+	pool := restart()
+	assert.NoError(t, pool.OpenJournal(path))
+	assert.True(t, pool.HasTx(trx1.ID()))
+	assert.True(t, pool.hasFutureTx(trx2))
+	assert.Equal(t, pool.journal.count, 2)
+
+	// Compacting the journal removes the stale records
+	pool.RemoveTx(trx1.ID())
+	pool.lastCompact = time.Time{}
+	pool.Recheck()
+	pool.CloseJournal()
+
+	pool = restart()
+	assert.NoError(t, pool.OpenJournal(path))
+	assert.False(t, pool.HasTx(trx1.ID()))
+	assert.True(t, pool.hasFutureTx(trx2))
+	assert.Equal(t, pool.journal.count, 1)
+	pool.CloseJournal()
+}
+
+func TestCorruptedJournal(t *testing.T) {
+	setup(t)
+
+	path := util.TempFilePath()
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	assert.NoError(t, tPool.OpenJournal(path))
+
+	trx1 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "pending", &tAcc1Pub, nil)
+	trx1.SetSignature(tAcc1Priv.Sign(trx1.SignBytes()))
+	assert.NoError(t, tPool.AppendTx(trx1))
+	tPool.CloseJournal()
+
+	// A record with a huge length
+	f, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	_, _ = f.Write([]byte{0xff, 0xff, 0xff, 0xff, 1, 2, 3})
+	f.Close()
+
+	p, _ := NewTxPool(TestConfig(), tCh)
+	sb := sandbox.NewMockSandbox()
+	acc := account.NewAccount(tAcc1Addr, 0)
+	acc.AddToBalance(10000000000)
+	sb.UpdateAccount(acc)
+	sb.AppendStampAndUpdateHeight(100, stamp)
+	p.SetSandbox(sb)
+	pool := p.(*txPool)
+	assert.NoError(t, pool.OpenJournal(path))
+	assert.True(t, pool.HasTx(trx1.ID()))
+	assert.Equal(t, pool.journal.count, 1)
+	pool.CloseJournal()
+}
+
+func TestJournalFuturesOrder(t *testing.T) {
+	setup(t)
+
+	path := util.TempFilePath()
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	assert.NoError(t, tPool.OpenJournal(path))
+
+	for i := 0; i < 3; i++ {
+		addr, pub, priv := makeFundedAccount()
+		for seq := 4; seq >= 2; seq-- {
+			trx := tx.NewSendTx(stamp, seq, addr, receiverAddr, 1000000, 1000, "future", &pub, nil)
+			trx.SetSignature(priv.Sign(trx.SignBytes()))
+			assert.NoError(t, tPool.appendTx(trx))
+		}
+	}
+	assert.Equal(t, tPool.futureSize, 9)
+
+	assert.NoError(t, tPool.compactJournal())
+	data1, _ := os.ReadFile(path)
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, tPool.compactJournal())
+		data2, _ := os.ReadFile(path)
+		assert.Equal(t, data1, data2)
+	}
+	tPool.CloseJournal()
+}
+
+func TestSignerLimit(t *testing.T) {
+	setup(t)
+
+	tPool.config.MaxPendingPerSigner = 2
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+
+	trx1 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "ok", &tAcc1Pub, nil)
+	trx1.SetSignature(tAcc1Priv.Sign(trx1.SignBytes()))
+	trx2 := tx.NewSendTx(stamp, 2, tAcc1Addr, receiverAddr, 1000000, 1000, "ok", &tAcc1Pub, nil)
+	trx2.SetSignature(tAcc1Priv.Sign(trx2.SignBytes()))
+	trx3 := tx.NewSendTx(stamp, 3, tAcc1Addr, receiverAddr, 1000000, 1000, "over-quota", &tAcc1Pub, nil)
+	trx3.SetSignature(tAcc1Priv.Sign(trx3.SignBytes()))
+	trx4 := tx.NewSendTx(stamp, 3, tAcc1Addr, receiverAddr, 2000000, 2000, "bumped", &tAcc1Pub, nil)
+	trx4.SetSignature(tAcc1Priv.Sign(trx4.SignBytes()))
+
+	assert.NoError(t, tPool.appendTx(trx1))
+	assert.NoError(t, tPool.appendTx(trx2))
+	err := tPool.appendTx(trx3)
+	assert.Equal(t, errors.Code(err), errors.ErrTxPoolLimit)
+	assert.NoError(t, tPool.appendTx(trx4))
+}
+
+func TestPeerLimit(t *testing.T) {
+	setup(t)
+
+	tPool.config.MaxPendingPerPeer = 1
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	peer1 := peer.ID("peer-1")
+	peer2 := peer.ID("peer-2")
+
+	makeTx := func() *tx.Tx {
+		addr, pub, priv := makeFundedAccount()
+		trx := tx.NewSendTx(stamp, tSandbox.AccSeq(addr)+1, addr, receiverAddr, 1000000, 1000, "relayed", &pub, nil)
+		trx.SetSignature(priv.Sign(trx.SignBytes()))
+		return trx
+	}
+
+	trx1 := makeTx()
+	trx2 := makeTx()
+	assert.NoError(t, tPool.AppendTxFrom(trx1, peer1))
+	err := tPool.AppendTxFrom(trx2, peer1)
+	assert.Equal(t, errors.Code(err), errors.ErrTxPoolLimit)
+	assert.NoError(t, tPool.AppendTxFrom(trx2, peer2))
+
+	// Once the relayed transaction is removed, the peer can relay again
+	tPool.RemoveTx(trx1.ID())
+	assert.NoError(t, tPool.AppendTxFrom(makeTx(), peer1))
+}
+
+func TestBlockTxIgnoresLimits(t *testing.T) {
+	setup(t)
+
+	tPool.config.MaxPendingPerPeer = 1
+	tPool.config.MaxPendingPerSigner = 1
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+	peer1 := peer.ID("peer-1")
+
+	trx1 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "ok", &tAcc1Pub, nil)
+	trx1.SetSignature(tAcc1Priv.Sign(trx1.SignBytes()))
+	trx2 := tx.NewSendTx(stamp, 2, tAcc1Addr, receiverAddr, 1000000, 1000, "block", &tAcc1Pub, nil)
+	trx2.SetSignature(tAcc1Priv.Sign(trx2.SignBytes()))
+
+	assert.NoError(t, tPool.AppendTxFrom(trx1, peer1))
+	err := tPool.AppendTxFrom(trx2, peer1)
+	assert.Equal(t, errors.Code(err), errors.ErrTxPoolLimit)
+
+	go func() {
+		<-tCh
+		assert.NoError(t, tPool.AppendTxFrom(trx2, peer1))
+	}()
+	trx := tPool.PendingTx(trx2.ID())
+	require.NotNil(t, trx)
+	assert.True(t, tPool.HasTx(trx2.ID()))
+}
+
+func TestExpectedSequence(t *testing.T) {
+	setup(t)
+
+	stamp := crypto.GenerateTestHash()
+	tSandbox.AppendStampAndUpdateHeight(100, stamp)
+	receiverAddr, _, _ := crypto.GenerateTestKeyPair()
+
+	trx1 := tx.NewSendTx(stamp, 1, tAcc1Addr, receiverAddr, 1000000, 1000, "ok", &tAcc1Pub, nil)
+	trx1.SetSignature(tAcc1Priv.Sign(trx1.SignBytes()))
+	assert.Equal(t, tPool.ExpectedSequence(trx1), 1)
+
+	assert.NoError(t, tPool.AppendTx(trx1))
+	assert.Equal(t, tPool.ExpectedSequence(trx1), 2)
+
+	unknownAddr, unknownPub, _ := crypto.GenerateTestKeyPair()
+	trx2 := tx.NewSendTx(stamp, 1, unknownAddr, receiverAddr, 1000000, 1000, "unknown", &unknownPub, nil)
+	assert.Equal(t, tPool.ExpectedSequence(trx2), 0)
+}
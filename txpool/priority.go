@@ -0,0 +1,180 @@
+package txpool
+
+import (
+	"container/heap"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/libs/linkedmap"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+// feePerByte is the priority of a transaction in the pool
+func feePerByte(trx *tx.Tx) float64 {
+	bs, err := trx.Encode()
+	if err != nil || len(bs) == 0 {
+		return 0
+	}
+	return float64(trx.Fee()) / float64(len(bs))
+}
+
+// isSystemTx returns true for the transactions that pay no fee, like subsidy, sortition and unbond transactions.
+// Executors reject zero fee for other payloads, so they can't be used to fill the pool.
+func isSystemTx(trx *tx.Tx) bool {
+	return trx.Fee() == 0
+}
+
+// appendPendingTx executes the transaction and adds it to the pending list.
+// If the pool is full, the cheapest transaction will be evicted, unless the new transaction is the cheapest one.
+// System transactions and forced transactions, like transactions of a block, are not priced,
+// they always evict the cheapest transaction.
+func (pool *txPool) appendPendingTx(trx *tx.Tx, force bool) error {
+	priority := feePerByte(trx)
+
+	if !pool.pendings.Full() {
+		if err := pool.checker.Execute(trx); err != nil {
+			return err
+		}
+		pool.pendings.PushBack(trx.ID(), trx)
+		pool.priorities[trx.ID()] = priority
+		return nil
+	}
+
+	evicting := pool.evictionCandidate(trx.Payload().Signer(), force)
+	if evicting == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Pool is full")
+	}
+	if !force && !isSystemTx(trx) && pool.priorities[evicting.ID()] >= priority {
+		return errors.Errorf(errors.ErrInvalidTx, "Pool is full and the fee is too low")
+	}
+	if err := trx.SanityCheck(); err != nil {
+		return err
+	}
+
+	// The evicted transaction has changed the pool sandbox, so the pool should be rebuilt without it
+	olds := make([]*tx.Tx, 0, pool.pendings.Size())
+	news := make([]*tx.Tx, 0, pool.pendings.Size())
+	for e := pool.pendings.FirstElement(); e != nil; e = e.Next() {
+		pending := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+		olds = append(olds, pending)
+		if !pending.ID().EqualsTo(evicting.ID()) {
+			news = append(news, pending)
+		}
+	}
+	news = append(news, trx)
+
+	if err := pool.rebuildWith(olds, news, trx); err != nil {
+		return err
+	}
+
+	pool.publishEviction(evicting, EvictionUnderpriced,
+		errors.Errorf(errors.ErrInvalidTx, "Evicted by a transaction with higher fee"))
+
+	return nil
+}
+
+func (pool *txPool) removePendingTx(id crypto.Hash) {
+	pool.pendings.Remove(id)
+	delete(pool.priorities, id)
+}
+
+// evictionCandidate returns the cheapest transaction that no other transaction depends on it.
+// Transactions of the given signer are excluded. System transactions are excluded too,
+// unless there is no other transaction and the system transactions are allowed.
+func (pool *txPool) evictionCandidate(exclude crypto.Address, allowSystemTx bool) *tx.Tx {
+	lasts := make(map[crypto.Address]*tx.Tx)
+	for e := pool.pendings.FirstElement(); e != nil; e = e.Next() {
+		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+		lasts[trx.Payload().Signer()] = trx
+	}
+	delete(lasts, exclude)
+
+	var cheapest, system *tx.Tx
+	for _, trx := range lasts {
+		if isSystemTx(trx) {
+			system = trx
+			continue
+		}
+		if cheapest == nil ||
+			pool.priorities[trx.ID()] < pool.priorities[cheapest.ID()] {
+			cheapest = trx
+		}
+	}
+	if cheapest == nil && allowSystemTx {
+		return system
+	}
+	return cheapest
+}
+
+type priorityItem struct {
+	trxs     []*tx.Tx
+	priority float64
+	order    int
+}
+
+// priorityQueue keeps the first transaction of each signer, ordered by fee per byte
+type priorityQueue []*priorityItem
+
+func (pq priorityQueue) Len() int { return len(pq) }
+func (pq priorityQueue) Less(i, j int) bool {
+	if pq[i].priority == pq[j].priority {
+		return pq[i].order < pq[j].order
+	}
+	return pq[i].priority > pq[j].priority
+}
+func (pq priorityQueue) Swap(i, j int)       { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *priorityQueue) Push(x interface{}) { *pq = append(*pq, x.(*priorityItem)) }
+func (pq *priorityQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[:n-1]
+	return item
+}
+
+// PrioritizedTransactions returns the pending transactions ordered by fee per byte.
+// Transactions of each signer keep their sequence order.
+func (pool *txPool) PrioritizedTransactions() []*tx.Tx {
+	pool.lk.RLock()
+	defer pool.lk.RUnlock()
+
+	orders := make(map[crypto.Hash]int)
+	signers := make(map[crypto.Address]*priorityItem)
+	pq := make(priorityQueue, 0)
+	order := 0
+	for e := pool.pendings.FirstElement(); e != nil; e = e.Next() {
+		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+		orders[trx.ID()] = order
+		order++
+
+		signer := trx.Payload().Signer()
+		item, ok := signers[signer]
+		if !ok {
+			item = &priorityItem{}
+			signers[signer] = item
+			pq = append(pq, item)
+		}
+		item.trxs = append(item.trxs, trx)
+	}
+	for _, item := range pq {
+		item.priority = pool.priorities[item.trxs[0].ID()]
+		item.order = orders[item.trxs[0].ID()]
+	}
+	heap.Init(&pq)
+
+	trxs := make([]*tx.Tx, 0, pool.pendings.Size())
+	for pq.Len() > 0 {
+		item := pq[0]
+		trxs = append(trxs, item.trxs[0])
+		item.trxs = item.trxs[1:]
+		if len(item.trxs) == 0 {
+			heap.Pop(&pq)
+		} else {
+			item.priority = pool.priorities[item.trxs[0].ID()]
+			item.order = orders[item.trxs[0].ID()]
+			heap.Fix(&pq, 0)
+		}
+	}
+
+	return trxs
+}
@@ -0,0 +1,116 @@
+package txpool
+
+import (
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/execution"
+	"github.com/zarbchain/zarb-go/libs/linkedmap"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+// replaceableTx returns the pending transaction with the same signer and sequence
+func (pool *txPool) replaceableTx(trx *tx.Tx) *tx.Tx {
+	signer := trx.Payload().Signer()
+	for e := pool.pendings.FirstElement(); e != nil; e = e.Next() {
+		pending := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+		if pending.Sequence() == trx.Sequence() &&
+			pending.Payload().Signer().EqualsTo(signer) &&
+			execution.IsAccountTx(pending) == execution.IsAccountTx(trx) {
+			return pending
+		}
+	}
+	return nil
+}
+
+// replaceTx replaces the pending transaction with a new one that pays higher fee, at least by the fee bump.
+// The pool sandbox is rebuilt, so the old transaction has no effect on it anymore.
+// Rebuilding the pool is expensive, therefore each signer can replace a few transactions per block.
+func (pool *txPool) replaceTx(old, trx *tx.Tx) error {
+	required := float64(old.Fee()) * (1 + float64(pool.config.MinFeeBump)/100)
+	if trx.Fee() <= old.Fee() || float64(trx.Fee()) < required {
+		return errors.Errorf(errors.ErrInvalidTx, "Replacement transaction should pay higher fee. Pending fee: %v, required: %.0f, got: %v", old.Fee(), required, trx.Fee())
+	}
+	signer := trx.Payload().Signer()
+	if pool.config.MaxReplacementsPerSigner > 0 &&
+		pool.replaced[signer] >= pool.config.MaxReplacementsPerSigner {
+		return errors.Errorf(errors.ErrTxPoolLimit, "Signer has replaced too many transactions in this block")
+	}
+	if err := pool.swapTx(old, trx,
+		errors.Errorf(errors.ErrInvalidTx, "Replaced by a transaction with higher fee")); err != nil {
+		return err
+	}
+
+	pool.replaced[signer]++
+	return nil
+}
+
+// swapTx puts the new transaction in place of the pending one and rebuilds the pool sandbox.
+// The old transaction is evicted with the given reason.
+func (pool *txPool) swapTx(old, trx *tx.Tx, reason error) error {
+	if err := trx.SanityCheck(); err != nil {
+		return err
+	}
+
+	olds := make([]*tx.Tx, 0, pool.pendings.Size())
+	news := make([]*tx.Tx, 0, pool.pendings.Size())
+	for e := pool.pendings.FirstElement(); e != nil; e = e.Next() {
+		pending := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+		olds = append(olds, pending)
+		if pending.ID().EqualsTo(old.ID()) {
+			news = append(news, trx)
+		} else {
+			news = append(news, pending)
+		}
+	}
+
+	if err := pool.rebuildWith(olds, news, trx); err != nil {
+		return err
+	}
+
+	pool.logger.Info("Transaction is replaced", "old", old, "new", trx)
+	pool.publishEviction(old, EvictionReplaced, reason)
+
+	return nil
+}
+
+// rebuildWith rebuilds the pool with the new list of the pending transactions.
+// If the given transaction fails, the old list is restored.
+// Otherwise the dropped transactions are evicted.
+func (pool *txPool) rebuildWith(olds, news []*tx.Tx, trx *tx.Tx) error {
+	failed := pool.rebuild(news)
+	if err, ok := failed[trx.ID()]; ok {
+		pool.rebuild(olds)
+		return err
+	}
+
+	for _, pending := range news {
+		if err, ok := failed[pending.ID()]; ok {
+			pool.publishEviction(pending, pool.evictionReason(pending), err)
+		}
+	}
+	return nil
+}
+
+// rebuild clears the pool sandbox and executes the transactions again.
+// Priorities of the queued transactions are kept.
+// Invalid transactions are dropped from the pool and returned with their reasons.
+func (pool *txPool) rebuild(trxs []*tx.Tx) map[crypto.Hash]error {
+	pool.sandbox.Clear()
+	for e := pool.pendings.FirstElement(); e != nil; e = e.Next() {
+		delete(pool.priorities, e.Value.(*linkedmap.Pair).First.(crypto.Hash))
+	}
+	pool.pendings.Clear()
+
+	failed := make(map[crypto.Hash]error)
+	for _, trx := range trxs {
+		if err := pool.checker.Execute(trx); err != nil {
+			pool.logger.Debug("Transaction dropped", "tx", trx, "err", err)
+			failed[trx.ID()] = err
+			continue
+		}
+		pool.pendings.PushBack(trx.ID(), trx)
+		pool.priorities[trx.ID()] = feePerByte(trx)
+	}
+
+	return failed
+}
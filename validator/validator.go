@@ -20,6 +20,7 @@ type validatorData struct {
 	Stake           int64            `cbor:"4,keyasint"`
 	BondingHeight   int              `cbor:"5,keyasint"`
 	UnbondingHeight int              `cbor:"6,keyasint"`
+	JailedHeight    int              `cbor:"7,keyasint,omitempty"`
 }
 
 func NewValidator(publicKey crypto.PublicKey, number, bondingHeight int) *Validator {
@@ -40,6 +41,7 @@ func (val *Validator) Sequence() int               { return val.data.Sequence }
 func (val *Validator) Stake() int64                { return val.data.Stake }
 func (val *Validator) BondingHeight() int          { return val.data.BondingHeight }
 func (val *Validator) UnbondingHeight() int        { return val.data.UnbondingHeight }
+func (val *Validator) JailedHeight() int           { return val.data.JailedHeight }
 
 func (val Validator) Power() int64 {
 	// Viva democracy, everybody should be treated equally
@@ -51,6 +53,21 @@ func (val *Validator) WithdrawStake() {
 	val.data.Stake = 0
 }
 
+// UpdateUnbondingHeight marks the validator as unbonded at the given height.
+func (val *Validator) UpdateUnbondingHeight(height int) {
+	val.data.UnbondingHeight = height
+}
+
+// Jail marks the validator as jailed at the given height, for missing too many signatures.
+func (val *Validator) Jail(height int) {
+	val.data.JailedHeight = height
+}
+
+// IsJailed checks if the validator is still jailed at the given height
+func (val *Validator) IsJailed(height int, jailPeriod int) bool {
+	return val.data.JailedHeight > 0 && height < val.data.JailedHeight+jailPeriod
+}
+
 // AddToStake increases the stake by bonding transaction
 func (val *Validator) AddToStake(amt int64) {
 	val.data.Stake += amt
@@ -70,7 +87,7 @@ func (val *Validator) Hash() crypto.Hash {
 	return crypto.HashH(bs)
 }
 
-///---- Serialization methods
+// /---- Serialization methods
 func (val Validator) Encode() ([]byte, error) {
 	return cbor.Marshal(val.data)
 }
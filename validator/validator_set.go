@@ -13,6 +13,7 @@ type ValidatorSetReader interface {
 	MaximumPower() int
 	Power() int
 	Validators() []crypto.Address
+	CopyValidators() []*Validator
 	Validator(addr crypto.Address) *Validator
 	Contains(addr crypto.Address) bool
 	Proposer(round int) *Validator
@@ -57,7 +58,7 @@ func (set *ValidatorSet) Power() int {
 	return len(set.validators)
 }
 
-func (set *ValidatorSet) MoveToNextHeight(lastRound int, joined []*Validator) error {
+func (set *ValidatorSet) MoveToNextHeight(lastRound int, joined []*Validator, left []*Validator) error {
 	set.lk.Lock()
 	defer set.lk.Unlock()
 
@@ -67,20 +68,47 @@ func (set *ValidatorSet) MoveToNextHeight(lastRound int, joined []*Validator) er
 		}
 	}
 
+	for _, v := range left {
+		if !set.contains(v.Address()) {
+			return errors.Errorf(errors.ErrGeneric, "Validator is not in the set")
+		}
+	}
+
 	if len(joined) > (set.MaximumPower() / 3) {
 		return errors.Errorf(errors.ErrGeneric, "In each height only 1/3 of validator can be changed")
 	}
 
+	if len(left) >= len(set.validators)+len(joined) {
+		return errors.Errorf(errors.ErrGeneric, "Validator set can't be empty")
+	}
+
 	// First update proposer index
 	set.proposerIndex = (set.proposerIndex + lastRound + 1) % len(set.validators)
 
+	// Remove unbonded validators, the proposer index should point to the same validator
+	for _, v := range left {
+		for i, v2 := range set.validators {
+			if v2.Address().EqualsTo(v.Address()) {
+				set.validators = append(set.validators[:i], set.validators[i+1:]...)
+				if i < set.proposerIndex {
+					set.proposerIndex--
+				}
+				break
+			}
+		}
+	}
+	if set.proposerIndex >= len(set.validators) {
+		set.proposerIndex = 0
+	}
+
+	shouldLeave := 0
 	set.validators = append(set.validators, joined...)
 	if set.Power() > set.MaximumPower() {
-		shouldLeave := set.Power() - set.MaximumPower()
+		shouldLeave = set.Power() - set.MaximumPower()
 		set.validators = set.validators[shouldLeave:]
 	}
 	// Move proposer index after modifying the set
-	set.proposerIndex = set.proposerIndex - len(joined)
+	set.proposerIndex = set.proposerIndex - shouldLeave
 	if set.proposerIndex < 0 {
 		set.proposerIndex = 0
 	}
@@ -99,6 +127,20 @@ func (set *ValidatorSet) Validators() []crypto.Address {
 	return vals
 }
 
+// CopyValidators returns a copy of the validators in the set.
+// Unlike calling Validator for each address, the set can't change in between.
+func (set *ValidatorSet) CopyValidators() []*Validator {
+	set.lk.Lock()
+	defer set.lk.Unlock()
+
+	vals := make([]*Validator, len(set.validators))
+	for i, v := range set.validators {
+		val := *v
+		vals[i] = &val
+	}
+	return vals
+}
+
 func (set *ValidatorSet) Contains(addr crypto.Address) bool {
 	set.lk.Lock()
 	defer set.lk.Unlock()
@@ -16,6 +16,20 @@ func TestContains(t *testing.T) {
 	assert.False(t, vs.Contains(a))
 }
 
+func TestCopyValidators(t *testing.T) {
+	vs, keys := GenerateTestValidatorSet()
+
+	vals := vs.CopyValidators()
+	assert.Equal(t, len(vals), len(keys))
+	for i, val := range vals {
+		assert.Equal(t, val.Address(), keys[i].PublicKey().Address())
+	}
+
+	// Changing the copy doesn't change the set
+	vals[0].AddToStake(1)
+	assert.NotEqual(t, vals[0].Stake(), vs.Validator(keys[0].PublicKey().Address()).Stake())
+}
+
 func TestProposerMoves(t *testing.T) {
 	vs, keys := GenerateTestValidatorSet()
 
@@ -23,7 +37,7 @@ func TestProposerMoves(t *testing.T) {
 	assert.Equal(t, vs.Proposer(3).Address(), keys[3].PublicKey().Address())
 	assert.Equal(t, vs.Proposer(4).Address(), keys[0].PublicKey().Address())
 
-	assert.NoError(t, vs.MoveToNextHeight(0, nil))
+	assert.NoError(t, vs.MoveToNextHeight(0, nil, nil))
 	assert.Equal(t, vs.Proposer(0).Address(), keys[1].PublicKey().Address())
 }
 
@@ -58,7 +72,7 @@ func TestProposerMove(t *testing.T) {
 	//
 	vs.proposerIndex = 0
 	assert.Equal(t, vs.Proposer(0).Address(), val1.Address())
-	assert.NoError(t, vs.MoveToNextHeight(0, nil))
+	assert.NoError(t, vs.MoveToNextHeight(0, nil, nil))
 	assert.Equal(t, vs.proposerIndex, 1)
 	assert.Equal(t, vs.Proposer(0).Address(), val2.Address())
 	assert.Equal(t, vs.Proposer(1).Address(), val3.Address())
@@ -70,7 +84,7 @@ func TestProposerMove(t *testing.T) {
 	//
 	vs.proposerIndex = 3
 	assert.Equal(t, vs.Proposer(0).Address(), val4.Address())
-	assert.NoError(t, vs.MoveToNextHeight(0, nil))
+	assert.NoError(t, vs.MoveToNextHeight(0, nil, nil))
 	assert.Equal(t, vs.proposerIndex, 4)
 	assert.Equal(t, vs.Proposer(0).Address(), val5.Address())
 
@@ -81,7 +95,7 @@ func TestProposerMove(t *testing.T) {
 	//
 	vs.proposerIndex = 6
 	assert.Equal(t, vs.Proposer(0).Address(), val7.Address())
-	assert.NoError(t, vs.MoveToNextHeight(0, nil))
+	assert.NoError(t, vs.MoveToNextHeight(0, nil, nil))
 	assert.Equal(t, vs.proposerIndex, 0)
 	assert.Equal(t, vs.Proposer(0).Address(), val1.Address())
 }
@@ -105,7 +119,7 @@ func TestProposerMoveMoreRounds(t *testing.T) {
 	//
 	vs.proposerIndex = 0
 	assert.Equal(t, vs.Proposer(0).Address(), val1.Address())
-	assert.NoError(t, vs.MoveToNextHeight(2, nil))
+	assert.NoError(t, vs.MoveToNextHeight(2, nil, nil))
 	assert.Equal(t, vs.proposerIndex, 3)
 	assert.Equal(t, vs.Proposer(0).Address(), val4.Address())
 	assert.Equal(t, vs.Proposer(1).Address(), val5.Address())
@@ -117,7 +131,7 @@ func TestProposerMoveMoreRounds(t *testing.T) {
 	//
 	vs.proposerIndex = 3
 	assert.Equal(t, vs.Proposer(0).Address(), val4.Address())
-	assert.NoError(t, vs.MoveToNextHeight(3, nil))
+	assert.NoError(t, vs.MoveToNextHeight(3, nil, nil))
 	assert.Equal(t, vs.proposerIndex, 0)
 	assert.Equal(t, vs.Proposer(0).Address(), val1.Address())
 
@@ -128,7 +142,7 @@ func TestProposerMoveMoreRounds(t *testing.T) {
 	//
 	vs.proposerIndex = 6
 	assert.Equal(t, vs.Proposer(0).Address(), val7.Address())
-	assert.NoError(t, vs.MoveToNextHeight(1, nil))
+	assert.NoError(t, vs.MoveToNextHeight(1, nil, nil))
 	assert.Equal(t, vs.proposerIndex, 1)
 	assert.Equal(t, vs.Proposer(0).Address(), val2.Address())
 }
@@ -152,7 +166,7 @@ func TestProposerJoinAndLeave(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Val1 is already in set
-	assert.Error(t, vs.MoveToNextHeight(0, []*Validator{val1}))
+	assert.Error(t, vs.MoveToNextHeight(0, []*Validator{val1}, nil))
 
 	//
 	// +=+-+-+-+-+-+-+       +=+-+-+-+-+-+-+
@@ -160,7 +174,7 @@ func TestProposerJoinAndLeave(t *testing.T) {
 	// +=+-+-+-+-+-+-+       +=+-+-+-+-+-+-+
 	//
 	vs.proposerIndex = 0
-	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{val8}))
+	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{val8}, nil))
 	assert.Equal(t, vs.proposerIndex, 0)
 	assert.Equal(t, vs.Proposer(0).Address(), val2.Address())
 	assert.Equal(t, vs.Proposer(1).Address(), val3.Address())
@@ -172,7 +186,7 @@ func TestProposerJoinAndLeave(t *testing.T) {
 	//
 	//
 	vs.proposerIndex = 2
-	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{val9, valA}))
+	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{val9, valA}, nil))
 	assert.Equal(t, vs.proposerIndex, 1)
 	assert.Equal(t, vs.Proposer(0).Address(), val5.Address())
 
@@ -182,7 +196,7 @@ func TestProposerJoinAndLeave(t *testing.T) {
 	// +-+-+-+-+-+-+=+       +=+-+-+-+-+-+-+
 	//
 	vs.proposerIndex = 6
-	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{valB}))
+	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{valB}, nil))
 	assert.Equal(t, vs.proposerIndex, 0)
 	assert.Equal(t, vs.Proposer(0).Address(), val5.Address())
 
@@ -192,7 +206,7 @@ func TestProposerJoinAndLeave(t *testing.T) {
 	// +-+-+-+-+-+-+=+       +=+-+-+-+-+-+-+
 	//
 	vs.proposerIndex = 6
-	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{valC, valD}))
+	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{valC, valD}, nil))
 	assert.Equal(t, vs.proposerIndex, 0)
 	assert.Equal(t, vs.Proposer(0).Address(), val7.Address())
 }
@@ -216,7 +230,7 @@ func TestProposerJoinAndLeaveMoreRound(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Val1 is already in set
-	assert.Error(t, vs.MoveToNextHeight(0, []*Validator{val1}))
+	assert.Error(t, vs.MoveToNextHeight(0, []*Validator{val1}, nil))
 
 	//
 	// +=+-+-+-+-+-+-+       +-+-+=+-+-+-+-+
@@ -224,7 +238,7 @@ func TestProposerJoinAndLeaveMoreRound(t *testing.T) {
 	// +=+-+-+-+-+-+-+       +-+-+=+-+-+-+-+
 	//
 	vs.proposerIndex = 0
-	assert.NoError(t, vs.MoveToNextHeight(2, []*Validator{val8}))
+	assert.NoError(t, vs.MoveToNextHeight(2, []*Validator{val8}, nil))
 	assert.Equal(t, vs.proposerIndex, 2)
 	assert.Equal(t, vs.Proposer(0).Address(), val4.Address())
 	assert.Equal(t, vs.Proposer(1).Address(), val5.Address())
@@ -236,7 +250,7 @@ func TestProposerJoinAndLeaveMoreRound(t *testing.T) {
 	//
 	//
 	vs.proposerIndex = 2
-	assert.NoError(t, vs.MoveToNextHeight(3, []*Validator{val9, valA}))
+	assert.NoError(t, vs.MoveToNextHeight(3, []*Validator{val9, valA}, nil))
 	assert.Equal(t, vs.proposerIndex, 4)
 	assert.Equal(t, vs.Proposer(0).Address(), val8.Address())
 
@@ -247,7 +261,7 @@ func TestProposerJoinAndLeaveMoreRound(t *testing.T) {
 	//
 	// 5 is offline
 	vs.proposerIndex = 6
-	assert.NoError(t, vs.MoveToNextHeight(2, []*Validator{valB}))
+	assert.NoError(t, vs.MoveToNextHeight(2, []*Validator{valB}, nil))
 
 	assert.Equal(t, vs.proposerIndex, 1)
 	assert.Equal(t, vs.Proposer(0).Address(), val6.Address())
@@ -258,8 +272,86 @@ func TestProposerJoinAndLeaveMoreRound(t *testing.T) {
 	// +-+-+-+-+-+-+=+       +-+-+-+-+-+-+-+
 	//
 	vs.proposerIndex = 5
-	assert.NoError(t, vs.MoveToNextHeight(2, []*Validator{valC, valD}))
+	assert.NoError(t, vs.MoveToNextHeight(2, []*Validator{valC, valD}, nil))
 
 	assert.Equal(t, vs.proposerIndex, 0)
 	assert.Equal(t, vs.Proposer(0).Address(), val7.Address())
 }
+
+func TestProposerJoinNotFullSet(t *testing.T) {
+	val1, _ := GenerateTestValidator(0)
+	val2, _ := GenerateTestValidator(1)
+	val3, _ := GenerateTestValidator(2)
+	val4, _ := GenerateTestValidator(3)
+	val5, _ := GenerateTestValidator(4)
+	val6, _ := GenerateTestValidator(5)
+
+	vs, err := NewValidatorSet([]*Validator{val1, val2, val3, val4}, 7, val1.Address())
+	assert.NoError(t, err)
+
+	//
+	// +-+-+=+-+       +-+-+-+=+-+
+	// |1|2|3|4|  ==>  |1|2|3|4|5|
+	// +-+-+=+-+       +-+-+-+=+-+
+	//
+	// Nobody leaves the set, the proposer index shouldn't move back
+	vs.proposerIndex = 2
+	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{val5}, nil))
+	assert.Equal(t, vs.proposerIndex, 3)
+	assert.Equal(t, vs.Power(), 5)
+	assert.Equal(t, vs.Proposer(0).Address(), val4.Address())
+	assert.Equal(t, vs.Proposer(1).Address(), val5.Address())
+
+	//
+	// +-+-+-+-+=+       +=+-+-+-+-+-+
+	// |1|2|3|4|5|  ==>  |1|2|3|4|5|6|
+	// +-+-+-+-+=+       +=+-+-+-+-+-+
+	//
+	vs.proposerIndex = 4
+	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{val6}, nil))
+	assert.Equal(t, vs.proposerIndex, 0)
+	assert.Equal(t, vs.Proposer(0).Address(), val1.Address())
+}
+
+func TestValidatorsLeave(t *testing.T) {
+	val1, _ := GenerateTestValidator(0)
+	val2, _ := GenerateTestValidator(1)
+	val3, _ := GenerateTestValidator(2)
+	val4, _ := GenerateTestValidator(3)
+	val5, _ := GenerateTestValidator(4)
+	val6, _ := GenerateTestValidator(5)
+	val7, _ := GenerateTestValidator(6)
+	val8, _ := GenerateTestValidator(7)
+
+	vs, err := NewValidatorSet([]*Validator{val1, val2, val3, val4, val5, val6, val7}, 7, val1.Address())
+	assert.NoError(t, err)
+
+	// Val8 is not in set
+	assert.Error(t, vs.MoveToNextHeight(0, nil, []*Validator{val8}))
+
+	//
+	// +-+-+=+-+-+-+-+       +-+=+-+-+-+-+
+	// |1|2|3|4|5|6|7|  ==>  |1|3|4|5|6|7|
+	// +-+-+=+-+-+-+-+       +-+=+-+-+-+-+
+	//
+	vs.proposerIndex = 1
+	assert.NoError(t, vs.MoveToNextHeight(0, nil, []*Validator{val2}))
+	assert.Equal(t, vs.proposerIndex, 1)
+	assert.Equal(t, vs.Power(), 6)
+	assert.Equal(t, vs.Proposer(0).Address(), val3.Address())
+	assert.False(t, vs.Contains(val2.Address()))
+
+	//
+	// +-+-+-+-+-+=+       +=+-+-+-+-+
+	// |1|3|4|5|6|7|  ==>  |1|3|4|5|8|
+	// +-+-+-+-+-+=+       +=+-+-+-+-+
+	//
+	vs.proposerIndex = 4
+	assert.NoError(t, vs.MoveToNextHeight(0, []*Validator{val8}, []*Validator{val6, val7}))
+	assert.Equal(t, vs.proposerIndex, 0)
+	assert.Equal(t, vs.Power(), 5)
+	assert.Equal(t, vs.Proposer(0).Address(), val1.Address())
+
+	// Validator set can't be empty
+	assert.Error(t, vs.MoveToNextHeight(0, nil, []*Validator{val1, val3, val4, val5, val8}))
+}
@@ -66,3 +66,16 @@ func TestIncSequence(t *testing.T) {
 	val.IncSequence()
 	assert.Equal(t, val.Sequence(), seq+1)
 }
+
+func TestJail(t *testing.T) {
+	val, _ := GenerateTestValidator(0)
+	assert.False(t, val.IsJailed(100, 10))
+
+	h1 := val.Hash()
+	val.Jail(100)
+	assert.NotEqual(t, val.Hash(), h1)
+	assert.Equal(t, val.JailedHeight(), 100)
+	assert.True(t, val.IsJailed(100, 10))
+	assert.True(t, val.IsJailed(109, 10))
+	assert.False(t, val.IsJailed(110, 10))
+}
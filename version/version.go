@@ -4,6 +4,10 @@ import (
 	"fmt"
 )
 
+// ProtocolVersion is the highest protocol version that this node supports.
+// It is a variable, so the tests can activate a newer version.
+var ProtocolVersion = 1
+
 var (
 	NodeVersion Version
 	GitCommit   string
@@ -143,6 +143,22 @@ func (vs *VoteSet) AddVote(vote *Vote) (bool, error) {
 
 	return added, nil
 }
+
+// ConflictingVote returns the vote from the same signer for another block, if any.
+// Votes for undef block hash are not conflicting.
+func (vs *VoteSet) ConflictingVote(vote *Vote) *Vote {
+	for id, v := range vs.votesByBlock {
+		if id.IsUndef() || id.EqualsTo(vote.BlockHash()) {
+			continue
+		}
+		duplicated, ok := v.votes[vote.Signer()]
+		if ok {
+			return duplicated
+		}
+	}
+	return nil
+}
+
 func (vs *VoteSet) hasQuorum(sum int) bool {
 	return sum > (vs.valSet.Power() * 2 / 3)
 }
@@ -101,4 +101,6 @@ func TestDuplicateVote(t *testing.T) {
 	assert.False(t, added) // ok, replace UndefHash
 	assert.Error(t, err)
 	assert.Equal(t, err, errors.Error(errors.ErrDuplicateVote))
+	assert.Equal(t, voteSet.ConflictingVote(duplicatedVote).Hash(), correctVote.Hash())
+	assert.Nil(t, voteSet.ConflictingVote(correctVote))
 }
@@ -4,12 +4,18 @@ import (
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/store"
+	"github.com/zarbchain/zarb-go/sync/stats"
+	"github.com/zarbchain/zarb-go/txpool"
 )
 
 type factory struct {
-	store  store.StoreReader
-	logger *logger.Logger
+	store     store.StoreReader
+	state     state.StateReader
+	syncStats *stats.Stats
+	txPool    txpool.TxPool
+	logger    *logger.Logger
 }
 
 func (f factory) GetBlockHeight(args ZarbServer_getBlockHeight) error {
@@ -1,5 +1,48 @@
 package capnp
 
 func (f factory) GetBlockchainInfo(args ZarbServer_getBlockchainInfo) error {
+	res, _ := args.Results.NewResult()
+
+	lastBlockHeight := f.state.LastBlockHeight()
+	res.SetLastBlockHeight(uint64(lastBlockHeight))
+	if err := res.SetLastBlockHash(f.state.LastBlockHash().RawBytes()); err != nil {
+		return err
+	}
+	res.SetLastBlockTime(f.state.LastBlockTime().Unix())
+	if err := res.SetGenesisHash(f.state.GenesisHash().RawBytes()); err != nil {
+		return err
+	}
+	if err := res.SetChainName(f.state.ChainName()); err != nil {
+		return err
+	}
+	res.SetTotalAccounts(int32(f.store.TotalAccounts()))
+	res.SetTotalValidators(int32(f.store.TotalValidators()))
+
+	valSet := f.state.ValidatorSet()
+	validators := valSet.CopyValidators()
+	vals, err := res.NewValidators(int32(len(validators)))
+	if err != nil {
+		return err
+	}
+	for i, val := range validators {
+		valData, err := val.Encode()
+		if err != nil {
+			return err
+		}
+		if err := vals.Set(i, valData); err != nil {
+			return err
+		}
+	}
+	if err := res.SetProposer(valSet.Proposer(0).Address().RawBytes()); err != nil {
+		return err
+	}
+	res.SetTxPoolSize(int32(f.txPool.Size()))
+
+	syncStatus, _ := res.NewSyncStatus()
+	syncStatus.SetPeersCount(int32(f.syncStats.PeersCount()))
+	syncStatus.SetNodesCount(int32(f.syncStats.NodesCount()))
+	syncStatus.SetMaxHeight(uint64(f.syncStats.MaxHeight()))
+	syncStatus.SetIsSynced(lastBlockHeight >= f.syncStats.MaxHeight())
+
 	return nil
 }
@@ -0,0 +1,86 @@
+package capnp
+
+import (
+	"github.com/zarbchain/zarb-go/crypto"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
+)
+
+// setProofResult fills the proof result.
+// The root is calculated from the leaf hash and should be compared with the block header.
+func setProofResult(res ProofResult, data []byte, leaf crypto.Hash, proof *simpleMerkle.Proof, height int) error {
+	if err := res.SetData(data); err != nil {
+		return err
+	}
+	p, _ := res.NewProof()
+	p.SetIndex(uint64(proof.Index))
+	siblings, _ := p.NewSiblings(int32(len(proof.Siblings)))
+	for i, s := range proof.Siblings {
+		if err := siblings.Set(i, s.RawBytes()); err != nil {
+			return err
+		}
+	}
+	if err := res.SetRoot(proof.Root(leaf).RawBytes()); err != nil {
+		return err
+	}
+	res.SetHeight(uint64(height))
+	return nil
+}
+
+func (f factory) GetAccountProof(args ZarbServer_getAccountProof) error {
+	s, _ := args.Params.Address()
+	addr, err := crypto.AddressFromString(string(s))
+	if err != nil {
+		return err
+	}
+	acc, proof, height, err := f.state.AccountProof(addr)
+	if err != nil {
+		f.logger.Error("Error on creating account proof", "address", addr, "err", err)
+		return err
+	}
+
+	d, _ := acc.Encode()
+	res, _ := args.Results.NewResult()
+	return setProofResult(res, d, acc.Hash(), proof, height)
+}
+
+func (f factory) GetValidatorProof(args ZarbServer_getValidatorProof) error {
+	s, _ := args.Params.Address()
+	addr, err := crypto.AddressFromString(string(s))
+	if err != nil {
+		return err
+	}
+	val, proof, height, err := f.state.ValidatorProof(addr)
+	if err != nil {
+		f.logger.Error("Error on creating validator proof", "address", addr, "err", err)
+		return err
+	}
+
+	d, _ := val.Encode()
+	res, _ := args.Results.NewResult()
+	return setProofResult(res, d, val.Hash(), proof, height)
+}
+
+func (f factory) GetTransactionProof(args ZarbServer_getTransactionProof) error {
+	s, _ := args.Params.Hash()
+	id, err := crypto.HashFromString(string(s))
+	if err != nil {
+		return err
+	}
+	ctrx, err := f.store.Transaction(id)
+	if err != nil {
+		return err
+	}
+	height, err := f.store.BlockHeight(ctrx.Receipt.BlockHash())
+	if err != nil {
+		return err
+	}
+	proof, err := f.state.TxProof(id)
+	if err != nil {
+		f.logger.Error("Error on creating transaction proof", "id", id, "err", err)
+		return err
+	}
+
+	d, _ := ctrx.Tx.Encode()
+	res, _ := args.Results.NewResult()
+	return setProofResult(res, d, id, proof, height)
+}
@@ -7,29 +7,32 @@ import (
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/store"
+	"github.com/zarbchain/zarb-go/sync/stats"
 	"github.com/zarbchain/zarb-go/txpool"
 	"zombiezen.com/go/capnproto2/rpc"
 )
 
 type Server struct {
-	ctx      context.Context
-	config   *Config
-	address  string
-	listener net.Listener
-	store    store.StoreReader
-	state    state.StateReader
-	txPool   txpool.TxPoolReader
-	logger   *logger.Logger
+	ctx       context.Context
+	config    *Config
+	address   string
+	listener  net.Listener
+	store     store.StoreReader
+	state     state.StateReader
+	syncStats *stats.Stats
+	txPool    txpool.TxPool
+	logger    *logger.Logger
 }
 
-func NewServer(conf *Config, state state.StateReader, txPool txpool.TxPoolReader) (*Server, error) {
+func NewServer(conf *Config, state state.StateReader, syncStats *stats.Stats, txPool txpool.TxPool) (*Server, error) {
 	return &Server{
-		ctx:    context.Background(),
-		store:  state.StoreReader(),
-		state:  state,
-		txPool: txPool,
-		config: conf,
-		logger: logger.NewLogger("_capnp", nil),
+		ctx:       context.Background(),
+		store:     state.StoreReader(),
+		state:     state,
+		syncStats: syncStats,
+		txPool:    txPool,
+		config:    conf,
+		logger:    logger.NewLogger("_capnp", nil),
 	}, nil
 }
 func (s *Server) Address() string {
@@ -59,7 +62,7 @@ func (s *Server) StartServer() error {
 			} else {
 				//
 				go func(c net.Conn) {
-					s2c := ZarbServer_ServerToClient(factory{s.store, s.logger})
+					s2c := ZarbServer_ServerToClient(factory{s.store, s.state, s.syncStats, s.txPool, s.logger})
 					conn := rpc.NewConn(rpc.StreamTransport(conn), rpc.MainInterface(s2c.Client))
 					err := conn.Wait()
 					if err != nil {
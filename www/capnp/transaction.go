@@ -1,6 +1,10 @@
 package capnp
 
-import "github.com/zarbchain/zarb-go/crypto"
+import (
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/tx"
+)
 
 func (f factory) GetTransaction(args ZarbServer_getTransaction) error {
 	s, _ := args.Params.Hash()
@@ -31,3 +35,72 @@ func (f factory) GetTransaction(args ZarbServer_getTransaction) error {
 	}
 	return nil
 }
+
+func (f factory) SendRawTransaction(args ZarbServer_sendRawTransaction) error {
+	rawTx, _ := args.Params.RawTx()
+	res, _ := args.Results.NewResult()
+
+	trx := new(tx.Tx)
+	err := trx.Decode(rawTx)
+	if err != nil {
+		err = errors.Errorf(errors.ErrInvalidTx, err.Error())
+	} else {
+		err = f.txPool.AppendTxAndBroadcast(trx)
+	}
+	if err != nil {
+		res.SetStatus(int32(errors.Code(err)))
+		return res.SetError(err.Error())
+	}
+
+	return res.SetId(trx.ID().RawBytes())
+}
+
+func (f factory) DryRunTransaction(args ZarbServer_dryRunTransaction) error {
+	rawTx, _ := args.Params.RawTx()
+	res, _ := args.Results.NewResult()
+
+	trx := new(tx.Tx)
+	if err := trx.Decode(rawTx); err != nil {
+		err = errors.Errorf(errors.ErrInvalidTx, err.Error())
+		res.SetStatus(int32(errors.Code(err)))
+		return res.SetError(err.Error())
+	}
+
+	dryRun, err := f.state.DryRunTx(trx)
+	if err != nil {
+		return err
+	}
+
+	rec, _ := res.NewReceipt()
+	recData, _ := dryRun.Receipt.Encode()
+	if err := rec.SetData(recData); err != nil {
+		return err
+	}
+	if err := rec.SetHash(dryRun.Receipt.Hash().RawBytes()); err != nil {
+		return err
+	}
+	res.SetExpectedFee(dryRun.ExpectedFee)
+	res.SetExpectedSequence(int32(dryRun.ExpectedSequence))
+
+	accs, _ := res.NewAccounts(int32(len(dryRun.Accounts)))
+	for i, acc := range dryRun.Accounts {
+		accData, _ := acc.Encode()
+		if err := accs.Set(i, accData); err != nil {
+			return err
+		}
+	}
+	vals, _ := res.NewValidators(int32(len(dryRun.Validators)))
+	for i, val := range dryRun.Validators {
+		valData, _ := val.Encode()
+		if err := vals.Set(i, valData); err != nil {
+			return err
+		}
+	}
+
+	if dryRun.Error != nil {
+		res.SetStatus(int32(errors.Code(dryRun.Error)))
+		return res.SetError(dryRun.Error.Error())
+	}
+
+	return nil
+}
@@ -566,12 +566,12 @@ type BlockchainResult struct{ capnp.Struct }
 const BlockchainResult_TypeID = 0xbd88d0eab3826ba9
 
 func NewBlockchainResult(s *capnp.Segment) (BlockchainResult, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 6})
 	return BlockchainResult{st}, err
 }
 
 func NewRootBlockchainResult(s *capnp.Segment) (BlockchainResult, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 6})
 	return BlockchainResult{st}, err
 }
 
@@ -585,12 +585,163 @@ func (s BlockchainResult) String() string {
 	return str
 }
 
+func (s BlockchainResult) LastBlockHeight() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s BlockchainResult) SetLastBlockHeight(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s BlockchainResult) LastBlockHash() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s BlockchainResult) HasLastBlockHash() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s BlockchainResult) SetLastBlockHash(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s BlockchainResult) LastBlockTime() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s BlockchainResult) SetLastBlockTime(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
+func (s BlockchainResult) GenesisHash() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return []byte(p.Data()), err
+}
+
+func (s BlockchainResult) HasGenesisHash() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s BlockchainResult) SetGenesisHash(v []byte) error {
+	return s.Struct.SetData(1, v)
+}
+
+func (s BlockchainResult) ChainName() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s BlockchainResult) HasChainName() bool {
+	p, err := s.Struct.Ptr(2)
+	return p.IsValid() || err != nil
+}
+
+func (s BlockchainResult) ChainNameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s BlockchainResult) SetChainName(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+func (s BlockchainResult) TotalAccounts() int32 {
+	return int32(s.Struct.Uint32(16))
+}
+
+func (s BlockchainResult) SetTotalAccounts(v int32) {
+	s.Struct.SetUint32(16, uint32(v))
+}
+
+func (s BlockchainResult) TotalValidators() int32 {
+	return int32(s.Struct.Uint32(20))
+}
+
+func (s BlockchainResult) SetTotalValidators(v int32) {
+	s.Struct.SetUint32(20, uint32(v))
+}
+
+func (s BlockchainResult) Validators() (capnp.DataList, error) {
+	p, err := s.Struct.Ptr(3)
+	return capnp.DataList{List: p.List()}, err
+}
+
+func (s BlockchainResult) HasValidators() bool {
+	p, err := s.Struct.Ptr(3)
+	return p.IsValid() || err != nil
+}
+
+func (s BlockchainResult) SetValidators(v capnp.DataList) error {
+	return s.Struct.SetPtr(3, v.List.ToPtr())
+}
+
+// NewValidators sets the validators field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s BlockchainResult) NewValidators(n int32) (capnp.DataList, error) {
+	l, err := capnp.NewDataList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.DataList{}, err
+	}
+	err = s.Struct.SetPtr(3, l.List.ToPtr())
+	return l, err
+}
+
+func (s BlockchainResult) Proposer() ([]byte, error) {
+	p, err := s.Struct.Ptr(4)
+	return []byte(p.Data()), err
+}
+
+func (s BlockchainResult) HasProposer() bool {
+	p, err := s.Struct.Ptr(4)
+	return p.IsValid() || err != nil
+}
+
+func (s BlockchainResult) SetProposer(v []byte) error {
+	return s.Struct.SetData(4, v)
+}
+
+func (s BlockchainResult) TxPoolSize() int32 {
+	return int32(s.Struct.Uint32(24))
+}
+
+func (s BlockchainResult) SetTxPoolSize(v int32) {
+	s.Struct.SetUint32(24, uint32(v))
+}
+
+func (s BlockchainResult) SyncStatus() (SyncStatus, error) {
+	p, err := s.Struct.Ptr(5)
+	return SyncStatus{Struct: p.Struct()}, err
+}
+
+func (s BlockchainResult) HasSyncStatus() bool {
+	p, err := s.Struct.Ptr(5)
+	return p.IsValid() || err != nil
+}
+
+func (s BlockchainResult) SetSyncStatus(v SyncStatus) error {
+	return s.Struct.SetPtr(5, v.Struct.ToPtr())
+}
+
+// NewSyncStatus sets the syncStatus field to a newly
+// allocated SyncStatus struct, preferring placement in s's segment.
+func (s BlockchainResult) NewSyncStatus() (SyncStatus, error) {
+	ss, err := NewSyncStatus(s.Struct.Segment())
+	if err != nil {
+		return SyncStatus{}, err
+	}
+	err = s.Struct.SetPtr(5, ss.Struct.ToPtr())
+	return ss, err
+}
+
 // BlockchainResult_List is a list of BlockchainResult.
 type BlockchainResult_List struct{ capnp.List }
 
 // NewBlockchainResult creates a new list of BlockchainResult.
 func NewBlockchainResult_List(s *capnp.Segment, sz int32) (BlockchainResult_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 6}, sz)
 	return BlockchainResult_List{l}, err
 }
 
@@ -613,6 +764,10 @@ func (p BlockchainResult_Promise) Struct() (BlockchainResult, error) {
 	return BlockchainResult{s}, err
 }
 
+func (p BlockchainResult_Promise) SyncStatus() SyncStatus_Promise {
+	return SyncStatus_Promise{Pipeline: p.Pipeline.GetPipeline(5)}
+}
+
 type BlockResult struct{ capnp.Struct }
 
 // BlockResult_TypeID is the unique identifier for the type BlockResult.
@@ -1058,1332 +1213,2978 @@ func (p ValidatorResult_Promise) Struct() (ValidatorResult, error) {
 	return ValidatorResult{s}, err
 }
 
-type ZarbServer struct{ Client capnp.Client }
+type SendTransactionResult struct{ capnp.Struct }
 
-// ZarbServer_TypeID is the unique identifier for the type ZarbServer.
-const ZarbServer_TypeID = 0xf906e2ae0dd37fe4
+// SendTransactionResult_TypeID is the unique identifier for the type SendTransactionResult.
+const SendTransactionResult_TypeID = 0xcfd704b9b2c62a4a
 
-func (c ZarbServer) GetBlockchainInfo(ctx context.Context, params func(ZarbServer_getBlockchainInfo_Params) error, opts ...capnp.CallOption) ZarbServer_getBlockchainInfo_Results_Promise {
-	if c.Client == nil {
-		return ZarbServer_getBlockchainInfo_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      0,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getBlockchainInfo",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlockchainInfo_Params{Struct: s}) }
-	}
-	return ZarbServer_getBlockchainInfo_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+func NewSendTransactionResult(s *capnp.Segment) (SendTransactionResult, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return SendTransactionResult{st}, err
 }
-func (c ZarbServer) GetBlock(ctx context.Context, params func(ZarbServer_getBlock_Params) error, opts ...capnp.CallOption) ZarbServer_getBlock_Results_Promise {
-	if c.Client == nil {
-		return ZarbServer_getBlock_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      1,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getBlock",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlock_Params{Struct: s}) }
-	}
-	return ZarbServer_getBlock_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func NewRootSendTransactionResult(s *capnp.Segment) (SendTransactionResult, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return SendTransactionResult{st}, err
 }
-func (c ZarbServer) GetTransaction(ctx context.Context, params func(ZarbServer_getTransaction_Params) error, opts ...capnp.CallOption) ZarbServer_getTransaction_Results_Promise {
-	if c.Client == nil {
-		return ZarbServer_getTransaction_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      2,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getTransaction",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getTransaction_Params{Struct: s}) }
-	}
-	return ZarbServer_getTransaction_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func ReadRootSendTransactionResult(msg *capnp.Message) (SendTransactionResult, error) {
+	root, err := msg.RootPtr()
+	return SendTransactionResult{root.Struct()}, err
 }
-func (c ZarbServer) GetBlockHeight(ctx context.Context, params func(ZarbServer_getBlockHeight_Params) error, opts ...capnp.CallOption) ZarbServer_getBlockHeight_Results_Promise {
-	if c.Client == nil {
-		return ZarbServer_getBlockHeight_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      3,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getBlockHeight",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlockHeight_Params{Struct: s}) }
-	}
-	return ZarbServer_getBlockHeight_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func (s SendTransactionResult) String() string {
+	str, _ := text.Marshal(0xcfd704b9b2c62a4a, s.Struct)
+	return str
 }
-func (c ZarbServer) GetAccount(ctx context.Context, params func(ZarbServer_getAccount_Params) error, opts ...capnp.CallOption) ZarbServer_getAccount_Results_Promise {
-	if c.Client == nil {
-		return ZarbServer_getAccount_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      4,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getAccount",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getAccount_Params{Struct: s}) }
-	}
-	return ZarbServer_getAccount_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func (s SendTransactionResult) Id() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
 }
-func (c ZarbServer) GetValidator(ctx context.Context, params func(ZarbServer_getValidator_Params) error, opts ...capnp.CallOption) ZarbServer_getValidator_Results_Promise {
-	if c.Client == nil {
-		return ZarbServer_getValidator_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      5,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getValidator",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getValidator_Params{Struct: s}) }
-	}
-	return ZarbServer_getValidator_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func (s SendTransactionResult) HasId() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-type ZarbServer_Server interface {
-	GetBlockchainInfo(ZarbServer_getBlockchainInfo) error
+func (s SendTransactionResult) SetId(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
 
-	GetBlock(ZarbServer_getBlock) error
+func (s SendTransactionResult) Status() int32 {
+	return int32(s.Struct.Uint32(0))
+}
 
-	GetTransaction(ZarbServer_getTransaction) error
+func (s SendTransactionResult) SetStatus(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
 
-	GetBlockHeight(ZarbServer_getBlockHeight) error
+func (s SendTransactionResult) Error() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
 
-	GetAccount(ZarbServer_getAccount) error
+func (s SendTransactionResult) HasError() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
 
-	GetValidator(ZarbServer_getValidator) error
+func (s SendTransactionResult) ErrorBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
 }
 
-func ZarbServer_ServerToClient(s ZarbServer_Server) ZarbServer {
-	c, _ := s.(server.Closer)
-	return ZarbServer{Client: server.New(ZarbServer_Methods(nil, s), c)}
+func (s SendTransactionResult) SetError(v string) error {
+	return s.Struct.SetText(1, v)
 }
 
-func ZarbServer_Methods(methods []server.Method, s ZarbServer_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 6)
+// SendTransactionResult_List is a list of SendTransactionResult.
+type SendTransactionResult_List struct{ capnp.List }
+
+// NewSendTransactionResult creates a new list of SendTransactionResult.
+func NewSendTransactionResult_List(s *capnp.Segment, sz int32) (SendTransactionResult_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return SendTransactionResult_List{l}, err
+}
+
+func (s SendTransactionResult_List) At(i int) SendTransactionResult {
+	return SendTransactionResult{s.List.Struct(i)}
+}
+
+func (s SendTransactionResult_List) Set(i int, v SendTransactionResult) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s SendTransactionResult_List) String() string {
+	str, _ := text.MarshalList(0xcfd704b9b2c62a4a, s.List)
+	return str
+}
+
+// SendTransactionResult_Promise is a wrapper for a SendTransactionResult promised by a client call.
+type SendTransactionResult_Promise struct{ *capnp.Pipeline }
+
+func (p SendTransactionResult_Promise) Struct() (SendTransactionResult, error) {
+	s, err := p.Pipeline.Struct()
+	return SendTransactionResult{s}, err
+}
+
+type SyncStatus struct{ capnp.Struct }
+
+// SyncStatus_TypeID is the unique identifier for the type SyncStatus.
+const SyncStatus_TypeID = 0xa920b04cafab9870
+
+func NewSyncStatus(s *capnp.Segment) (SyncStatus, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return SyncStatus{st}, err
+}
+
+func NewRootSyncStatus(s *capnp.Segment) (SyncStatus, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return SyncStatus{st}, err
+}
+
+func ReadRootSyncStatus(msg *capnp.Message) (SyncStatus, error) {
+	root, err := msg.RootPtr()
+	return SyncStatus{root.Struct()}, err
+}
+
+func (s SyncStatus) String() string {
+	str, _ := text.Marshal(0xa920b04cafab9870, s.Struct)
+	return str
+}
+
+func (s SyncStatus) PeersCount() int32 {
+	return int32(s.Struct.Uint32(0))
+}
+
+func (s SyncStatus) SetPeersCount(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
+
+func (s SyncStatus) NodesCount() int32 {
+	return int32(s.Struct.Uint32(4))
+}
+
+func (s SyncStatus) SetNodesCount(v int32) {
+	s.Struct.SetUint32(4, uint32(v))
+}
+
+func (s SyncStatus) MaxHeight() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s SyncStatus) SetMaxHeight(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s SyncStatus) IsSynced() bool {
+	return s.Struct.Bit(128)
+}
+
+func (s SyncStatus) SetIsSynced(v bool) {
+	s.Struct.SetBit(128, v)
+}
+
+// SyncStatus_List is a list of SyncStatus.
+type SyncStatus_List struct{ capnp.List }
+
+// NewSyncStatus creates a new list of SyncStatus.
+func NewSyncStatus_List(s *capnp.Segment, sz int32) (SyncStatus_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return SyncStatus_List{l}, err
+}
+
+func (s SyncStatus_List) At(i int) SyncStatus { return SyncStatus{s.List.Struct(i)} }
+
+func (s SyncStatus_List) Set(i int, v SyncStatus) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s SyncStatus_List) String() string {
+	str, _ := text.MarshalList(0xa920b04cafab9870, s.List)
+	return str
+}
+
+// SyncStatus_Promise is a wrapper for a SyncStatus promised by a client call.
+type SyncStatus_Promise struct{ *capnp.Pipeline }
+
+func (p SyncStatus_Promise) Struct() (SyncStatus, error) {
+	s, err := p.Pipeline.Struct()
+	return SyncStatus{s}, err
+}
+
+type DryRunResult struct{ capnp.Struct }
+
+// DryRunResult_TypeID is the unique identifier for the type DryRunResult.
+const DryRunResult_TypeID = 0xdec7faa3e9fc6e94
+
+func NewDryRunResult(s *capnp.Segment) (DryRunResult, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return DryRunResult{st}, err
+}
+
+func NewRootDryRunResult(s *capnp.Segment) (DryRunResult, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return DryRunResult{st}, err
+}
+
+func ReadRootDryRunResult(msg *capnp.Message) (DryRunResult, error) {
+	root, err := msg.RootPtr()
+	return DryRunResult{root.Struct()}, err
+}
+
+func (s DryRunResult) String() string {
+	str, _ := text.Marshal(0xdec7faa3e9fc6e94, s.Struct)
+	return str
+}
+
+func (s DryRunResult) Receipt() (Receipt, error) {
+	p, err := s.Struct.Ptr(0)
+	return Receipt{Struct: p.Struct()}, err
+}
+
+func (s DryRunResult) HasReceipt() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s DryRunResult) SetReceipt(v Receipt) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewReceipt sets the receipt field to a newly
+// allocated Receipt struct, preferring placement in s's segment.
+func (s DryRunResult) NewReceipt() (Receipt, error) {
+	ss, err := NewReceipt(s.Struct.Segment())
+	if err != nil {
+		return Receipt{}, err
 	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      0,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getBlockchainInfo",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := ZarbServer_getBlockchainInfo{c, opts, ZarbServer_getBlockchainInfo_Params{Struct: p}, ZarbServer_getBlockchainInfo_Results{Struct: r}}
-			return s.GetBlockchainInfo(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+func (s DryRunResult) ExpectedFee() int64 {
+	return int64(s.Struct.Uint64(0))
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      1,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getBlock",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := ZarbServer_getBlock{c, opts, ZarbServer_getBlock_Params{Struct: p}, ZarbServer_getBlock_Results{Struct: r}}
-			return s.GetBlock(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+func (s DryRunResult) SetExpectedFee(v int64) {
+	s.Struct.SetUint64(0, uint64(v))
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      2,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getTransaction",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := ZarbServer_getTransaction{c, opts, ZarbServer_getTransaction_Params{Struct: p}, ZarbServer_getTransaction_Results{Struct: r}}
-			return s.GetTransaction(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+func (s DryRunResult) ExpectedSequence() int32 {
+	return int32(s.Struct.Uint32(8))
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      3,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getBlockHeight",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := ZarbServer_getBlockHeight{c, opts, ZarbServer_getBlockHeight_Params{Struct: p}, ZarbServer_getBlockHeight_Results{Struct: r}}
-			return s.GetBlockHeight(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
-	})
+func (s DryRunResult) SetExpectedSequence(v int32) {
+	s.Struct.SetUint32(8, uint32(v))
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      4,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getAccount",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := ZarbServer_getAccount{c, opts, ZarbServer_getAccount_Params{Struct: p}, ZarbServer_getAccount_Results{Struct: r}}
-			return s.GetAccount(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+func (s DryRunResult) Accounts() (capnp.DataList, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.DataList{List: p.List()}, err
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      5,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getValidator",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := ZarbServer_getValidator{c, opts, ZarbServer_getValidator_Params{Struct: p}, ZarbServer_getValidator_Results{Struct: r}}
-			return s.GetValidator(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+func (s DryRunResult) HasAccounts() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
 
-	return methods
+func (s DryRunResult) SetAccounts(v capnp.DataList) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
 }
 
-// ZarbServer_getBlockchainInfo holds the arguments for a server call to ZarbServer.getBlockchainInfo.
-type ZarbServer_getBlockchainInfo struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  ZarbServer_getBlockchainInfo_Params
-	Results ZarbServer_getBlockchainInfo_Results
+// NewAccounts sets the accounts field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s DryRunResult) NewAccounts(n int32) (capnp.DataList, error) {
+	l, err := capnp.NewDataList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.DataList{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
 }
 
-// ZarbServer_getBlock holds the arguments for a server call to ZarbServer.getBlock.
-type ZarbServer_getBlock struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  ZarbServer_getBlock_Params
-	Results ZarbServer_getBlock_Results
+func (s DryRunResult) Validators() (capnp.DataList, error) {
+	p, err := s.Struct.Ptr(2)
+	return capnp.DataList{List: p.List()}, err
 }
 
-// ZarbServer_getTransaction holds the arguments for a server call to ZarbServer.getTransaction.
-type ZarbServer_getTransaction struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  ZarbServer_getTransaction_Params
-	Results ZarbServer_getTransaction_Results
+func (s DryRunResult) HasValidators() bool {
+	p, err := s.Struct.Ptr(2)
+	return p.IsValid() || err != nil
 }
 
-// ZarbServer_getBlockHeight holds the arguments for a server call to ZarbServer.getBlockHeight.
-type ZarbServer_getBlockHeight struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  ZarbServer_getBlockHeight_Params
-	Results ZarbServer_getBlockHeight_Results
+func (s DryRunResult) SetValidators(v capnp.DataList) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
 }
 
-// ZarbServer_getAccount holds the arguments for a server call to ZarbServer.getAccount.
-type ZarbServer_getAccount struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  ZarbServer_getAccount_Params
-	Results ZarbServer_getAccount_Results
+// NewValidators sets the validators field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s DryRunResult) NewValidators(n int32) (capnp.DataList, error) {
+	l, err := capnp.NewDataList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.DataList{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
 }
 
-// ZarbServer_getValidator holds the arguments for a server call to ZarbServer.getValidator.
-type ZarbServer_getValidator struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  ZarbServer_getValidator_Params
-	Results ZarbServer_getValidator_Results
+func (s DryRunResult) Status() int32 {
+	return int32(s.Struct.Uint32(12))
 }
 
-type ZarbServer_getBlockchainInfo_Params struct{ capnp.Struct }
+func (s DryRunResult) SetStatus(v int32) {
+	s.Struct.SetUint32(12, uint32(v))
+}
 
-// ZarbServer_getBlockchainInfo_Params_TypeID is the unique identifier for the type ZarbServer_getBlockchainInfo_Params.
-const ZarbServer_getBlockchainInfo_Params_TypeID = 0x8df1c729f8d2ca00
+func (s DryRunResult) Error() (string, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.Text(), err
+}
 
-func NewZarbServer_getBlockchainInfo_Params(s *capnp.Segment) (ZarbServer_getBlockchainInfo_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return ZarbServer_getBlockchainInfo_Params{st}, err
+func (s DryRunResult) HasError() bool {
+	p, err := s.Struct.Ptr(3)
+	return p.IsValid() || err != nil
+}
+
+func (s DryRunResult) ErrorBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.TextBytes(), err
+}
+
+func (s DryRunResult) SetError(v string) error {
+	return s.Struct.SetText(3, v)
+}
+
+// DryRunResult_List is a list of DryRunResult.
+type DryRunResult_List struct{ capnp.List }
+
+// NewDryRunResult creates a new list of DryRunResult.
+func NewDryRunResult_List(s *capnp.Segment, sz int32) (DryRunResult_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4}, sz)
+	return DryRunResult_List{l}, err
+}
+
+func (s DryRunResult_List) At(i int) DryRunResult { return DryRunResult{s.List.Struct(i)} }
+
+func (s DryRunResult_List) Set(i int, v DryRunResult) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s DryRunResult_List) String() string {
+	str, _ := text.MarshalList(0xdec7faa3e9fc6e94, s.List)
+	return str
+}
+
+// DryRunResult_Promise is a wrapper for a DryRunResult promised by a client call.
+type DryRunResult_Promise struct{ *capnp.Pipeline }
+
+func (p DryRunResult_Promise) Struct() (DryRunResult, error) {
+	s, err := p.Pipeline.Struct()
+	return DryRunResult{s}, err
+}
+
+func (p DryRunResult_Promise) Receipt() Receipt_Promise {
+	return Receipt_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type Proof struct{ capnp.Struct }
+
+// Proof_TypeID is the unique identifier for the type Proof.
+const Proof_TypeID = 0xd157dc600dd4b3b4
+
+func NewProof(s *capnp.Segment) (Proof, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Proof{st}, err
+}
+
+func NewRootProof(s *capnp.Segment) (Proof, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Proof{st}, err
+}
+
+func ReadRootProof(msg *capnp.Message) (Proof, error) {
+	root, err := msg.RootPtr()
+	return Proof{root.Struct()}, err
+}
+
+func (s Proof) String() string {
+	str, _ := text.Marshal(0xd157dc600dd4b3b4, s.Struct)
+	return str
+}
+
+func (s Proof) Index() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Proof) SetIndex(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Proof) Siblings() (capnp.DataList, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.DataList{List: p.List()}, err
+}
+
+func (s Proof) HasSiblings() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s Proof) SetSiblings(v capnp.DataList) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewSiblings sets the siblings field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s Proof) NewSiblings(n int32) (capnp.DataList, error) {
+	l, err := capnp.NewDataList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.DataList{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Proof_List is a list of Proof.
+type Proof_List struct{ capnp.List }
+
+// NewProof creates a new list of Proof.
+func NewProof_List(s *capnp.Segment, sz int32) (Proof_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return Proof_List{l}, err
+}
+
+func (s Proof_List) At(i int) Proof { return Proof{s.List.Struct(i)} }
+
+func (s Proof_List) Set(i int, v Proof) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Proof_List) String() string {
+	str, _ := text.MarshalList(0xd157dc600dd4b3b4, s.List)
+	return str
+}
+
+// Proof_Promise is a wrapper for a Proof promised by a client call.
+type Proof_Promise struct{ *capnp.Pipeline }
+
+func (p Proof_Promise) Struct() (Proof, error) {
+	s, err := p.Pipeline.Struct()
+	return Proof{s}, err
+}
+
+type ProofResult struct{ capnp.Struct }
+
+// ProofResult_TypeID is the unique identifier for the type ProofResult.
+const ProofResult_TypeID = 0xab00e1900b4e7341
+
+func NewProofResult(s *capnp.Segment) (ProofResult, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return ProofResult{st}, err
+}
+
+func NewRootProofResult(s *capnp.Segment) (ProofResult, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return ProofResult{st}, err
+}
+
+func ReadRootProofResult(msg *capnp.Message) (ProofResult, error) {
+	root, err := msg.RootPtr()
+	return ProofResult{root.Struct()}, err
+}
+
+func (s ProofResult) String() string {
+	str, _ := text.Marshal(0xab00e1900b4e7341, s.Struct)
+	return str
+}
+
+func (s ProofResult) Data() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s ProofResult) HasData() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ProofResult) SetData(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s ProofResult) Proof() (Proof, error) {
+	p, err := s.Struct.Ptr(1)
+	return Proof{Struct: p.Struct()}, err
+}
+
+func (s ProofResult) HasProof() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s ProofResult) SetProof(v Proof) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewProof sets the proof field to a newly
+// allocated Proof struct, preferring placement in s's segment.
+func (s ProofResult) NewProof() (Proof, error) {
+	ss, err := NewProof(s.Struct.Segment())
+	if err != nil {
+		return Proof{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s ProofResult) Root() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return []byte(p.Data()), err
+}
+
+func (s ProofResult) HasRoot() bool {
+	p, err := s.Struct.Ptr(2)
+	return p.IsValid() || err != nil
+}
+
+func (s ProofResult) SetRoot(v []byte) error {
+	return s.Struct.SetData(2, v)
+}
+
+func (s ProofResult) Height() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s ProofResult) SetHeight(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+// ProofResult_List is a list of ProofResult.
+type ProofResult_List struct{ capnp.List }
+
+// NewProofResult creates a new list of ProofResult.
+func NewProofResult_List(s *capnp.Segment, sz int32) (ProofResult_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	return ProofResult_List{l}, err
+}
+
+func (s ProofResult_List) At(i int) ProofResult { return ProofResult{s.List.Struct(i)} }
+
+func (s ProofResult_List) Set(i int, v ProofResult) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s ProofResult_List) String() string {
+	str, _ := text.MarshalList(0xab00e1900b4e7341, s.List)
+	return str
+}
+
+// ProofResult_Promise is a wrapper for a ProofResult promised by a client call.
+type ProofResult_Promise struct{ *capnp.Pipeline }
+
+func (p ProofResult_Promise) Struct() (ProofResult, error) {
+	s, err := p.Pipeline.Struct()
+	return ProofResult{s}, err
+}
+
+func (p ProofResult_Promise) Proof() Proof_Promise {
+	return Proof_Promise{Pipeline: p.Pipeline.GetPipeline(1)}
+}
+
+type ZarbServer struct{ Client capnp.Client }
+
+// ZarbServer_TypeID is the unique identifier for the type ZarbServer.
+const ZarbServer_TypeID = 0xf906e2ae0dd37fe4
+
+func (c ZarbServer) GetBlockchainInfo(ctx context.Context, params func(ZarbServer_getBlockchainInfo_Params) error, opts ...capnp.CallOption) ZarbServer_getBlockchainInfo_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getBlockchainInfo_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      0,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getBlockchainInfo",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlockchainInfo_Params{Struct: s}) }
+	}
+	return ZarbServer_getBlockchainInfo_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetBlock(ctx context.Context, params func(ZarbServer_getBlock_Params) error, opts ...capnp.CallOption) ZarbServer_getBlock_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getBlock_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      1,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getBlock",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlock_Params{Struct: s}) }
+	}
+	return ZarbServer_getBlock_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetTransaction(ctx context.Context, params func(ZarbServer_getTransaction_Params) error, opts ...capnp.CallOption) ZarbServer_getTransaction_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getTransaction_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      2,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getTransaction",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getTransaction_Params{Struct: s}) }
+	}
+	return ZarbServer_getTransaction_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetBlockHeight(ctx context.Context, params func(ZarbServer_getBlockHeight_Params) error, opts ...capnp.CallOption) ZarbServer_getBlockHeight_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getBlockHeight_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      3,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getBlockHeight",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlockHeight_Params{Struct: s}) }
+	}
+	return ZarbServer_getBlockHeight_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetAccount(ctx context.Context, params func(ZarbServer_getAccount_Params) error, opts ...capnp.CallOption) ZarbServer_getAccount_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getAccount_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      4,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getAccount",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getAccount_Params{Struct: s}) }
+	}
+	return ZarbServer_getAccount_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetValidator(ctx context.Context, params func(ZarbServer_getValidator_Params) error, opts ...capnp.CallOption) ZarbServer_getValidator_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getValidator_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      5,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getValidator",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getValidator_Params{Struct: s}) }
+	}
+	return ZarbServer_getValidator_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) SendRawTransaction(ctx context.Context, params func(ZarbServer_sendRawTransaction_Params) error, opts ...capnp.CallOption) ZarbServer_sendRawTransaction_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_sendRawTransaction_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      6,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "sendRawTransaction",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_sendRawTransaction_Params{Struct: s}) }
+	}
+	return ZarbServer_sendRawTransaction_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) DryRunTransaction(ctx context.Context, params func(ZarbServer_dryRunTransaction_Params) error, opts ...capnp.CallOption) ZarbServer_dryRunTransaction_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_dryRunTransaction_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      7,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "dryRunTransaction",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_dryRunTransaction_Params{Struct: s}) }
+	}
+	return ZarbServer_dryRunTransaction_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetAccountProof(ctx context.Context, params func(ZarbServer_getAccountProof_Params) error, opts ...capnp.CallOption) ZarbServer_getAccountProof_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getAccountProof_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      8,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getAccountProof",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getAccountProof_Params{Struct: s}) }
+	}
+	return ZarbServer_getAccountProof_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetValidatorProof(ctx context.Context, params func(ZarbServer_getValidatorProof_Params) error, opts ...capnp.CallOption) ZarbServer_getValidatorProof_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getValidatorProof_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      9,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getValidatorProof",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getValidatorProof_Params{Struct: s}) }
+	}
+	return ZarbServer_getValidatorProof_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetTransactionProof(ctx context.Context, params func(ZarbServer_getTransactionProof_Params) error, opts ...capnp.CallOption) ZarbServer_getTransactionProof_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getTransactionProof_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      10,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getTransactionProof",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getTransactionProof_Params{Struct: s}) }
+	}
+	return ZarbServer_getTransactionProof_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+
+type ZarbServer_Server interface {
+	GetBlockchainInfo(ZarbServer_getBlockchainInfo) error
+
+	GetBlock(ZarbServer_getBlock) error
+
+	GetTransaction(ZarbServer_getTransaction) error
+
+	GetBlockHeight(ZarbServer_getBlockHeight) error
+
+	GetAccount(ZarbServer_getAccount) error
+
+	GetValidator(ZarbServer_getValidator) error
+
+	SendRawTransaction(ZarbServer_sendRawTransaction) error
+
+	DryRunTransaction(ZarbServer_dryRunTransaction) error
+
+	GetAccountProof(ZarbServer_getAccountProof) error
+
+	GetValidatorProof(ZarbServer_getValidatorProof) error
+
+	GetTransactionProof(ZarbServer_getTransactionProof) error
+}
+
+func ZarbServer_ServerToClient(s ZarbServer_Server) ZarbServer {
+	c, _ := s.(server.Closer)
+	return ZarbServer{Client: server.New(ZarbServer_Methods(nil, s), c)}
+}
+
+func ZarbServer_Methods(methods []server.Method, s ZarbServer_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 11)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      0,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getBlockchainInfo",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getBlockchainInfo{c, opts, ZarbServer_getBlockchainInfo_Params{Struct: p}, ZarbServer_getBlockchainInfo_Results{Struct: r}}
+			return s.GetBlockchainInfo(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      1,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getBlock",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getBlock{c, opts, ZarbServer_getBlock_Params{Struct: p}, ZarbServer_getBlock_Results{Struct: r}}
+			return s.GetBlock(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      2,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getTransaction",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getTransaction{c, opts, ZarbServer_getTransaction_Params{Struct: p}, ZarbServer_getTransaction_Results{Struct: r}}
+			return s.GetTransaction(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      3,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getBlockHeight",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getBlockHeight{c, opts, ZarbServer_getBlockHeight_Params{Struct: p}, ZarbServer_getBlockHeight_Results{Struct: r}}
+			return s.GetBlockHeight(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      4,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getAccount",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getAccount{c, opts, ZarbServer_getAccount_Params{Struct: p}, ZarbServer_getAccount_Results{Struct: r}}
+			return s.GetAccount(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      5,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getValidator",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getValidator{c, opts, ZarbServer_getValidator_Params{Struct: p}, ZarbServer_getValidator_Results{Struct: r}}
+			return s.GetValidator(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      6,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "sendRawTransaction",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_sendRawTransaction{c, opts, ZarbServer_sendRawTransaction_Params{Struct: p}, ZarbServer_sendRawTransaction_Results{Struct: r}}
+			return s.SendRawTransaction(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      7,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "dryRunTransaction",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_dryRunTransaction{c, opts, ZarbServer_dryRunTransaction_Params{Struct: p}, ZarbServer_dryRunTransaction_Results{Struct: r}}
+			return s.DryRunTransaction(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      8,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getAccountProof",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getAccountProof{c, opts, ZarbServer_getAccountProof_Params{Struct: p}, ZarbServer_getAccountProof_Results{Struct: r}}
+			return s.GetAccountProof(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      9,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getValidatorProof",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getValidatorProof{c, opts, ZarbServer_getValidatorProof_Params{Struct: p}, ZarbServer_getValidatorProof_Results{Struct: r}}
+			return s.GetValidatorProof(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      10,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getTransactionProof",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getTransactionProof{c, opts, ZarbServer_getTransactionProof_Params{Struct: p}, ZarbServer_getTransactionProof_Results{Struct: r}}
+			return s.GetTransactionProof(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	return methods
+}
+
+// ZarbServer_getBlockchainInfo holds the arguments for a server call to ZarbServer.getBlockchainInfo.
+type ZarbServer_getBlockchainInfo struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getBlockchainInfo_Params
+	Results ZarbServer_getBlockchainInfo_Results
+}
+
+// ZarbServer_getBlock holds the arguments for a server call to ZarbServer.getBlock.
+type ZarbServer_getBlock struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getBlock_Params
+	Results ZarbServer_getBlock_Results
+}
+
+// ZarbServer_getTransaction holds the arguments for a server call to ZarbServer.getTransaction.
+type ZarbServer_getTransaction struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getTransaction_Params
+	Results ZarbServer_getTransaction_Results
+}
+
+// ZarbServer_getBlockHeight holds the arguments for a server call to ZarbServer.getBlockHeight.
+type ZarbServer_getBlockHeight struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getBlockHeight_Params
+	Results ZarbServer_getBlockHeight_Results
+}
+
+// ZarbServer_getAccount holds the arguments for a server call to ZarbServer.getAccount.
+type ZarbServer_getAccount struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getAccount_Params
+	Results ZarbServer_getAccount_Results
+}
+
+// ZarbServer_getValidator holds the arguments for a server call to ZarbServer.getValidator.
+type ZarbServer_getValidator struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getValidator_Params
+	Results ZarbServer_getValidator_Results
+}
+
+// ZarbServer_sendRawTransaction holds the arguments for a server call to ZarbServer.sendRawTransaction.
+type ZarbServer_sendRawTransaction struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_sendRawTransaction_Params
+	Results ZarbServer_sendRawTransaction_Results
+}
+
+// ZarbServer_dryRunTransaction holds the arguments for a server call to ZarbServer.dryRunTransaction.
+type ZarbServer_dryRunTransaction struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_dryRunTransaction_Params
+	Results ZarbServer_dryRunTransaction_Results
+}
+
+// ZarbServer_getAccountProof holds the arguments for a server call to ZarbServer.getAccountProof.
+type ZarbServer_getAccountProof struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getAccountProof_Params
+	Results ZarbServer_getAccountProof_Results
+}
+
+// ZarbServer_getValidatorProof holds the arguments for a server call to ZarbServer.getValidatorProof.
+type ZarbServer_getValidatorProof struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getValidatorProof_Params
+	Results ZarbServer_getValidatorProof_Results
+}
+
+// ZarbServer_getTransactionProof holds the arguments for a server call to ZarbServer.getTransactionProof.
+type ZarbServer_getTransactionProof struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getTransactionProof_Params
+	Results ZarbServer_getTransactionProof_Results
+}
+
+type ZarbServer_getBlockchainInfo_Params struct{ capnp.Struct }
+
+// ZarbServer_getBlockchainInfo_Params_TypeID is the unique identifier for the type ZarbServer_getBlockchainInfo_Params.
+const ZarbServer_getBlockchainInfo_Params_TypeID = 0x8df1c729f8d2ca00
+
+func NewZarbServer_getBlockchainInfo_Params(s *capnp.Segment) (ZarbServer_getBlockchainInfo_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ZarbServer_getBlockchainInfo_Params{st}, err
+}
+
+func NewRootZarbServer_getBlockchainInfo_Params(s *capnp.Segment) (ZarbServer_getBlockchainInfo_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ZarbServer_getBlockchainInfo_Params{st}, err
+}
+
+func ReadRootZarbServer_getBlockchainInfo_Params(msg *capnp.Message) (ZarbServer_getBlockchainInfo_Params, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getBlockchainInfo_Params{root.Struct()}, err
+}
+
+func (s ZarbServer_getBlockchainInfo_Params) String() string {
+	str, _ := text.Marshal(0x8df1c729f8d2ca00, s.Struct)
+	return str
+}
+
+// ZarbServer_getBlockchainInfo_Params_List is a list of ZarbServer_getBlockchainInfo_Params.
+type ZarbServer_getBlockchainInfo_Params_List struct{ capnp.List }
+
+// NewZarbServer_getBlockchainInfo_Params creates a new list of ZarbServer_getBlockchainInfo_Params.
+func NewZarbServer_getBlockchainInfo_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getBlockchainInfo_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return ZarbServer_getBlockchainInfo_Params_List{l}, err
+}
+
+func (s ZarbServer_getBlockchainInfo_Params_List) At(i int) ZarbServer_getBlockchainInfo_Params {
+	return ZarbServer_getBlockchainInfo_Params{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getBlockchainInfo_Params_List) Set(i int, v ZarbServer_getBlockchainInfo_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getBlockchainInfo_Params_List) String() string {
+	str, _ := text.MarshalList(0x8df1c729f8d2ca00, s.List)
+	return str
+}
+
+// ZarbServer_getBlockchainInfo_Params_Promise is a wrapper for a ZarbServer_getBlockchainInfo_Params promised by a client call.
+type ZarbServer_getBlockchainInfo_Params_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getBlockchainInfo_Params_Promise) Struct() (ZarbServer_getBlockchainInfo_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getBlockchainInfo_Params{s}, err
+}
+
+type ZarbServer_getBlockchainInfo_Results struct{ capnp.Struct }
+
+// ZarbServer_getBlockchainInfo_Results_TypeID is the unique identifier for the type ZarbServer_getBlockchainInfo_Results.
+const ZarbServer_getBlockchainInfo_Results_TypeID = 0xf5e8509c82a71e1c
+
+func NewZarbServer_getBlockchainInfo_Results(s *capnp.Segment) (ZarbServer_getBlockchainInfo_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getBlockchainInfo_Results{st}, err
+}
+
+func NewRootZarbServer_getBlockchainInfo_Results(s *capnp.Segment) (ZarbServer_getBlockchainInfo_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getBlockchainInfo_Results{st}, err
+}
+
+func ReadRootZarbServer_getBlockchainInfo_Results(msg *capnp.Message) (ZarbServer_getBlockchainInfo_Results, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getBlockchainInfo_Results{root.Struct()}, err
+}
+
+func (s ZarbServer_getBlockchainInfo_Results) String() string {
+	str, _ := text.Marshal(0xf5e8509c82a71e1c, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getBlockchainInfo_Results) Result() (BlockchainResult, error) {
+	p, err := s.Struct.Ptr(0)
+	return BlockchainResult{Struct: p.Struct()}, err
+}
+
+func (s ZarbServer_getBlockchainInfo_Results) HasResult() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getBlockchainInfo_Results) SetResult(v BlockchainResult) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResult sets the result field to a newly
+// allocated BlockchainResult struct, preferring placement in s's segment.
+func (s ZarbServer_getBlockchainInfo_Results) NewResult() (BlockchainResult, error) {
+	ss, err := NewBlockchainResult(s.Struct.Segment())
+	if err != nil {
+		return BlockchainResult{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// ZarbServer_getBlockchainInfo_Results_List is a list of ZarbServer_getBlockchainInfo_Results.
+type ZarbServer_getBlockchainInfo_Results_List struct{ capnp.List }
+
+// NewZarbServer_getBlockchainInfo_Results creates a new list of ZarbServer_getBlockchainInfo_Results.
+func NewZarbServer_getBlockchainInfo_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getBlockchainInfo_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getBlockchainInfo_Results_List{l}, err
+}
+
+func (s ZarbServer_getBlockchainInfo_Results_List) At(i int) ZarbServer_getBlockchainInfo_Results {
+	return ZarbServer_getBlockchainInfo_Results{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getBlockchainInfo_Results_List) Set(i int, v ZarbServer_getBlockchainInfo_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getBlockchainInfo_Results_List) String() string {
+	str, _ := text.MarshalList(0xf5e8509c82a71e1c, s.List)
+	return str
+}
+
+// ZarbServer_getBlockchainInfo_Results_Promise is a wrapper for a ZarbServer_getBlockchainInfo_Results promised by a client call.
+type ZarbServer_getBlockchainInfo_Results_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getBlockchainInfo_Results_Promise) Struct() (ZarbServer_getBlockchainInfo_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getBlockchainInfo_Results{s}, err
+}
+
+func (p ZarbServer_getBlockchainInfo_Results_Promise) Result() BlockchainResult_Promise {
+	return BlockchainResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type ZarbServer_getBlock_Params struct{ capnp.Struct }
+
+// ZarbServer_getBlock_Params_TypeID is the unique identifier for the type ZarbServer_getBlock_Params.
+const ZarbServer_getBlock_Params_TypeID = 0x85252b1ec1c352d2
+
+func NewZarbServer_getBlock_Params(s *capnp.Segment) (ZarbServer_getBlock_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return ZarbServer_getBlock_Params{st}, err
+}
+
+func NewRootZarbServer_getBlock_Params(s *capnp.Segment) (ZarbServer_getBlock_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return ZarbServer_getBlock_Params{st}, err
+}
+
+func ReadRootZarbServer_getBlock_Params(msg *capnp.Message) (ZarbServer_getBlock_Params, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getBlock_Params{root.Struct()}, err
+}
+
+func (s ZarbServer_getBlock_Params) String() string {
+	str, _ := text.Marshal(0x85252b1ec1c352d2, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getBlock_Params) Height() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s ZarbServer_getBlock_Params) SetHeight(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s ZarbServer_getBlock_Params) Verbosity() int32 {
+	return int32(s.Struct.Uint32(8))
+}
+
+func (s ZarbServer_getBlock_Params) SetVerbosity(v int32) {
+	s.Struct.SetUint32(8, uint32(v))
+}
+
+// ZarbServer_getBlock_Params_List is a list of ZarbServer_getBlock_Params.
+type ZarbServer_getBlock_Params_List struct{ capnp.List }
+
+// NewZarbServer_getBlock_Params creates a new list of ZarbServer_getBlock_Params.
+func NewZarbServer_getBlock_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getBlock_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return ZarbServer_getBlock_Params_List{l}, err
+}
+
+func (s ZarbServer_getBlock_Params_List) At(i int) ZarbServer_getBlock_Params {
+	return ZarbServer_getBlock_Params{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getBlock_Params_List) Set(i int, v ZarbServer_getBlock_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getBlock_Params_List) String() string {
+	str, _ := text.MarshalList(0x85252b1ec1c352d2, s.List)
+	return str
+}
+
+// ZarbServer_getBlock_Params_Promise is a wrapper for a ZarbServer_getBlock_Params promised by a client call.
+type ZarbServer_getBlock_Params_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getBlock_Params_Promise) Struct() (ZarbServer_getBlock_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getBlock_Params{s}, err
+}
+
+type ZarbServer_getBlock_Results struct{ capnp.Struct }
+
+// ZarbServer_getBlock_Results_TypeID is the unique identifier for the type ZarbServer_getBlock_Results.
+const ZarbServer_getBlock_Results_TypeID = 0x946b1f715eac1308
+
+func NewZarbServer_getBlock_Results(s *capnp.Segment) (ZarbServer_getBlock_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getBlock_Results{st}, err
+}
+
+func NewRootZarbServer_getBlock_Results(s *capnp.Segment) (ZarbServer_getBlock_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getBlock_Results{st}, err
+}
+
+func ReadRootZarbServer_getBlock_Results(msg *capnp.Message) (ZarbServer_getBlock_Results, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getBlock_Results{root.Struct()}, err
+}
+
+func (s ZarbServer_getBlock_Results) String() string {
+	str, _ := text.Marshal(0x946b1f715eac1308, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getBlock_Results) Result() (BlockResult, error) {
+	p, err := s.Struct.Ptr(0)
+	return BlockResult{Struct: p.Struct()}, err
+}
+
+func (s ZarbServer_getBlock_Results) HasResult() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getBlock_Results) SetResult(v BlockResult) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResult sets the result field to a newly
+// allocated BlockResult struct, preferring placement in s's segment.
+func (s ZarbServer_getBlock_Results) NewResult() (BlockResult, error) {
+	ss, err := NewBlockResult(s.Struct.Segment())
+	if err != nil {
+		return BlockResult{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// ZarbServer_getBlock_Results_List is a list of ZarbServer_getBlock_Results.
+type ZarbServer_getBlock_Results_List struct{ capnp.List }
+
+// NewZarbServer_getBlock_Results creates a new list of ZarbServer_getBlock_Results.
+func NewZarbServer_getBlock_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getBlock_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getBlock_Results_List{l}, err
+}
+
+func (s ZarbServer_getBlock_Results_List) At(i int) ZarbServer_getBlock_Results {
+	return ZarbServer_getBlock_Results{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getBlock_Results_List) Set(i int, v ZarbServer_getBlock_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getBlock_Results_List) String() string {
+	str, _ := text.MarshalList(0x946b1f715eac1308, s.List)
+	return str
+}
+
+// ZarbServer_getBlock_Results_Promise is a wrapper for a ZarbServer_getBlock_Results promised by a client call.
+type ZarbServer_getBlock_Results_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getBlock_Results_Promise) Struct() (ZarbServer_getBlock_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getBlock_Results{s}, err
+}
+
+func (p ZarbServer_getBlock_Results_Promise) Result() BlockResult_Promise {
+	return BlockResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type ZarbServer_getTransaction_Params struct{ capnp.Struct }
+
+// ZarbServer_getTransaction_Params_TypeID is the unique identifier for the type ZarbServer_getTransaction_Params.
+const ZarbServer_getTransaction_Params_TypeID = 0xd3df8a6125925ab9
+
+func NewZarbServer_getTransaction_Params(s *capnp.Segment) (ZarbServer_getTransaction_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ZarbServer_getTransaction_Params{st}, err
+}
+
+func NewRootZarbServer_getTransaction_Params(s *capnp.Segment) (ZarbServer_getTransaction_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ZarbServer_getTransaction_Params{st}, err
+}
+
+func ReadRootZarbServer_getTransaction_Params(msg *capnp.Message) (ZarbServer_getTransaction_Params, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getTransaction_Params{root.Struct()}, err
+}
+
+func (s ZarbServer_getTransaction_Params) String() string {
+	str, _ := text.Marshal(0xd3df8a6125925ab9, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getTransaction_Params) Hash() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s ZarbServer_getTransaction_Params) HasHash() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getTransaction_Params) SetHash(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s ZarbServer_getTransaction_Params) Verbosity() int32 {
+	return int32(s.Struct.Uint32(0))
+}
+
+func (s ZarbServer_getTransaction_Params) SetVerbosity(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
+
+// ZarbServer_getTransaction_Params_List is a list of ZarbServer_getTransaction_Params.
+type ZarbServer_getTransaction_Params_List struct{ capnp.List }
+
+// NewZarbServer_getTransaction_Params creates a new list of ZarbServer_getTransaction_Params.
+func NewZarbServer_getTransaction_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getTransaction_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return ZarbServer_getTransaction_Params_List{l}, err
+}
+
+func (s ZarbServer_getTransaction_Params_List) At(i int) ZarbServer_getTransaction_Params {
+	return ZarbServer_getTransaction_Params{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getTransaction_Params_List) Set(i int, v ZarbServer_getTransaction_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getTransaction_Params_List) String() string {
+	str, _ := text.MarshalList(0xd3df8a6125925ab9, s.List)
+	return str
+}
+
+// ZarbServer_getTransaction_Params_Promise is a wrapper for a ZarbServer_getTransaction_Params promised by a client call.
+type ZarbServer_getTransaction_Params_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getTransaction_Params_Promise) Struct() (ZarbServer_getTransaction_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getTransaction_Params{s}, err
+}
+
+type ZarbServer_getTransaction_Results struct{ capnp.Struct }
+
+// ZarbServer_getTransaction_Results_TypeID is the unique identifier for the type ZarbServer_getTransaction_Results.
+const ZarbServer_getTransaction_Results_TypeID = 0xa2b1016cefab775b
+
+func NewZarbServer_getTransaction_Results(s *capnp.Segment) (ZarbServer_getTransaction_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getTransaction_Results{st}, err
+}
+
+func NewRootZarbServer_getTransaction_Results(s *capnp.Segment) (ZarbServer_getTransaction_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getTransaction_Results{st}, err
+}
+
+func ReadRootZarbServer_getTransaction_Results(msg *capnp.Message) (ZarbServer_getTransaction_Results, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getTransaction_Results{root.Struct()}, err
+}
+
+func (s ZarbServer_getTransaction_Results) String() string {
+	str, _ := text.Marshal(0xa2b1016cefab775b, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getTransaction_Results) Result() (TransactionResult, error) {
+	p, err := s.Struct.Ptr(0)
+	return TransactionResult{Struct: p.Struct()}, err
+}
+
+func (s ZarbServer_getTransaction_Results) HasResult() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getTransaction_Results) SetResult(v TransactionResult) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResult sets the result field to a newly
+// allocated TransactionResult struct, preferring placement in s's segment.
+func (s ZarbServer_getTransaction_Results) NewResult() (TransactionResult, error) {
+	ss, err := NewTransactionResult(s.Struct.Segment())
+	if err != nil {
+		return TransactionResult{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// ZarbServer_getTransaction_Results_List is a list of ZarbServer_getTransaction_Results.
+type ZarbServer_getTransaction_Results_List struct{ capnp.List }
+
+// NewZarbServer_getTransaction_Results creates a new list of ZarbServer_getTransaction_Results.
+func NewZarbServer_getTransaction_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getTransaction_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getTransaction_Results_List{l}, err
+}
+
+func (s ZarbServer_getTransaction_Results_List) At(i int) ZarbServer_getTransaction_Results {
+	return ZarbServer_getTransaction_Results{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getTransaction_Results_List) Set(i int, v ZarbServer_getTransaction_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getTransaction_Results_List) String() string {
+	str, _ := text.MarshalList(0xa2b1016cefab775b, s.List)
+	return str
+}
+
+// ZarbServer_getTransaction_Results_Promise is a wrapper for a ZarbServer_getTransaction_Results promised by a client call.
+type ZarbServer_getTransaction_Results_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getTransaction_Results_Promise) Struct() (ZarbServer_getTransaction_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getTransaction_Results{s}, err
+}
+
+func (p ZarbServer_getTransaction_Results_Promise) Result() TransactionResult_Promise {
+	return TransactionResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type ZarbServer_getBlockHeight_Params struct{ capnp.Struct }
+
+// ZarbServer_getBlockHeight_Params_TypeID is the unique identifier for the type ZarbServer_getBlockHeight_Params.
+const ZarbServer_getBlockHeight_Params_TypeID = 0xcd6c734787642800
+
+func NewZarbServer_getBlockHeight_Params(s *capnp.Segment) (ZarbServer_getBlockHeight_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getBlockHeight_Params{st}, err
+}
+
+func NewRootZarbServer_getBlockHeight_Params(s *capnp.Segment) (ZarbServer_getBlockHeight_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getBlockHeight_Params{st}, err
+}
+
+func ReadRootZarbServer_getBlockHeight_Params(msg *capnp.Message) (ZarbServer_getBlockHeight_Params, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getBlockHeight_Params{root.Struct()}, err
+}
+
+func (s ZarbServer_getBlockHeight_Params) String() string {
+	str, _ := text.Marshal(0xcd6c734787642800, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getBlockHeight_Params) Hash() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s ZarbServer_getBlockHeight_Params) HasHash() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getBlockHeight_Params) SetHash(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+// ZarbServer_getBlockHeight_Params_List is a list of ZarbServer_getBlockHeight_Params.
+type ZarbServer_getBlockHeight_Params_List struct{ capnp.List }
+
+// NewZarbServer_getBlockHeight_Params creates a new list of ZarbServer_getBlockHeight_Params.
+func NewZarbServer_getBlockHeight_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getBlockHeight_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getBlockHeight_Params_List{l}, err
+}
+
+func (s ZarbServer_getBlockHeight_Params_List) At(i int) ZarbServer_getBlockHeight_Params {
+	return ZarbServer_getBlockHeight_Params{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getBlockHeight_Params_List) Set(i int, v ZarbServer_getBlockHeight_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getBlockHeight_Params_List) String() string {
+	str, _ := text.MarshalList(0xcd6c734787642800, s.List)
+	return str
+}
+
+// ZarbServer_getBlockHeight_Params_Promise is a wrapper for a ZarbServer_getBlockHeight_Params promised by a client call.
+type ZarbServer_getBlockHeight_Params_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getBlockHeight_Params_Promise) Struct() (ZarbServer_getBlockHeight_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getBlockHeight_Params{s}, err
+}
+
+type ZarbServer_getBlockHeight_Results struct{ capnp.Struct }
+
+// ZarbServer_getBlockHeight_Results_TypeID is the unique identifier for the type ZarbServer_getBlockHeight_Results.
+const ZarbServer_getBlockHeight_Results_TypeID = 0x8e979661cc6a1161
+
+func NewZarbServer_getBlockHeight_Results(s *capnp.Segment) (ZarbServer_getBlockHeight_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ZarbServer_getBlockHeight_Results{st}, err
+}
+
+func NewRootZarbServer_getBlockHeight_Results(s *capnp.Segment) (ZarbServer_getBlockHeight_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ZarbServer_getBlockHeight_Results{st}, err
+}
+
+func ReadRootZarbServer_getBlockHeight_Results(msg *capnp.Message) (ZarbServer_getBlockHeight_Results, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getBlockHeight_Results{root.Struct()}, err
+}
+
+func (s ZarbServer_getBlockHeight_Results) String() string {
+	str, _ := text.Marshal(0x8e979661cc6a1161, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getBlockHeight_Results) Result() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s ZarbServer_getBlockHeight_Results) SetResult(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+// ZarbServer_getBlockHeight_Results_List is a list of ZarbServer_getBlockHeight_Results.
+type ZarbServer_getBlockHeight_Results_List struct{ capnp.List }
+
+// NewZarbServer_getBlockHeight_Results creates a new list of ZarbServer_getBlockHeight_Results.
+func NewZarbServer_getBlockHeight_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getBlockHeight_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return ZarbServer_getBlockHeight_Results_List{l}, err
+}
+
+func (s ZarbServer_getBlockHeight_Results_List) At(i int) ZarbServer_getBlockHeight_Results {
+	return ZarbServer_getBlockHeight_Results{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getBlockHeight_Results_List) Set(i int, v ZarbServer_getBlockHeight_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getBlockHeight_Results_List) String() string {
+	str, _ := text.MarshalList(0x8e979661cc6a1161, s.List)
+	return str
+}
+
+// ZarbServer_getBlockHeight_Results_Promise is a wrapper for a ZarbServer_getBlockHeight_Results promised by a client call.
+type ZarbServer_getBlockHeight_Results_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getBlockHeight_Results_Promise) Struct() (ZarbServer_getBlockHeight_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getBlockHeight_Results{s}, err
+}
+
+type ZarbServer_getAccount_Params struct{ capnp.Struct }
+
+// ZarbServer_getAccount_Params_TypeID is the unique identifier for the type ZarbServer_getAccount_Params.
+const ZarbServer_getAccount_Params_TypeID = 0xeed94cf76be61d8e
+
+func NewZarbServer_getAccount_Params(s *capnp.Segment) (ZarbServer_getAccount_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ZarbServer_getAccount_Params{st}, err
+}
+
+func NewRootZarbServer_getAccount_Params(s *capnp.Segment) (ZarbServer_getAccount_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ZarbServer_getAccount_Params{st}, err
+}
+
+func ReadRootZarbServer_getAccount_Params(msg *capnp.Message) (ZarbServer_getAccount_Params, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getAccount_Params{root.Struct()}, err
+}
+
+func (s ZarbServer_getAccount_Params) String() string {
+	str, _ := text.Marshal(0xeed94cf76be61d8e, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getAccount_Params) Address() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s ZarbServer_getAccount_Params) HasAddress() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getAccount_Params) SetAddress(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s ZarbServer_getAccount_Params) Verbosity() int32 {
+	return int32(s.Struct.Uint32(0))
+}
+
+func (s ZarbServer_getAccount_Params) SetVerbosity(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
+
+// ZarbServer_getAccount_Params_List is a list of ZarbServer_getAccount_Params.
+type ZarbServer_getAccount_Params_List struct{ capnp.List }
+
+// NewZarbServer_getAccount_Params creates a new list of ZarbServer_getAccount_Params.
+func NewZarbServer_getAccount_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getAccount_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return ZarbServer_getAccount_Params_List{l}, err
+}
+
+func (s ZarbServer_getAccount_Params_List) At(i int) ZarbServer_getAccount_Params {
+	return ZarbServer_getAccount_Params{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getAccount_Params_List) Set(i int, v ZarbServer_getAccount_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getAccount_Params_List) String() string {
+	str, _ := text.MarshalList(0xeed94cf76be61d8e, s.List)
+	return str
+}
+
+// ZarbServer_getAccount_Params_Promise is a wrapper for a ZarbServer_getAccount_Params promised by a client call.
+type ZarbServer_getAccount_Params_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getAccount_Params_Promise) Struct() (ZarbServer_getAccount_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getAccount_Params{s}, err
+}
+
+type ZarbServer_getAccount_Results struct{ capnp.Struct }
+
+// ZarbServer_getAccount_Results_TypeID is the unique identifier for the type ZarbServer_getAccount_Results.
+const ZarbServer_getAccount_Results_TypeID = 0xfb42d1f26b074c15
+
+func NewZarbServer_getAccount_Results(s *capnp.Segment) (ZarbServer_getAccount_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getAccount_Results{st}, err
+}
+
+func NewRootZarbServer_getAccount_Results(s *capnp.Segment) (ZarbServer_getAccount_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getAccount_Results{st}, err
+}
+
+func ReadRootZarbServer_getAccount_Results(msg *capnp.Message) (ZarbServer_getAccount_Results, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getAccount_Results{root.Struct()}, err
+}
+
+func (s ZarbServer_getAccount_Results) String() string {
+	str, _ := text.Marshal(0xfb42d1f26b074c15, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getAccount_Results) Result() (AccountResult, error) {
+	p, err := s.Struct.Ptr(0)
+	return AccountResult{Struct: p.Struct()}, err
+}
+
+func (s ZarbServer_getAccount_Results) HasResult() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getAccount_Results) SetResult(v AccountResult) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResult sets the result field to a newly
+// allocated AccountResult struct, preferring placement in s's segment.
+func (s ZarbServer_getAccount_Results) NewResult() (AccountResult, error) {
+	ss, err := NewAccountResult(s.Struct.Segment())
+	if err != nil {
+		return AccountResult{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// ZarbServer_getAccount_Results_List is a list of ZarbServer_getAccount_Results.
+type ZarbServer_getAccount_Results_List struct{ capnp.List }
+
+// NewZarbServer_getAccount_Results creates a new list of ZarbServer_getAccount_Results.
+func NewZarbServer_getAccount_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getAccount_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getAccount_Results_List{l}, err
+}
+
+func (s ZarbServer_getAccount_Results_List) At(i int) ZarbServer_getAccount_Results {
+	return ZarbServer_getAccount_Results{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getAccount_Results_List) Set(i int, v ZarbServer_getAccount_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getAccount_Results_List) String() string {
+	str, _ := text.MarshalList(0xfb42d1f26b074c15, s.List)
+	return str
+}
+
+// ZarbServer_getAccount_Results_Promise is a wrapper for a ZarbServer_getAccount_Results promised by a client call.
+type ZarbServer_getAccount_Results_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getAccount_Results_Promise) Struct() (ZarbServer_getAccount_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getAccount_Results{s}, err
+}
+
+func (p ZarbServer_getAccount_Results_Promise) Result() AccountResult_Promise {
+	return AccountResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type ZarbServer_getValidator_Params struct{ capnp.Struct }
+
+// ZarbServer_getValidator_Params_TypeID is the unique identifier for the type ZarbServer_getValidator_Params.
+const ZarbServer_getValidator_Params_TypeID = 0x8fb41d4bd35c5a30
+
+func NewZarbServer_getValidator_Params(s *capnp.Segment) (ZarbServer_getValidator_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ZarbServer_getValidator_Params{st}, err
+}
+
+func NewRootZarbServer_getValidator_Params(s *capnp.Segment) (ZarbServer_getValidator_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ZarbServer_getValidator_Params{st}, err
+}
+
+func ReadRootZarbServer_getValidator_Params(msg *capnp.Message) (ZarbServer_getValidator_Params, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getValidator_Params{root.Struct()}, err
+}
+
+func (s ZarbServer_getValidator_Params) String() string {
+	str, _ := text.Marshal(0x8fb41d4bd35c5a30, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getValidator_Params) Address() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s ZarbServer_getValidator_Params) HasAddress() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-func NewRootZarbServer_getBlockchainInfo_Params(s *capnp.Segment) (ZarbServer_getBlockchainInfo_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return ZarbServer_getBlockchainInfo_Params{st}, err
+func (s ZarbServer_getValidator_Params) SetAddress(v []byte) error {
+	return s.Struct.SetData(0, v)
 }
 
-func ReadRootZarbServer_getBlockchainInfo_Params(msg *capnp.Message) (ZarbServer_getBlockchainInfo_Params, error) {
-	root, err := msg.RootPtr()
-	return ZarbServer_getBlockchainInfo_Params{root.Struct()}, err
+func (s ZarbServer_getValidator_Params) Verbosity() int32 {
+	return int32(s.Struct.Uint32(0))
 }
 
-func (s ZarbServer_getBlockchainInfo_Params) String() string {
-	str, _ := text.Marshal(0x8df1c729f8d2ca00, s.Struct)
-	return str
+func (s ZarbServer_getValidator_Params) SetVerbosity(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
 }
 
-// ZarbServer_getBlockchainInfo_Params_List is a list of ZarbServer_getBlockchainInfo_Params.
-type ZarbServer_getBlockchainInfo_Params_List struct{ capnp.List }
+// ZarbServer_getValidator_Params_List is a list of ZarbServer_getValidator_Params.
+type ZarbServer_getValidator_Params_List struct{ capnp.List }
 
-// NewZarbServer_getBlockchainInfo_Params creates a new list of ZarbServer_getBlockchainInfo_Params.
-func NewZarbServer_getBlockchainInfo_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getBlockchainInfo_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return ZarbServer_getBlockchainInfo_Params_List{l}, err
+// NewZarbServer_getValidator_Params creates a new list of ZarbServer_getValidator_Params.
+func NewZarbServer_getValidator_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getValidator_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return ZarbServer_getValidator_Params_List{l}, err
 }
 
-func (s ZarbServer_getBlockchainInfo_Params_List) At(i int) ZarbServer_getBlockchainInfo_Params {
-	return ZarbServer_getBlockchainInfo_Params{s.List.Struct(i)}
+func (s ZarbServer_getValidator_Params_List) At(i int) ZarbServer_getValidator_Params {
+	return ZarbServer_getValidator_Params{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getBlockchainInfo_Params_List) Set(i int, v ZarbServer_getBlockchainInfo_Params) error {
+func (s ZarbServer_getValidator_Params_List) Set(i int, v ZarbServer_getValidator_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getBlockchainInfo_Params_List) String() string {
-	str, _ := text.MarshalList(0x8df1c729f8d2ca00, s.List)
+func (s ZarbServer_getValidator_Params_List) String() string {
+	str, _ := text.MarshalList(0x8fb41d4bd35c5a30, s.List)
 	return str
 }
 
-// ZarbServer_getBlockchainInfo_Params_Promise is a wrapper for a ZarbServer_getBlockchainInfo_Params promised by a client call.
-type ZarbServer_getBlockchainInfo_Params_Promise struct{ *capnp.Pipeline }
+// ZarbServer_getValidator_Params_Promise is a wrapper for a ZarbServer_getValidator_Params promised by a client call.
+type ZarbServer_getValidator_Params_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getBlockchainInfo_Params_Promise) Struct() (ZarbServer_getBlockchainInfo_Params, error) {
+func (p ZarbServer_getValidator_Params_Promise) Struct() (ZarbServer_getValidator_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getBlockchainInfo_Params{s}, err
+	return ZarbServer_getValidator_Params{s}, err
 }
 
-type ZarbServer_getBlockchainInfo_Results struct{ capnp.Struct }
+type ZarbServer_getValidator_Results struct{ capnp.Struct }
 
-// ZarbServer_getBlockchainInfo_Results_TypeID is the unique identifier for the type ZarbServer_getBlockchainInfo_Results.
-const ZarbServer_getBlockchainInfo_Results_TypeID = 0xf5e8509c82a71e1c
+// ZarbServer_getValidator_Results_TypeID is the unique identifier for the type ZarbServer_getValidator_Results.
+const ZarbServer_getValidator_Results_TypeID = 0xa128fe760c2612c4
 
-func NewZarbServer_getBlockchainInfo_Results(s *capnp.Segment) (ZarbServer_getBlockchainInfo_Results, error) {
+func NewZarbServer_getValidator_Results(s *capnp.Segment) (ZarbServer_getValidator_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getBlockchainInfo_Results{st}, err
+	return ZarbServer_getValidator_Results{st}, err
 }
 
-func NewRootZarbServer_getBlockchainInfo_Results(s *capnp.Segment) (ZarbServer_getBlockchainInfo_Results, error) {
+func NewRootZarbServer_getValidator_Results(s *capnp.Segment) (ZarbServer_getValidator_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getBlockchainInfo_Results{st}, err
+	return ZarbServer_getValidator_Results{st}, err
 }
 
-func ReadRootZarbServer_getBlockchainInfo_Results(msg *capnp.Message) (ZarbServer_getBlockchainInfo_Results, error) {
+func ReadRootZarbServer_getValidator_Results(msg *capnp.Message) (ZarbServer_getValidator_Results, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getBlockchainInfo_Results{root.Struct()}, err
+	return ZarbServer_getValidator_Results{root.Struct()}, err
 }
 
-func (s ZarbServer_getBlockchainInfo_Results) String() string {
-	str, _ := text.Marshal(0xf5e8509c82a71e1c, s.Struct)
+func (s ZarbServer_getValidator_Results) String() string {
+	str, _ := text.Marshal(0xa128fe760c2612c4, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getBlockchainInfo_Results) Result() (BlockchainResult, error) {
+func (s ZarbServer_getValidator_Results) Result() (ValidatorResult, error) {
 	p, err := s.Struct.Ptr(0)
-	return BlockchainResult{Struct: p.Struct()}, err
+	return ValidatorResult{Struct: p.Struct()}, err
 }
 
-func (s ZarbServer_getBlockchainInfo_Results) HasResult() bool {
+func (s ZarbServer_getValidator_Results) HasResult() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getBlockchainInfo_Results) SetResult(v BlockchainResult) error {
+func (s ZarbServer_getValidator_Results) SetResult(v ValidatorResult) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResult sets the result field to a newly
-// allocated BlockchainResult struct, preferring placement in s's segment.
-func (s ZarbServer_getBlockchainInfo_Results) NewResult() (BlockchainResult, error) {
-	ss, err := NewBlockchainResult(s.Struct.Segment())
+// allocated ValidatorResult struct, preferring placement in s's segment.
+func (s ZarbServer_getValidator_Results) NewResult() (ValidatorResult, error) {
+	ss, err := NewValidatorResult(s.Struct.Segment())
 	if err != nil {
-		return BlockchainResult{}, err
+		return ValidatorResult{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// ZarbServer_getBlockchainInfo_Results_List is a list of ZarbServer_getBlockchainInfo_Results.
-type ZarbServer_getBlockchainInfo_Results_List struct{ capnp.List }
+// ZarbServer_getValidator_Results_List is a list of ZarbServer_getValidator_Results.
+type ZarbServer_getValidator_Results_List struct{ capnp.List }
 
-// NewZarbServer_getBlockchainInfo_Results creates a new list of ZarbServer_getBlockchainInfo_Results.
-func NewZarbServer_getBlockchainInfo_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getBlockchainInfo_Results_List, error) {
+// NewZarbServer_getValidator_Results creates a new list of ZarbServer_getValidator_Results.
+func NewZarbServer_getValidator_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getValidator_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return ZarbServer_getBlockchainInfo_Results_List{l}, err
+	return ZarbServer_getValidator_Results_List{l}, err
 }
 
-func (s ZarbServer_getBlockchainInfo_Results_List) At(i int) ZarbServer_getBlockchainInfo_Results {
-	return ZarbServer_getBlockchainInfo_Results{s.List.Struct(i)}
+func (s ZarbServer_getValidator_Results_List) At(i int) ZarbServer_getValidator_Results {
+	return ZarbServer_getValidator_Results{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getBlockchainInfo_Results_List) Set(i int, v ZarbServer_getBlockchainInfo_Results) error {
+func (s ZarbServer_getValidator_Results_List) Set(i int, v ZarbServer_getValidator_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getBlockchainInfo_Results_List) String() string {
-	str, _ := text.MarshalList(0xf5e8509c82a71e1c, s.List)
+func (s ZarbServer_getValidator_Results_List) String() string {
+	str, _ := text.MarshalList(0xa128fe760c2612c4, s.List)
 	return str
 }
 
-// ZarbServer_getBlockchainInfo_Results_Promise is a wrapper for a ZarbServer_getBlockchainInfo_Results promised by a client call.
-type ZarbServer_getBlockchainInfo_Results_Promise struct{ *capnp.Pipeline }
+// ZarbServer_getValidator_Results_Promise is a wrapper for a ZarbServer_getValidator_Results promised by a client call.
+type ZarbServer_getValidator_Results_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getBlockchainInfo_Results_Promise) Struct() (ZarbServer_getBlockchainInfo_Results, error) {
+func (p ZarbServer_getValidator_Results_Promise) Struct() (ZarbServer_getValidator_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getBlockchainInfo_Results{s}, err
+	return ZarbServer_getValidator_Results{s}, err
 }
 
-func (p ZarbServer_getBlockchainInfo_Results_Promise) Result() BlockchainResult_Promise {
-	return BlockchainResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+func (p ZarbServer_getValidator_Results_Promise) Result() ValidatorResult_Promise {
+	return ValidatorResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
 }
 
-type ZarbServer_getBlock_Params struct{ capnp.Struct }
+type ZarbServer_sendRawTransaction_Params struct{ capnp.Struct }
 
-// ZarbServer_getBlock_Params_TypeID is the unique identifier for the type ZarbServer_getBlock_Params.
-const ZarbServer_getBlock_Params_TypeID = 0x85252b1ec1c352d2
+// ZarbServer_sendRawTransaction_Params_TypeID is the unique identifier for the type ZarbServer_sendRawTransaction_Params.
+const ZarbServer_sendRawTransaction_Params_TypeID = 0xe051a47070c97f9e
 
-func NewZarbServer_getBlock_Params(s *capnp.Segment) (ZarbServer_getBlock_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
-	return ZarbServer_getBlock_Params{st}, err
+func NewZarbServer_sendRawTransaction_Params(s *capnp.Segment) (ZarbServer_sendRawTransaction_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_sendRawTransaction_Params{st}, err
 }
 
-func NewRootZarbServer_getBlock_Params(s *capnp.Segment) (ZarbServer_getBlock_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
-	return ZarbServer_getBlock_Params{st}, err
+func NewRootZarbServer_sendRawTransaction_Params(s *capnp.Segment) (ZarbServer_sendRawTransaction_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_sendRawTransaction_Params{st}, err
 }
 
-func ReadRootZarbServer_getBlock_Params(msg *capnp.Message) (ZarbServer_getBlock_Params, error) {
+func ReadRootZarbServer_sendRawTransaction_Params(msg *capnp.Message) (ZarbServer_sendRawTransaction_Params, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getBlock_Params{root.Struct()}, err
+	return ZarbServer_sendRawTransaction_Params{root.Struct()}, err
 }
 
-func (s ZarbServer_getBlock_Params) String() string {
-	str, _ := text.Marshal(0x85252b1ec1c352d2, s.Struct)
+func (s ZarbServer_sendRawTransaction_Params) String() string {
+	str, _ := text.Marshal(0xe051a47070c97f9e, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getBlock_Params) Height() uint64 {
-	return s.Struct.Uint64(0)
-}
-
-func (s ZarbServer_getBlock_Params) SetHeight(v uint64) {
-	s.Struct.SetUint64(0, v)
+func (s ZarbServer_sendRawTransaction_Params) RawTx() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
 }
 
-func (s ZarbServer_getBlock_Params) Verbosity() int32 {
-	return int32(s.Struct.Uint32(8))
+func (s ZarbServer_sendRawTransaction_Params) HasRawTx() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getBlock_Params) SetVerbosity(v int32) {
-	s.Struct.SetUint32(8, uint32(v))
+func (s ZarbServer_sendRawTransaction_Params) SetRawTx(v []byte) error {
+	return s.Struct.SetData(0, v)
 }
 
-// ZarbServer_getBlock_Params_List is a list of ZarbServer_getBlock_Params.
-type ZarbServer_getBlock_Params_List struct{ capnp.List }
+// ZarbServer_sendRawTransaction_Params_List is a list of ZarbServer_sendRawTransaction_Params.
+type ZarbServer_sendRawTransaction_Params_List struct{ capnp.List }
 
-// NewZarbServer_getBlock_Params creates a new list of ZarbServer_getBlock_Params.
-func NewZarbServer_getBlock_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getBlock_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
-	return ZarbServer_getBlock_Params_List{l}, err
+// NewZarbServer_sendRawTransaction_Params creates a new list of ZarbServer_sendRawTransaction_Params.
+func NewZarbServer_sendRawTransaction_Params_List(s *capnp.Segment, sz int32) (ZarbServer_sendRawTransaction_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_sendRawTransaction_Params_List{l}, err
 }
 
-func (s ZarbServer_getBlock_Params_List) At(i int) ZarbServer_getBlock_Params {
-	return ZarbServer_getBlock_Params{s.List.Struct(i)}
+func (s ZarbServer_sendRawTransaction_Params_List) At(i int) ZarbServer_sendRawTransaction_Params {
+	return ZarbServer_sendRawTransaction_Params{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getBlock_Params_List) Set(i int, v ZarbServer_getBlock_Params) error {
+func (s ZarbServer_sendRawTransaction_Params_List) Set(i int, v ZarbServer_sendRawTransaction_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getBlock_Params_List) String() string {
-	str, _ := text.MarshalList(0x85252b1ec1c352d2, s.List)
+func (s ZarbServer_sendRawTransaction_Params_List) String() string {
+	str, _ := text.MarshalList(0xe051a47070c97f9e, s.List)
 	return str
 }
 
-// ZarbServer_getBlock_Params_Promise is a wrapper for a ZarbServer_getBlock_Params promised by a client call.
-type ZarbServer_getBlock_Params_Promise struct{ *capnp.Pipeline }
+// ZarbServer_sendRawTransaction_Params_Promise is a wrapper for a ZarbServer_sendRawTransaction_Params promised by a client call.
+type ZarbServer_sendRawTransaction_Params_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getBlock_Params_Promise) Struct() (ZarbServer_getBlock_Params, error) {
+func (p ZarbServer_sendRawTransaction_Params_Promise) Struct() (ZarbServer_sendRawTransaction_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getBlock_Params{s}, err
+	return ZarbServer_sendRawTransaction_Params{s}, err
 }
 
-type ZarbServer_getBlock_Results struct{ capnp.Struct }
+type ZarbServer_sendRawTransaction_Results struct{ capnp.Struct }
 
-// ZarbServer_getBlock_Results_TypeID is the unique identifier for the type ZarbServer_getBlock_Results.
-const ZarbServer_getBlock_Results_TypeID = 0x946b1f715eac1308
+// ZarbServer_sendRawTransaction_Results_TypeID is the unique identifier for the type ZarbServer_sendRawTransaction_Results.
+const ZarbServer_sendRawTransaction_Results_TypeID = 0x8d7ad02d9eab8fb7
 
-func NewZarbServer_getBlock_Results(s *capnp.Segment) (ZarbServer_getBlock_Results, error) {
+func NewZarbServer_sendRawTransaction_Results(s *capnp.Segment) (ZarbServer_sendRawTransaction_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getBlock_Results{st}, err
+	return ZarbServer_sendRawTransaction_Results{st}, err
 }
 
-func NewRootZarbServer_getBlock_Results(s *capnp.Segment) (ZarbServer_getBlock_Results, error) {
+func NewRootZarbServer_sendRawTransaction_Results(s *capnp.Segment) (ZarbServer_sendRawTransaction_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getBlock_Results{st}, err
+	return ZarbServer_sendRawTransaction_Results{st}, err
 }
 
-func ReadRootZarbServer_getBlock_Results(msg *capnp.Message) (ZarbServer_getBlock_Results, error) {
+func ReadRootZarbServer_sendRawTransaction_Results(msg *capnp.Message) (ZarbServer_sendRawTransaction_Results, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getBlock_Results{root.Struct()}, err
+	return ZarbServer_sendRawTransaction_Results{root.Struct()}, err
 }
 
-func (s ZarbServer_getBlock_Results) String() string {
-	str, _ := text.Marshal(0x946b1f715eac1308, s.Struct)
+func (s ZarbServer_sendRawTransaction_Results) String() string {
+	str, _ := text.Marshal(0x8d7ad02d9eab8fb7, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getBlock_Results) Result() (BlockResult, error) {
+func (s ZarbServer_sendRawTransaction_Results) Result() (SendTransactionResult, error) {
 	p, err := s.Struct.Ptr(0)
-	return BlockResult{Struct: p.Struct()}, err
+	return SendTransactionResult{Struct: p.Struct()}, err
 }
 
-func (s ZarbServer_getBlock_Results) HasResult() bool {
+func (s ZarbServer_sendRawTransaction_Results) HasResult() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getBlock_Results) SetResult(v BlockResult) error {
+func (s ZarbServer_sendRawTransaction_Results) SetResult(v SendTransactionResult) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResult sets the result field to a newly
-// allocated BlockResult struct, preferring placement in s's segment.
-func (s ZarbServer_getBlock_Results) NewResult() (BlockResult, error) {
-	ss, err := NewBlockResult(s.Struct.Segment())
+// allocated SendTransactionResult struct, preferring placement in s's segment.
+func (s ZarbServer_sendRawTransaction_Results) NewResult() (SendTransactionResult, error) {
+	ss, err := NewSendTransactionResult(s.Struct.Segment())
 	if err != nil {
-		return BlockResult{}, err
+		return SendTransactionResult{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// ZarbServer_getBlock_Results_List is a list of ZarbServer_getBlock_Results.
-type ZarbServer_getBlock_Results_List struct{ capnp.List }
+// ZarbServer_sendRawTransaction_Results_List is a list of ZarbServer_sendRawTransaction_Results.
+type ZarbServer_sendRawTransaction_Results_List struct{ capnp.List }
 
-// NewZarbServer_getBlock_Results creates a new list of ZarbServer_getBlock_Results.
-func NewZarbServer_getBlock_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getBlock_Results_List, error) {
+// NewZarbServer_sendRawTransaction_Results creates a new list of ZarbServer_sendRawTransaction_Results.
+func NewZarbServer_sendRawTransaction_Results_List(s *capnp.Segment, sz int32) (ZarbServer_sendRawTransaction_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return ZarbServer_getBlock_Results_List{l}, err
+	return ZarbServer_sendRawTransaction_Results_List{l}, err
 }
 
-func (s ZarbServer_getBlock_Results_List) At(i int) ZarbServer_getBlock_Results {
-	return ZarbServer_getBlock_Results{s.List.Struct(i)}
+func (s ZarbServer_sendRawTransaction_Results_List) At(i int) ZarbServer_sendRawTransaction_Results {
+	return ZarbServer_sendRawTransaction_Results{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getBlock_Results_List) Set(i int, v ZarbServer_getBlock_Results) error {
+func (s ZarbServer_sendRawTransaction_Results_List) Set(i int, v ZarbServer_sendRawTransaction_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getBlock_Results_List) String() string {
-	str, _ := text.MarshalList(0x946b1f715eac1308, s.List)
+func (s ZarbServer_sendRawTransaction_Results_List) String() string {
+	str, _ := text.MarshalList(0x8d7ad02d9eab8fb7, s.List)
 	return str
 }
 
-// ZarbServer_getBlock_Results_Promise is a wrapper for a ZarbServer_getBlock_Results promised by a client call.
-type ZarbServer_getBlock_Results_Promise struct{ *capnp.Pipeline }
+// ZarbServer_sendRawTransaction_Results_Promise is a wrapper for a ZarbServer_sendRawTransaction_Results promised by a client call.
+type ZarbServer_sendRawTransaction_Results_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getBlock_Results_Promise) Struct() (ZarbServer_getBlock_Results, error) {
+func (p ZarbServer_sendRawTransaction_Results_Promise) Struct() (ZarbServer_sendRawTransaction_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getBlock_Results{s}, err
+	return ZarbServer_sendRawTransaction_Results{s}, err
 }
 
-func (p ZarbServer_getBlock_Results_Promise) Result() BlockResult_Promise {
-	return BlockResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+func (p ZarbServer_sendRawTransaction_Results_Promise) Result() SendTransactionResult_Promise {
+	return SendTransactionResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
 }
 
-type ZarbServer_getTransaction_Params struct{ capnp.Struct }
+type ZarbServer_dryRunTransaction_Params struct{ capnp.Struct }
 
-// ZarbServer_getTransaction_Params_TypeID is the unique identifier for the type ZarbServer_getTransaction_Params.
-const ZarbServer_getTransaction_Params_TypeID = 0xd3df8a6125925ab9
+// ZarbServer_dryRunTransaction_Params_TypeID is the unique identifier for the type ZarbServer_dryRunTransaction_Params.
+const ZarbServer_dryRunTransaction_Params_TypeID = 0x8317eae56a55f0ba
 
-func NewZarbServer_getTransaction_Params(s *capnp.Segment) (ZarbServer_getTransaction_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return ZarbServer_getTransaction_Params{st}, err
+func NewZarbServer_dryRunTransaction_Params(s *capnp.Segment) (ZarbServer_dryRunTransaction_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_dryRunTransaction_Params{st}, err
 }
 
-func NewRootZarbServer_getTransaction_Params(s *capnp.Segment) (ZarbServer_getTransaction_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return ZarbServer_getTransaction_Params{st}, err
+func NewRootZarbServer_dryRunTransaction_Params(s *capnp.Segment) (ZarbServer_dryRunTransaction_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_dryRunTransaction_Params{st}, err
 }
 
-func ReadRootZarbServer_getTransaction_Params(msg *capnp.Message) (ZarbServer_getTransaction_Params, error) {
+func ReadRootZarbServer_dryRunTransaction_Params(msg *capnp.Message) (ZarbServer_dryRunTransaction_Params, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getTransaction_Params{root.Struct()}, err
+	return ZarbServer_dryRunTransaction_Params{root.Struct()}, err
 }
 
-func (s ZarbServer_getTransaction_Params) String() string {
-	str, _ := text.Marshal(0xd3df8a6125925ab9, s.Struct)
+func (s ZarbServer_dryRunTransaction_Params) String() string {
+	str, _ := text.Marshal(0x8317eae56a55f0ba, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getTransaction_Params) Hash() ([]byte, error) {
+func (s ZarbServer_dryRunTransaction_Params) RawTx() ([]byte, error) {
 	p, err := s.Struct.Ptr(0)
 	return []byte(p.Data()), err
 }
 
-func (s ZarbServer_getTransaction_Params) HasHash() bool {
+func (s ZarbServer_dryRunTransaction_Params) HasRawTx() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getTransaction_Params) SetHash(v []byte) error {
+func (s ZarbServer_dryRunTransaction_Params) SetRawTx(v []byte) error {
 	return s.Struct.SetData(0, v)
 }
 
-func (s ZarbServer_getTransaction_Params) Verbosity() int32 {
-	return int32(s.Struct.Uint32(0))
-}
-
-func (s ZarbServer_getTransaction_Params) SetVerbosity(v int32) {
-	s.Struct.SetUint32(0, uint32(v))
-}
-
-// ZarbServer_getTransaction_Params_List is a list of ZarbServer_getTransaction_Params.
-type ZarbServer_getTransaction_Params_List struct{ capnp.List }
+// ZarbServer_dryRunTransaction_Params_List is a list of ZarbServer_dryRunTransaction_Params.
+type ZarbServer_dryRunTransaction_Params_List struct{ capnp.List }
 
-// NewZarbServer_getTransaction_Params creates a new list of ZarbServer_getTransaction_Params.
-func NewZarbServer_getTransaction_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getTransaction_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return ZarbServer_getTransaction_Params_List{l}, err
+// NewZarbServer_dryRunTransaction_Params creates a new list of ZarbServer_dryRunTransaction_Params.
+func NewZarbServer_dryRunTransaction_Params_List(s *capnp.Segment, sz int32) (ZarbServer_dryRunTransaction_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_dryRunTransaction_Params_List{l}, err
 }
 
-func (s ZarbServer_getTransaction_Params_List) At(i int) ZarbServer_getTransaction_Params {
-	return ZarbServer_getTransaction_Params{s.List.Struct(i)}
+func (s ZarbServer_dryRunTransaction_Params_List) At(i int) ZarbServer_dryRunTransaction_Params {
+	return ZarbServer_dryRunTransaction_Params{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getTransaction_Params_List) Set(i int, v ZarbServer_getTransaction_Params) error {
+func (s ZarbServer_dryRunTransaction_Params_List) Set(i int, v ZarbServer_dryRunTransaction_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getTransaction_Params_List) String() string {
-	str, _ := text.MarshalList(0xd3df8a6125925ab9, s.List)
+func (s ZarbServer_dryRunTransaction_Params_List) String() string {
+	str, _ := text.MarshalList(0x8317eae56a55f0ba, s.List)
 	return str
 }
 
-// ZarbServer_getTransaction_Params_Promise is a wrapper for a ZarbServer_getTransaction_Params promised by a client call.
-type ZarbServer_getTransaction_Params_Promise struct{ *capnp.Pipeline }
+// ZarbServer_dryRunTransaction_Params_Promise is a wrapper for a ZarbServer_dryRunTransaction_Params promised by a client call.
+type ZarbServer_dryRunTransaction_Params_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getTransaction_Params_Promise) Struct() (ZarbServer_getTransaction_Params, error) {
+func (p ZarbServer_dryRunTransaction_Params_Promise) Struct() (ZarbServer_dryRunTransaction_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getTransaction_Params{s}, err
+	return ZarbServer_dryRunTransaction_Params{s}, err
 }
 
-type ZarbServer_getTransaction_Results struct{ capnp.Struct }
+type ZarbServer_dryRunTransaction_Results struct{ capnp.Struct }
 
-// ZarbServer_getTransaction_Results_TypeID is the unique identifier for the type ZarbServer_getTransaction_Results.
-const ZarbServer_getTransaction_Results_TypeID = 0xa2b1016cefab775b
+// ZarbServer_dryRunTransaction_Results_TypeID is the unique identifier for the type ZarbServer_dryRunTransaction_Results.
+const ZarbServer_dryRunTransaction_Results_TypeID = 0xc0689e5f33bf949d
 
-func NewZarbServer_getTransaction_Results(s *capnp.Segment) (ZarbServer_getTransaction_Results, error) {
+func NewZarbServer_dryRunTransaction_Results(s *capnp.Segment) (ZarbServer_dryRunTransaction_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getTransaction_Results{st}, err
+	return ZarbServer_dryRunTransaction_Results{st}, err
 }
 
-func NewRootZarbServer_getTransaction_Results(s *capnp.Segment) (ZarbServer_getTransaction_Results, error) {
+func NewRootZarbServer_dryRunTransaction_Results(s *capnp.Segment) (ZarbServer_dryRunTransaction_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getTransaction_Results{st}, err
+	return ZarbServer_dryRunTransaction_Results{st}, err
 }
 
-func ReadRootZarbServer_getTransaction_Results(msg *capnp.Message) (ZarbServer_getTransaction_Results, error) {
+func ReadRootZarbServer_dryRunTransaction_Results(msg *capnp.Message) (ZarbServer_dryRunTransaction_Results, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getTransaction_Results{root.Struct()}, err
+	return ZarbServer_dryRunTransaction_Results{root.Struct()}, err
 }
 
-func (s ZarbServer_getTransaction_Results) String() string {
-	str, _ := text.Marshal(0xa2b1016cefab775b, s.Struct)
+func (s ZarbServer_dryRunTransaction_Results) String() string {
+	str, _ := text.Marshal(0xc0689e5f33bf949d, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getTransaction_Results) Result() (TransactionResult, error) {
+func (s ZarbServer_dryRunTransaction_Results) Result() (DryRunResult, error) {
 	p, err := s.Struct.Ptr(0)
-	return TransactionResult{Struct: p.Struct()}, err
+	return DryRunResult{Struct: p.Struct()}, err
 }
 
-func (s ZarbServer_getTransaction_Results) HasResult() bool {
+func (s ZarbServer_dryRunTransaction_Results) HasResult() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getTransaction_Results) SetResult(v TransactionResult) error {
+func (s ZarbServer_dryRunTransaction_Results) SetResult(v DryRunResult) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResult sets the result field to a newly
-// allocated TransactionResult struct, preferring placement in s's segment.
-func (s ZarbServer_getTransaction_Results) NewResult() (TransactionResult, error) {
-	ss, err := NewTransactionResult(s.Struct.Segment())
+// allocated DryRunResult struct, preferring placement in s's segment.
+func (s ZarbServer_dryRunTransaction_Results) NewResult() (DryRunResult, error) {
+	ss, err := NewDryRunResult(s.Struct.Segment())
 	if err != nil {
-		return TransactionResult{}, err
+		return DryRunResult{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// ZarbServer_getTransaction_Results_List is a list of ZarbServer_getTransaction_Results.
-type ZarbServer_getTransaction_Results_List struct{ capnp.List }
+// ZarbServer_dryRunTransaction_Results_List is a list of ZarbServer_dryRunTransaction_Results.
+type ZarbServer_dryRunTransaction_Results_List struct{ capnp.List }
 
-// NewZarbServer_getTransaction_Results creates a new list of ZarbServer_getTransaction_Results.
-func NewZarbServer_getTransaction_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getTransaction_Results_List, error) {
+// NewZarbServer_dryRunTransaction_Results creates a new list of ZarbServer_dryRunTransaction_Results.
+func NewZarbServer_dryRunTransaction_Results_List(s *capnp.Segment, sz int32) (ZarbServer_dryRunTransaction_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return ZarbServer_getTransaction_Results_List{l}, err
+	return ZarbServer_dryRunTransaction_Results_List{l}, err
 }
 
-func (s ZarbServer_getTransaction_Results_List) At(i int) ZarbServer_getTransaction_Results {
-	return ZarbServer_getTransaction_Results{s.List.Struct(i)}
+func (s ZarbServer_dryRunTransaction_Results_List) At(i int) ZarbServer_dryRunTransaction_Results {
+	return ZarbServer_dryRunTransaction_Results{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getTransaction_Results_List) Set(i int, v ZarbServer_getTransaction_Results) error {
+func (s ZarbServer_dryRunTransaction_Results_List) Set(i int, v ZarbServer_dryRunTransaction_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getTransaction_Results_List) String() string {
-	str, _ := text.MarshalList(0xa2b1016cefab775b, s.List)
+func (s ZarbServer_dryRunTransaction_Results_List) String() string {
+	str, _ := text.MarshalList(0xc0689e5f33bf949d, s.List)
 	return str
 }
 
-// ZarbServer_getTransaction_Results_Promise is a wrapper for a ZarbServer_getTransaction_Results promised by a client call.
-type ZarbServer_getTransaction_Results_Promise struct{ *capnp.Pipeline }
+// ZarbServer_dryRunTransaction_Results_Promise is a wrapper for a ZarbServer_dryRunTransaction_Results promised by a client call.
+type ZarbServer_dryRunTransaction_Results_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getTransaction_Results_Promise) Struct() (ZarbServer_getTransaction_Results, error) {
+func (p ZarbServer_dryRunTransaction_Results_Promise) Struct() (ZarbServer_dryRunTransaction_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getTransaction_Results{s}, err
+	return ZarbServer_dryRunTransaction_Results{s}, err
 }
 
-func (p ZarbServer_getTransaction_Results_Promise) Result() TransactionResult_Promise {
-	return TransactionResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+func (p ZarbServer_dryRunTransaction_Results_Promise) Result() DryRunResult_Promise {
+	return DryRunResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
 }
 
-type ZarbServer_getBlockHeight_Params struct{ capnp.Struct }
+type ZarbServer_getAccountProof_Params struct{ capnp.Struct }
 
-// ZarbServer_getBlockHeight_Params_TypeID is the unique identifier for the type ZarbServer_getBlockHeight_Params.
-const ZarbServer_getBlockHeight_Params_TypeID = 0xcd6c734787642800
+// ZarbServer_getAccountProof_Params_TypeID is the unique identifier for the type ZarbServer_getAccountProof_Params.
+const ZarbServer_getAccountProof_Params_TypeID = 0xfe238774e8fa0fd9
 
-func NewZarbServer_getBlockHeight_Params(s *capnp.Segment) (ZarbServer_getBlockHeight_Params, error) {
+func NewZarbServer_getAccountProof_Params(s *capnp.Segment) (ZarbServer_getAccountProof_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getBlockHeight_Params{st}, err
+	return ZarbServer_getAccountProof_Params{st}, err
 }
 
-func NewRootZarbServer_getBlockHeight_Params(s *capnp.Segment) (ZarbServer_getBlockHeight_Params, error) {
+func NewRootZarbServer_getAccountProof_Params(s *capnp.Segment) (ZarbServer_getAccountProof_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getBlockHeight_Params{st}, err
+	return ZarbServer_getAccountProof_Params{st}, err
 }
 
-func ReadRootZarbServer_getBlockHeight_Params(msg *capnp.Message) (ZarbServer_getBlockHeight_Params, error) {
+func ReadRootZarbServer_getAccountProof_Params(msg *capnp.Message) (ZarbServer_getAccountProof_Params, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getBlockHeight_Params{root.Struct()}, err
+	return ZarbServer_getAccountProof_Params{root.Struct()}, err
 }
 
-func (s ZarbServer_getBlockHeight_Params) String() string {
-	str, _ := text.Marshal(0xcd6c734787642800, s.Struct)
+func (s ZarbServer_getAccountProof_Params) String() string {
+	str, _ := text.Marshal(0xfe238774e8fa0fd9, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getBlockHeight_Params) Hash() ([]byte, error) {
+func (s ZarbServer_getAccountProof_Params) Address() ([]byte, error) {
 	p, err := s.Struct.Ptr(0)
 	return []byte(p.Data()), err
 }
 
-func (s ZarbServer_getBlockHeight_Params) HasHash() bool {
+func (s ZarbServer_getAccountProof_Params) HasAddress() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getBlockHeight_Params) SetHash(v []byte) error {
+func (s ZarbServer_getAccountProof_Params) SetAddress(v []byte) error {
 	return s.Struct.SetData(0, v)
 }
 
-// ZarbServer_getBlockHeight_Params_List is a list of ZarbServer_getBlockHeight_Params.
-type ZarbServer_getBlockHeight_Params_List struct{ capnp.List }
+// ZarbServer_getAccountProof_Params_List is a list of ZarbServer_getAccountProof_Params.
+type ZarbServer_getAccountProof_Params_List struct{ capnp.List }
 
-// NewZarbServer_getBlockHeight_Params creates a new list of ZarbServer_getBlockHeight_Params.
-func NewZarbServer_getBlockHeight_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getBlockHeight_Params_List, error) {
+// NewZarbServer_getAccountProof_Params creates a new list of ZarbServer_getAccountProof_Params.
+func NewZarbServer_getAccountProof_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getAccountProof_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return ZarbServer_getBlockHeight_Params_List{l}, err
+	return ZarbServer_getAccountProof_Params_List{l}, err
 }
 
-func (s ZarbServer_getBlockHeight_Params_List) At(i int) ZarbServer_getBlockHeight_Params {
-	return ZarbServer_getBlockHeight_Params{s.List.Struct(i)}
+func (s ZarbServer_getAccountProof_Params_List) At(i int) ZarbServer_getAccountProof_Params {
+	return ZarbServer_getAccountProof_Params{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getBlockHeight_Params_List) Set(i int, v ZarbServer_getBlockHeight_Params) error {
+func (s ZarbServer_getAccountProof_Params_List) Set(i int, v ZarbServer_getAccountProof_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getBlockHeight_Params_List) String() string {
-	str, _ := text.MarshalList(0xcd6c734787642800, s.List)
+func (s ZarbServer_getAccountProof_Params_List) String() string {
+	str, _ := text.MarshalList(0xfe238774e8fa0fd9, s.List)
 	return str
 }
 
-// ZarbServer_getBlockHeight_Params_Promise is a wrapper for a ZarbServer_getBlockHeight_Params promised by a client call.
-type ZarbServer_getBlockHeight_Params_Promise struct{ *capnp.Pipeline }
+// ZarbServer_getAccountProof_Params_Promise is a wrapper for a ZarbServer_getAccountProof_Params promised by a client call.
+type ZarbServer_getAccountProof_Params_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getBlockHeight_Params_Promise) Struct() (ZarbServer_getBlockHeight_Params, error) {
+func (p ZarbServer_getAccountProof_Params_Promise) Struct() (ZarbServer_getAccountProof_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getBlockHeight_Params{s}, err
+	return ZarbServer_getAccountProof_Params{s}, err
 }
 
-type ZarbServer_getBlockHeight_Results struct{ capnp.Struct }
+type ZarbServer_getAccountProof_Results struct{ capnp.Struct }
 
-// ZarbServer_getBlockHeight_Results_TypeID is the unique identifier for the type ZarbServer_getBlockHeight_Results.
-const ZarbServer_getBlockHeight_Results_TypeID = 0x8e979661cc6a1161
+// ZarbServer_getAccountProof_Results_TypeID is the unique identifier for the type ZarbServer_getAccountProof_Results.
+const ZarbServer_getAccountProof_Results_TypeID = 0x9090e4cdf26bda5a
 
-func NewZarbServer_getBlockHeight_Results(s *capnp.Segment) (ZarbServer_getBlockHeight_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return ZarbServer_getBlockHeight_Results{st}, err
+func NewZarbServer_getAccountProof_Results(s *capnp.Segment) (ZarbServer_getAccountProof_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getAccountProof_Results{st}, err
 }
 
-func NewRootZarbServer_getBlockHeight_Results(s *capnp.Segment) (ZarbServer_getBlockHeight_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return ZarbServer_getBlockHeight_Results{st}, err
+func NewRootZarbServer_getAccountProof_Results(s *capnp.Segment) (ZarbServer_getAccountProof_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getAccountProof_Results{st}, err
 }
 
-func ReadRootZarbServer_getBlockHeight_Results(msg *capnp.Message) (ZarbServer_getBlockHeight_Results, error) {
+func ReadRootZarbServer_getAccountProof_Results(msg *capnp.Message) (ZarbServer_getAccountProof_Results, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getBlockHeight_Results{root.Struct()}, err
+	return ZarbServer_getAccountProof_Results{root.Struct()}, err
 }
 
-func (s ZarbServer_getBlockHeight_Results) String() string {
-	str, _ := text.Marshal(0x8e979661cc6a1161, s.Struct)
+func (s ZarbServer_getAccountProof_Results) String() string {
+	str, _ := text.Marshal(0x9090e4cdf26bda5a, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getBlockHeight_Results) Result() uint64 {
-	return s.Struct.Uint64(0)
+func (s ZarbServer_getAccountProof_Results) Result() (ProofResult, error) {
+	p, err := s.Struct.Ptr(0)
+	return ProofResult{Struct: p.Struct()}, err
 }
 
-func (s ZarbServer_getBlockHeight_Results) SetResult(v uint64) {
-	s.Struct.SetUint64(0, v)
+func (s ZarbServer_getAccountProof_Results) HasResult() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-// ZarbServer_getBlockHeight_Results_List is a list of ZarbServer_getBlockHeight_Results.
-type ZarbServer_getBlockHeight_Results_List struct{ capnp.List }
+func (s ZarbServer_getAccountProof_Results) SetResult(v ProofResult) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
 
-// NewZarbServer_getBlockHeight_Results creates a new list of ZarbServer_getBlockHeight_Results.
-func NewZarbServer_getBlockHeight_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getBlockHeight_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return ZarbServer_getBlockHeight_Results_List{l}, err
+// NewResult sets the result field to a newly
+// allocated ProofResult struct, preferring placement in s's segment.
+func (s ZarbServer_getAccountProof_Results) NewResult() (ProofResult, error) {
+	ss, err := NewProofResult(s.Struct.Segment())
+	if err != nil {
+		return ProofResult{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-func (s ZarbServer_getBlockHeight_Results_List) At(i int) ZarbServer_getBlockHeight_Results {
-	return ZarbServer_getBlockHeight_Results{s.List.Struct(i)}
+// ZarbServer_getAccountProof_Results_List is a list of ZarbServer_getAccountProof_Results.
+type ZarbServer_getAccountProof_Results_List struct{ capnp.List }
+
+// NewZarbServer_getAccountProof_Results creates a new list of ZarbServer_getAccountProof_Results.
+func NewZarbServer_getAccountProof_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getAccountProof_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getAccountProof_Results_List{l}, err
 }
 
-func (s ZarbServer_getBlockHeight_Results_List) Set(i int, v ZarbServer_getBlockHeight_Results) error {
+func (s ZarbServer_getAccountProof_Results_List) At(i int) ZarbServer_getAccountProof_Results {
+	return ZarbServer_getAccountProof_Results{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getAccountProof_Results_List) Set(i int, v ZarbServer_getAccountProof_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getBlockHeight_Results_List) String() string {
-	str, _ := text.MarshalList(0x8e979661cc6a1161, s.List)
+func (s ZarbServer_getAccountProof_Results_List) String() string {
+	str, _ := text.MarshalList(0x9090e4cdf26bda5a, s.List)
 	return str
 }
 
-// ZarbServer_getBlockHeight_Results_Promise is a wrapper for a ZarbServer_getBlockHeight_Results promised by a client call.
-type ZarbServer_getBlockHeight_Results_Promise struct{ *capnp.Pipeline }
+// ZarbServer_getAccountProof_Results_Promise is a wrapper for a ZarbServer_getAccountProof_Results promised by a client call.
+type ZarbServer_getAccountProof_Results_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getBlockHeight_Results_Promise) Struct() (ZarbServer_getBlockHeight_Results, error) {
+func (p ZarbServer_getAccountProof_Results_Promise) Struct() (ZarbServer_getAccountProof_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getBlockHeight_Results{s}, err
+	return ZarbServer_getAccountProof_Results{s}, err
 }
 
-type ZarbServer_getAccount_Params struct{ capnp.Struct }
+func (p ZarbServer_getAccountProof_Results_Promise) Result() ProofResult_Promise {
+	return ProofResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
 
-// ZarbServer_getAccount_Params_TypeID is the unique identifier for the type ZarbServer_getAccount_Params.
-const ZarbServer_getAccount_Params_TypeID = 0xeed94cf76be61d8e
+type ZarbServer_getValidatorProof_Params struct{ capnp.Struct }
 
-func NewZarbServer_getAccount_Params(s *capnp.Segment) (ZarbServer_getAccount_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return ZarbServer_getAccount_Params{st}, err
+// ZarbServer_getValidatorProof_Params_TypeID is the unique identifier for the type ZarbServer_getValidatorProof_Params.
+const ZarbServer_getValidatorProof_Params_TypeID = 0xb3f44a65c55cceec
+
+func NewZarbServer_getValidatorProof_Params(s *capnp.Segment) (ZarbServer_getValidatorProof_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getValidatorProof_Params{st}, err
 }
 
-func NewRootZarbServer_getAccount_Params(s *capnp.Segment) (ZarbServer_getAccount_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return ZarbServer_getAccount_Params{st}, err
+func NewRootZarbServer_getValidatorProof_Params(s *capnp.Segment) (ZarbServer_getValidatorProof_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getValidatorProof_Params{st}, err
 }
 
-func ReadRootZarbServer_getAccount_Params(msg *capnp.Message) (ZarbServer_getAccount_Params, error) {
+func ReadRootZarbServer_getValidatorProof_Params(msg *capnp.Message) (ZarbServer_getValidatorProof_Params, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getAccount_Params{root.Struct()}, err
+	return ZarbServer_getValidatorProof_Params{root.Struct()}, err
 }
 
-func (s ZarbServer_getAccount_Params) String() string {
-	str, _ := text.Marshal(0xeed94cf76be61d8e, s.Struct)
+func (s ZarbServer_getValidatorProof_Params) String() string {
+	str, _ := text.Marshal(0xb3f44a65c55cceec, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getAccount_Params) Address() ([]byte, error) {
+func (s ZarbServer_getValidatorProof_Params) Address() ([]byte, error) {
 	p, err := s.Struct.Ptr(0)
 	return []byte(p.Data()), err
 }
 
-func (s ZarbServer_getAccount_Params) HasAddress() bool {
+func (s ZarbServer_getValidatorProof_Params) HasAddress() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getAccount_Params) SetAddress(v []byte) error {
+func (s ZarbServer_getValidatorProof_Params) SetAddress(v []byte) error {
 	return s.Struct.SetData(0, v)
 }
 
-func (s ZarbServer_getAccount_Params) Verbosity() int32 {
-	return int32(s.Struct.Uint32(0))
-}
-
-func (s ZarbServer_getAccount_Params) SetVerbosity(v int32) {
-	s.Struct.SetUint32(0, uint32(v))
-}
-
-// ZarbServer_getAccount_Params_List is a list of ZarbServer_getAccount_Params.
-type ZarbServer_getAccount_Params_List struct{ capnp.List }
+// ZarbServer_getValidatorProof_Params_List is a list of ZarbServer_getValidatorProof_Params.
+type ZarbServer_getValidatorProof_Params_List struct{ capnp.List }
 
-// NewZarbServer_getAccount_Params creates a new list of ZarbServer_getAccount_Params.
-func NewZarbServer_getAccount_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getAccount_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return ZarbServer_getAccount_Params_List{l}, err
+// NewZarbServer_getValidatorProof_Params creates a new list of ZarbServer_getValidatorProof_Params.
+func NewZarbServer_getValidatorProof_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getValidatorProof_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getValidatorProof_Params_List{l}, err
 }
 
-func (s ZarbServer_getAccount_Params_List) At(i int) ZarbServer_getAccount_Params {
-	return ZarbServer_getAccount_Params{s.List.Struct(i)}
+func (s ZarbServer_getValidatorProof_Params_List) At(i int) ZarbServer_getValidatorProof_Params {
+	return ZarbServer_getValidatorProof_Params{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getAccount_Params_List) Set(i int, v ZarbServer_getAccount_Params) error {
+func (s ZarbServer_getValidatorProof_Params_List) Set(i int, v ZarbServer_getValidatorProof_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getAccount_Params_List) String() string {
-	str, _ := text.MarshalList(0xeed94cf76be61d8e, s.List)
+func (s ZarbServer_getValidatorProof_Params_List) String() string {
+	str, _ := text.MarshalList(0xb3f44a65c55cceec, s.List)
 	return str
 }
 
-// ZarbServer_getAccount_Params_Promise is a wrapper for a ZarbServer_getAccount_Params promised by a client call.
-type ZarbServer_getAccount_Params_Promise struct{ *capnp.Pipeline }
+// ZarbServer_getValidatorProof_Params_Promise is a wrapper for a ZarbServer_getValidatorProof_Params promised by a client call.
+type ZarbServer_getValidatorProof_Params_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getAccount_Params_Promise) Struct() (ZarbServer_getAccount_Params, error) {
+func (p ZarbServer_getValidatorProof_Params_Promise) Struct() (ZarbServer_getValidatorProof_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getAccount_Params{s}, err
+	return ZarbServer_getValidatorProof_Params{s}, err
 }
 
-type ZarbServer_getAccount_Results struct{ capnp.Struct }
+type ZarbServer_getValidatorProof_Results struct{ capnp.Struct }
 
-// ZarbServer_getAccount_Results_TypeID is the unique identifier for the type ZarbServer_getAccount_Results.
-const ZarbServer_getAccount_Results_TypeID = 0xfb42d1f26b074c15
+// ZarbServer_getValidatorProof_Results_TypeID is the unique identifier for the type ZarbServer_getValidatorProof_Results.
+const ZarbServer_getValidatorProof_Results_TypeID = 0xd116b8c7c465c1bf
 
-func NewZarbServer_getAccount_Results(s *capnp.Segment) (ZarbServer_getAccount_Results, error) {
+func NewZarbServer_getValidatorProof_Results(s *capnp.Segment) (ZarbServer_getValidatorProof_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getAccount_Results{st}, err
+	return ZarbServer_getValidatorProof_Results{st}, err
 }
 
-func NewRootZarbServer_getAccount_Results(s *capnp.Segment) (ZarbServer_getAccount_Results, error) {
+func NewRootZarbServer_getValidatorProof_Results(s *capnp.Segment) (ZarbServer_getValidatorProof_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getAccount_Results{st}, err
+	return ZarbServer_getValidatorProof_Results{st}, err
 }
 
-func ReadRootZarbServer_getAccount_Results(msg *capnp.Message) (ZarbServer_getAccount_Results, error) {
+func ReadRootZarbServer_getValidatorProof_Results(msg *capnp.Message) (ZarbServer_getValidatorProof_Results, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getAccount_Results{root.Struct()}, err
+	return ZarbServer_getValidatorProof_Results{root.Struct()}, err
 }
 
-func (s ZarbServer_getAccount_Results) String() string {
-	str, _ := text.Marshal(0xfb42d1f26b074c15, s.Struct)
+func (s ZarbServer_getValidatorProof_Results) String() string {
+	str, _ := text.Marshal(0xd116b8c7c465c1bf, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getAccount_Results) Result() (AccountResult, error) {
+func (s ZarbServer_getValidatorProof_Results) Result() (ProofResult, error) {
 	p, err := s.Struct.Ptr(0)
-	return AccountResult{Struct: p.Struct()}, err
+	return ProofResult{Struct: p.Struct()}, err
 }
 
-func (s ZarbServer_getAccount_Results) HasResult() bool {
+func (s ZarbServer_getValidatorProof_Results) HasResult() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getAccount_Results) SetResult(v AccountResult) error {
+func (s ZarbServer_getValidatorProof_Results) SetResult(v ProofResult) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResult sets the result field to a newly
-// allocated AccountResult struct, preferring placement in s's segment.
-func (s ZarbServer_getAccount_Results) NewResult() (AccountResult, error) {
-	ss, err := NewAccountResult(s.Struct.Segment())
+// allocated ProofResult struct, preferring placement in s's segment.
+func (s ZarbServer_getValidatorProof_Results) NewResult() (ProofResult, error) {
+	ss, err := NewProofResult(s.Struct.Segment())
 	if err != nil {
-		return AccountResult{}, err
+		return ProofResult{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// ZarbServer_getAccount_Results_List is a list of ZarbServer_getAccount_Results.
-type ZarbServer_getAccount_Results_List struct{ capnp.List }
+// ZarbServer_getValidatorProof_Results_List is a list of ZarbServer_getValidatorProof_Results.
+type ZarbServer_getValidatorProof_Results_List struct{ capnp.List }
 
-// NewZarbServer_getAccount_Results creates a new list of ZarbServer_getAccount_Results.
-func NewZarbServer_getAccount_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getAccount_Results_List, error) {
+// NewZarbServer_getValidatorProof_Results creates a new list of ZarbServer_getValidatorProof_Results.
+func NewZarbServer_getValidatorProof_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getValidatorProof_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return ZarbServer_getAccount_Results_List{l}, err
+	return ZarbServer_getValidatorProof_Results_List{l}, err
 }
 
-func (s ZarbServer_getAccount_Results_List) At(i int) ZarbServer_getAccount_Results {
-	return ZarbServer_getAccount_Results{s.List.Struct(i)}
+func (s ZarbServer_getValidatorProof_Results_List) At(i int) ZarbServer_getValidatorProof_Results {
+	return ZarbServer_getValidatorProof_Results{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getAccount_Results_List) Set(i int, v ZarbServer_getAccount_Results) error {
+func (s ZarbServer_getValidatorProof_Results_List) Set(i int, v ZarbServer_getValidatorProof_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getAccount_Results_List) String() string {
-	str, _ := text.MarshalList(0xfb42d1f26b074c15, s.List)
+func (s ZarbServer_getValidatorProof_Results_List) String() string {
+	str, _ := text.MarshalList(0xd116b8c7c465c1bf, s.List)
 	return str
 }
 
-// ZarbServer_getAccount_Results_Promise is a wrapper for a ZarbServer_getAccount_Results promised by a client call.
-type ZarbServer_getAccount_Results_Promise struct{ *capnp.Pipeline }
+// ZarbServer_getValidatorProof_Results_Promise is a wrapper for a ZarbServer_getValidatorProof_Results promised by a client call.
+type ZarbServer_getValidatorProof_Results_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getAccount_Results_Promise) Struct() (ZarbServer_getAccount_Results, error) {
+func (p ZarbServer_getValidatorProof_Results_Promise) Struct() (ZarbServer_getValidatorProof_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getAccount_Results{s}, err
+	return ZarbServer_getValidatorProof_Results{s}, err
 }
 
-func (p ZarbServer_getAccount_Results_Promise) Result() AccountResult_Promise {
-	return AccountResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+func (p ZarbServer_getValidatorProof_Results_Promise) Result() ProofResult_Promise {
+	return ProofResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
 }
 
-type ZarbServer_getValidator_Params struct{ capnp.Struct }
+type ZarbServer_getTransactionProof_Params struct{ capnp.Struct }
 
-// ZarbServer_getValidator_Params_TypeID is the unique identifier for the type ZarbServer_getValidator_Params.
-const ZarbServer_getValidator_Params_TypeID = 0x8fb41d4bd35c5a30
+// ZarbServer_getTransactionProof_Params_TypeID is the unique identifier for the type ZarbServer_getTransactionProof_Params.
+const ZarbServer_getTransactionProof_Params_TypeID = 0xea4f8e4e7afafcfa
 
-func NewZarbServer_getValidator_Params(s *capnp.Segment) (ZarbServer_getValidator_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return ZarbServer_getValidator_Params{st}, err
+func NewZarbServer_getTransactionProof_Params(s *capnp.Segment) (ZarbServer_getTransactionProof_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getTransactionProof_Params{st}, err
 }
 
-func NewRootZarbServer_getValidator_Params(s *capnp.Segment) (ZarbServer_getValidator_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return ZarbServer_getValidator_Params{st}, err
+func NewRootZarbServer_getTransactionProof_Params(s *capnp.Segment) (ZarbServer_getTransactionProof_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getTransactionProof_Params{st}, err
 }
 
-func ReadRootZarbServer_getValidator_Params(msg *capnp.Message) (ZarbServer_getValidator_Params, error) {
+func ReadRootZarbServer_getTransactionProof_Params(msg *capnp.Message) (ZarbServer_getTransactionProof_Params, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getValidator_Params{root.Struct()}, err
+	return ZarbServer_getTransactionProof_Params{root.Struct()}, err
 }
 
-func (s ZarbServer_getValidator_Params) String() string {
-	str, _ := text.Marshal(0x8fb41d4bd35c5a30, s.Struct)
+func (s ZarbServer_getTransactionProof_Params) String() string {
+	str, _ := text.Marshal(0xea4f8e4e7afafcfa, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getValidator_Params) Address() ([]byte, error) {
+func (s ZarbServer_getTransactionProof_Params) Hash() ([]byte, error) {
 	p, err := s.Struct.Ptr(0)
 	return []byte(p.Data()), err
 }
 
-func (s ZarbServer_getValidator_Params) HasAddress() bool {
+func (s ZarbServer_getTransactionProof_Params) HasHash() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getValidator_Params) SetAddress(v []byte) error {
+func (s ZarbServer_getTransactionProof_Params) SetHash(v []byte) error {
 	return s.Struct.SetData(0, v)
 }
 
-func (s ZarbServer_getValidator_Params) Verbosity() int32 {
-	return int32(s.Struct.Uint32(0))
-}
-
-func (s ZarbServer_getValidator_Params) SetVerbosity(v int32) {
-	s.Struct.SetUint32(0, uint32(v))
-}
-
-// ZarbServer_getValidator_Params_List is a list of ZarbServer_getValidator_Params.
-type ZarbServer_getValidator_Params_List struct{ capnp.List }
+// ZarbServer_getTransactionProof_Params_List is a list of ZarbServer_getTransactionProof_Params.
+type ZarbServer_getTransactionProof_Params_List struct{ capnp.List }
 
-// NewZarbServer_getValidator_Params creates a new list of ZarbServer_getValidator_Params.
-func NewZarbServer_getValidator_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getValidator_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return ZarbServer_getValidator_Params_List{l}, err
+// NewZarbServer_getTransactionProof_Params creates a new list of ZarbServer_getTransactionProof_Params.
+func NewZarbServer_getTransactionProof_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getTransactionProof_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getTransactionProof_Params_List{l}, err
 }
 
-func (s ZarbServer_getValidator_Params_List) At(i int) ZarbServer_getValidator_Params {
-	return ZarbServer_getValidator_Params{s.List.Struct(i)}
+func (s ZarbServer_getTransactionProof_Params_List) At(i int) ZarbServer_getTransactionProof_Params {
+	return ZarbServer_getTransactionProof_Params{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getValidator_Params_List) Set(i int, v ZarbServer_getValidator_Params) error {
+func (s ZarbServer_getTransactionProof_Params_List) Set(i int, v ZarbServer_getTransactionProof_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getValidator_Params_List) String() string {
-	str, _ := text.MarshalList(0x8fb41d4bd35c5a30, s.List)
+func (s ZarbServer_getTransactionProof_Params_List) String() string {
+	str, _ := text.MarshalList(0xea4f8e4e7afafcfa, s.List)
 	return str
 }
 
-// ZarbServer_getValidator_Params_Promise is a wrapper for a ZarbServer_getValidator_Params promised by a client call.
-type ZarbServer_getValidator_Params_Promise struct{ *capnp.Pipeline }
+// ZarbServer_getTransactionProof_Params_Promise is a wrapper for a ZarbServer_getTransactionProof_Params promised by a client call.
+type ZarbServer_getTransactionProof_Params_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getValidator_Params_Promise) Struct() (ZarbServer_getValidator_Params, error) {
+func (p ZarbServer_getTransactionProof_Params_Promise) Struct() (ZarbServer_getTransactionProof_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getValidator_Params{s}, err
+	return ZarbServer_getTransactionProof_Params{s}, err
 }
 
-type ZarbServer_getValidator_Results struct{ capnp.Struct }
+type ZarbServer_getTransactionProof_Results struct{ capnp.Struct }
 
-// ZarbServer_getValidator_Results_TypeID is the unique identifier for the type ZarbServer_getValidator_Results.
-const ZarbServer_getValidator_Results_TypeID = 0xa128fe760c2612c4
+// ZarbServer_getTransactionProof_Results_TypeID is the unique identifier for the type ZarbServer_getTransactionProof_Results.
+const ZarbServer_getTransactionProof_Results_TypeID = 0xffae9bfd910d3fbd
 
-func NewZarbServer_getValidator_Results(s *capnp.Segment) (ZarbServer_getValidator_Results, error) {
+func NewZarbServer_getTransactionProof_Results(s *capnp.Segment) (ZarbServer_getTransactionProof_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getValidator_Results{st}, err
+	return ZarbServer_getTransactionProof_Results{st}, err
 }
 
-func NewRootZarbServer_getValidator_Results(s *capnp.Segment) (ZarbServer_getValidator_Results, error) {
+func NewRootZarbServer_getTransactionProof_Results(s *capnp.Segment) (ZarbServer_getTransactionProof_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ZarbServer_getValidator_Results{st}, err
+	return ZarbServer_getTransactionProof_Results{st}, err
 }
 
-func ReadRootZarbServer_getValidator_Results(msg *capnp.Message) (ZarbServer_getValidator_Results, error) {
+func ReadRootZarbServer_getTransactionProof_Results(msg *capnp.Message) (ZarbServer_getTransactionProof_Results, error) {
 	root, err := msg.RootPtr()
-	return ZarbServer_getValidator_Results{root.Struct()}, err
+	return ZarbServer_getTransactionProof_Results{root.Struct()}, err
 }
 
-func (s ZarbServer_getValidator_Results) String() string {
-	str, _ := text.Marshal(0xa128fe760c2612c4, s.Struct)
+func (s ZarbServer_getTransactionProof_Results) String() string {
+	str, _ := text.Marshal(0xffae9bfd910d3fbd, s.Struct)
 	return str
 }
 
-func (s ZarbServer_getValidator_Results) Result() (ValidatorResult, error) {
+func (s ZarbServer_getTransactionProof_Results) Result() (ProofResult, error) {
 	p, err := s.Struct.Ptr(0)
-	return ValidatorResult{Struct: p.Struct()}, err
+	return ProofResult{Struct: p.Struct()}, err
 }
 
-func (s ZarbServer_getValidator_Results) HasResult() bool {
+func (s ZarbServer_getTransactionProof_Results) HasResult() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s ZarbServer_getValidator_Results) SetResult(v ValidatorResult) error {
+func (s ZarbServer_getTransactionProof_Results) SetResult(v ProofResult) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResult sets the result field to a newly
-// allocated ValidatorResult struct, preferring placement in s's segment.
-func (s ZarbServer_getValidator_Results) NewResult() (ValidatorResult, error) {
-	ss, err := NewValidatorResult(s.Struct.Segment())
+// allocated ProofResult struct, preferring placement in s's segment.
+func (s ZarbServer_getTransactionProof_Results) NewResult() (ProofResult, error) {
+	ss, err := NewProofResult(s.Struct.Segment())
 	if err != nil {
-		return ValidatorResult{}, err
+		return ProofResult{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// ZarbServer_getValidator_Results_List is a list of ZarbServer_getValidator_Results.
-type ZarbServer_getValidator_Results_List struct{ capnp.List }
+// ZarbServer_getTransactionProof_Results_List is a list of ZarbServer_getTransactionProof_Results.
+type ZarbServer_getTransactionProof_Results_List struct{ capnp.List }
 
-// NewZarbServer_getValidator_Results creates a new list of ZarbServer_getValidator_Results.
-func NewZarbServer_getValidator_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getValidator_Results_List, error) {
+// NewZarbServer_getTransactionProof_Results creates a new list of ZarbServer_getTransactionProof_Results.
+func NewZarbServer_getTransactionProof_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getTransactionProof_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return ZarbServer_getValidator_Results_List{l}, err
+	return ZarbServer_getTransactionProof_Results_List{l}, err
 }
 
-func (s ZarbServer_getValidator_Results_List) At(i int) ZarbServer_getValidator_Results {
-	return ZarbServer_getValidator_Results{s.List.Struct(i)}
+func (s ZarbServer_getTransactionProof_Results_List) At(i int) ZarbServer_getTransactionProof_Results {
+	return ZarbServer_getTransactionProof_Results{s.List.Struct(i)}
 }
 
-func (s ZarbServer_getValidator_Results_List) Set(i int, v ZarbServer_getValidator_Results) error {
+func (s ZarbServer_getTransactionProof_Results_List) Set(i int, v ZarbServer_getTransactionProof_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s ZarbServer_getValidator_Results_List) String() string {
-	str, _ := text.MarshalList(0xa128fe760c2612c4, s.List)
+func (s ZarbServer_getTransactionProof_Results_List) String() string {
+	str, _ := text.MarshalList(0xffae9bfd910d3fbd, s.List)
 	return str
 }
 
-// ZarbServer_getValidator_Results_Promise is a wrapper for a ZarbServer_getValidator_Results promised by a client call.
-type ZarbServer_getValidator_Results_Promise struct{ *capnp.Pipeline }
+// ZarbServer_getTransactionProof_Results_Promise is a wrapper for a ZarbServer_getTransactionProof_Results promised by a client call.
+type ZarbServer_getTransactionProof_Results_Promise struct{ *capnp.Pipeline }
 
-func (p ZarbServer_getValidator_Results_Promise) Struct() (ZarbServer_getValidator_Results, error) {
+func (p ZarbServer_getTransactionProof_Results_Promise) Struct() (ZarbServer_getTransactionProof_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return ZarbServer_getValidator_Results{s}, err
-}
-
-func (p ZarbServer_getValidator_Results_Promise) Result() ValidatorResult_Promise {
-	return ValidatorResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
-}
-
-const schema_84b56bd0975dfd33 = "x\xda\xb4W_l\x1cg\x11\x9f\xd9o\xf7v\xef|" +
-	"g\xdf\xb2g\xd1\xa2\xb8\x8e\x90\x83\x12\x03m\x9aP\x15" +
-	"E*\x97\xb8\x89j\x83+\xdd\x9e#TL\x8b\xba\xbe" +
-	"[r\xd7\xfbg\xef\xae\xff$/\x96\x0cU!\xe0T" +
-	"\x0a\x94R\x04\x0fn\x85*Z\\\x14\xa0\xa8E\xeaC" +
-	"\x01\x09\x11\xa5R\x9b<\x81\x0a\x08\x0b\x15\xab*\x14C" +
-	"\xad&\x80\xbdh\xbe\xbd\xfdsg\xe7l?\xf4\xedn" +
-	"\xbe\xd9\x99\xf9f~\xf3\x9bo\x0e?+\x1e\x17\xee\x94" +
-	"\xeeS\x00\xf4\x87\xa5\x98\xbbz\xe3\x9b_\x88e3_" +
-	"\x01\xb5\x17\xdd\xa3\x1b\x0f=\xf9z\xe5\xc5\xaf\x82\xc4d" +
-	"\x80\xa3q1\x81\xda\xad\xa2\x0c\xa0\xf5\x8a?\x01t\xaf" +
-	"\xe6\x7f\xfd\xeam\x1f?\xf0(\xe8\xfbQ\x00\xa0\x93\xa3" +
-	"\xcb\xe2\x02\x02j/\x8b\xb3\x80\x9b\x97\xaf^?\xf4\xdb" +
-	"\xb5Eu?6Oo\x95.#\x88\xae\xa1>r\xc5" +
-	"\xf8\xce\x93\x17\xe8C\xff(%\xfd\x92>\xec\x93\xb2\x80" +
-	"\xee?\x9f\xfb\xee\xfc\x8bo\xfe\xe9\x02\xe8\xbd(D\xe2" +
-	"\x90\xc9\xf9=\xd2\x8a6\"\xd17\xa7$\x17\x01\xdd\xc3" +
-	"\xe3\x0f^\xfb\\\xdf\xcf\x1fo\x9a\x93\x90\xce\x0c\xf9i" +
-	"\xb27%\xcf\x02\xba\x8a\xf6\xe3/M\xf5W\xbe\x0d<" +
-	"\x14O\xe1\x0d\xf9<)\xfcE&\x87\xbf\xf9\xd0\xc7\x92" +
-	"3\x9b\x07\x97\xa2\x0a\xa8<G\x0a\xaaB\x0a_\x9c}" +
-	"\xfe\xdd*^z:\xaap\xa7\xc2C>\xc1\x15>\x9c" +
-	"K|\xe6\xcd\xfb_y\xa6-s^,\xcaGP\x9b" +
-	"R(\xf8\x1aW\xbe\xf2\xfe\xc9\xe2\xf5\xdfM\xbfD\xf7" +
-	"k\xd7\xd6\x16\x95\xffhOq\xe5'\x14\x0a\xfedr" +
-	"\xbe\xb1\xf1\xad\x7f\xbf\xb4MM\xb45\xe5\x0f\xda\x06\xd7" +
-	"\xbd\xa1PIJ\xbf\xd8\xcc\xec\xbb{\xf6\x956]\x9e" +
-	"\xe1'\xe2\xc7P\xfba\x9c\x94\x97\xe2\x7f\x03t\x7fT" +
-	"Y\xf8\xd9\xdb\xaf\x7f\xadM\x99\xeb\x9eM\x1cA\xed\xeb" +
-	"\x09\x19\x98{h}\xf0\xdd\xe5\x95\xfd\xafns1\xcd" +
-	"L\\\xd6\xa6\x12\xfc^\x89,\xe0\xe6\xc1\xe2c\xf7\xd9" +
-	"\xd5\xd7\"9\xfaA\xe2\xa7\x94\xa3e:v_\x1e\xbf" +
-	"x\xc08\xff\xe7k-\x85z\xcd\xd3\xf8c\x82\xee\xfa" +
-	"\xc0\xaf\xae\x08'\x16\xdfZms&\xf0\xbaw\xbd\xad" +
-	"\x8dt\xd1\xafS]\xa4\xfb\x8c\xfb\x8d\x17\x16\x17\xf6\xbd" +
-	"\xb3]\xc6\x97\xba\x06Q\xbb\xc4\x95\x97\xbb\xc8\xf5\x85\xbe" +
-	"\xb7*\xef\x8f\xfe\xfe\x1f\xad\xae\xbb.r\xd7\xdc\xdc\xdd" +
-	"\xcfg\xfa\x1e\x1f\x8e\xad\xb5\xd7\xc4\xf3\x9d\\\xd1F\x92" +
-	"\xdcw\x92\xf2\xbc\xef\xb6g\x17\xbe\x9f[]\x8f\xc2a" +
-	"=y\x95\xac\xc5S\xe4\xef\xaf\xf3\xd7R/\xac\xc4n" +
-	"\x80\xda\xcbBc\x80G\x0f\xa5\x04\xd4\xeeJq\xfc\xa4" +
-	"\x1eC\xed\x9en\x19\xc0\xed\x1d\x95+\xffzc\xe8\xbf" +
-	"Q{\x07\xba\xbfG\xf6\xee\xea&{\xb3\xb3\xb3w\x14" +
-	"\x8c\xc9:\x9b\xbc\xe3\x9caM\xdcN\xbf'\x8f\x0dU" +
-	"\x1b\x85J\xde\xb4\xa7\xab\x0e@\x0eQO2\x11@D" +
-	"\x00\xf5\xd4 \x80~\x9c\xa1>*\xa0\x8a\x98\xa1[\xab" +
-	"#$<\xc9P\xcf\x09\xa8\x0aB\x86\xdaV\xbd\xff\x08" +
-	"\x80>\xccP?-`O\xc9\xb0K\x98\x02\x01S\x80" +
-	"=E\xc31\xfc?\xfd\x13\xe4\x0b\xd3!\x1c\x011\x1d" +
-	"\x89Lj\x89l\xdc\xb0&\xc6Lk\xc6\xb4n?c" +
-	":<\xce\x81\x9ca\x195\x1bt%\x08\xf2\xd01\x00" +
-	"}\x80\xa1~\x98\x82\x14\xbc ?\x99\x07\xd0?\xc1P" +
-	"\xff\xb4\x80\xd9\x92Y>Sr0\x0e\x02\xc6\x01\xdd\x19" +
-	"\xd3\x9ah\xd8e\x07\xf0,\x8a \xa0\x18\x09 \xb6S" +
-	"\x00\x85\x92Q\xae\x8f\xd4\xbf\xdc\xf0#\x81\xdd\x07?\xcc" +
-	"\xe3\x18\xc8gy\xb2m]\x0c\xee\x90\xa2;(\x0c\xf5" +
-	"\x8c\x80Y\x8b\x1f\x07\xe1\xfa\xe6\x85\x16\xf3\xc3\xa6!\x17" +
-	"M\x8b\xea5\x10\x98\xf9\xfb\x10\x80\xbe\xcaP\x7f/R" +
-	"\xaf5\xaa\xd7;\x0c\xf5\xeb\x02b\xb3\\\xeb\x16\x80\xfe" +
-	"\x1e\xc3<\x0a\xa82\xcc \x03P7(g\xffc8" +
-	"\xa6\x90T\x142(\x02h\x12\x0e\x01\xe4\x91\xe1X\x92" +
-	"\xc4\x12\xcb\xa0\x04\xa0\xc5\xf1<\xc0X\x92\xe4\xb7\x90<" +
-	"&f0F\xac\x8e\xe7\x00\xc62$\xdfOrY\xca" +
-	"\xf0.\xef\xe3\xf2}$?Hr%\x96A\x05@;" +
-	"\x80\x0b\x00c\x03$?\x8c\x02\xce\xcf\x98\x96]n\xd4" +
-	"\xfd\xc2\xf48\xe5\x9a\x89\x12\x08(\x01\xbaU\xc3\xe6\x99" +
-	"\x84\xfe\xcap\x04c\xae\xed\x18\x8e9l\xd8\x80\x81l" +
-	"\xde\x99\xb3[t\xe8\xdb\xbcY0\xb1<\xe9\xf0\x13\x80" +
-	"\x96\xb3{\x1b\xb5\x1ad\xcbN\xcbG\x85F\xadVv" +
-	"\x1c\x13\xb2V\xab\xb5I\xab1\xd9\xb0M\x0bO\x14\x8b" +
-	"\x96i\xdb\xa1\xad\xdd\xa0\xe1\xf3F\xb5\\4\x9c\x86E" +
-	" \x92\x8d\x9a\x1d\x85\xf3P\x08g\xbf\x84Q4\xcf\x1b" +
-	"\x9e\xc7\xc0a'8\xef\xdcO^\xe3\xdb\x00;\xa01" +
-	"\x1d\x8e\xf5=\xf4lx\xd1\xbci\xf7\xec\x02\xf4\xe9\x90" +
-	"\x91\xf7\xe0\xe6\xb4e\xd4m\xa3\xe0\x94\x1b\xf5\xddvW" +
-	":\x1csm\x8eZ\xd9\xf1D\xa1\xd0\x98\xae;\x94&" +
-	"Vu\xa8\xdd\"v\x07C\xbb-<w\x93\x9e\xbd\xb7" +
-	"Q\xcbr8\xf1\xb6\xdd\xa1\xe4\x14\xf3A\x86\xfa\xa7\xb6" +
-	"\x96<Kx\x9f\xb6\xb7T[h\xe7uV\xa8\xb4\x11" +
-	"\xfa\xb1\xed\x08}<\xe4\xee\x80\xd0\xf5\x8f\x02\xe8\xa3\x0c" +
-	"\xf5\x078\x81\x1aE\xd3\xc2t\xf8\xa6j\xe6,h\x1c" +
-	"V\xa6\x94\x06\xe3\xcf;\x96\x9d9\x1b\xd3\xe1\xf0\xef\x98" +
-	"\xe8H\x0d\xb3\x1e()\xf4t\x10\xbaA\xc9~\x90\xa1" +
-	"^\x8a\x84n\x92\xf0a\x86z5\x12zy\x02@/" +
-	"1\xd4\x1d\"7\xe6\x91\xdb\x14\xe5\xb8\xcaP\x9f\xeb4" +
-	"\xa0\\\xa7\x19\x05\xc8DB>\x97Xf\xc1,O\xd2" +
-	"\x0d\x83\xb7E\xc7\xbb\x84\x93\"\xdf\xef_\xe5&U:" +
-	"=g{Sw\x1b\xb8\x0eP\xea\x0d\xbbd\xda\xd8\x0d" +
-	"\x98c\xc8\x03\xea\xdeK\x7f7GN\xae\x9f\x0f\xab\x9b" +
-	"a7\x9a\x8f=7\x9co;\x82\xe7\xc1\xce\x14\xd6\xea" +
-	"\xaf\x13\x7f\xb5\xe6*o\x16z\xa8\x0em\xdd3\x18\x9d" +
-	"\xff\xbe\xbb\xc1\xb0}:\x94{\xfb\xf2\x05\xac\x957{" +
-	"| \xee\xa5\xeb;e\xae\xc9'\xde\xeb\x01?0\xe2" +
-	"\xdfB<r\xad\xec\xb4q\xc1\x91\x90\x0b\x02*\xc8G" +
-	"\xa9\x00\x9bT@\xfc\x90\xf3\x9a\xac\xdfjL\xd7\x8b\xa8" +
-	"\x80\x80\x0a\x0d\xde\xf2\x99\xba\xe1L[\x80\xe6\xd6\x99\xc9" +
-	"\xac\x00\xb7\xe9p[\x01lA\xf0\x1e\x1e\\\x1e+\xe0" +
-	"\xce\xcc\x1e\xec$\x1d\x9b4\xf4\xc5\xfb\xef\x16&\x01\xf8" +
-	"\xdbf\xe4m~\xe9\"\x08\xea\xb2\x8c\xe1\xaa\x8a\xfe&" +
-	"\xa8.}\x16\x04\xf5)\x19\x85`+A\x7f\xc7S\x17" +
-	"\xcf\x81\xa0>*#\xf3\x17\x9apcU\xcf\xd2\xd9\x94" +
-	"\x8cb\xb0R\xa0\xffxW\xcdq\x10\xd4\x87d\x94\x82" +
-	"\x95\x14\xfd\xc5R\xd5\x1f\x01A\x1d\x91]?7\xe8'" +
-	"\x07\x1b\xc71\x90\x02\x80\xf7\x8fw)d\xbd>\x8d*" +
-	"d=Z\xf0D\x1c\x91\xc0\xea\xcd\xbf\x1c\xfc\xd0C\xf0" +
-	"?\x8eQ\xde\xda\x0d\xaay\x89\xd8.\x86o\xb0\xe9z" +
-	"%\xfa\x7f\x00\x00\x00\xff\xff\x17\xc2:\x05"
+	return ZarbServer_getTransactionProof_Results{s}, err
+}
+
+func (p ZarbServer_getTransactionProof_Results_Promise) Result() ProofResult_Promise {
+	return ProofResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+const schema_84b56bd0975dfd33 = "x\xda\xb4Y}pTU\x96?\xe7\xbd\xee\xdc\xceG" +
+	"'y\xde\xce\x92P\x89\x81]\xd8\x82\xac(\x04,\xd7" +
+	"T\xb1\x9dF\xdc\x05\x16\xdc\xdcnp!\xa33\xbet" +
+	"?H\x9b\xf4\x07\xef\xbd\x90\x84\xc2BQJe\x04\x94" +
+	"\xc1\x11\x1d\xb5\xca\xaf\x9aR\x06\xbf\x99Q\xab(\x07G" +
+	"\x07\xa1tFPk\x06J\x9d\x81\"\x0a\x14\x8e\xc4\x81" +
+	"R\x18\xc3\x9b\xba\xef\xab_7\x1d\x920\xce\x1f7\xd5" +
+	"\xb9\xe7\xbcs\xcf9\xf7\x9c\xdf9\xf7\xde\xe9\x81\xd2V" +
+	"a\x86?\x13\x04`\x8f\xfaK\x8ccg\xef[V\x12" +
+	"\x0e\xdd\x09R\x0d\x1a3\x87n~\xe8\x83\xae\x9dw\x81" +
+	"_$\x003\x1f+)C\xba\xa3\x84\x00\xd0gK^" +
+	"\x004\xde8\xb5\xe4\xd6\x81\x13\xe3\xee\x04i\x02\x02\xf8" +
+	"\x913E\xc8>\xa47\x13b\x8f0\x00}\x98\x10\xe3" +
+	"@\xf47\xbb/\xff\x8f\xc9\xeb\x81M@\x01\xc0\xc7Y" +
+	"\xd7\x93u\x08H\x1f \xbd\x80\xc6\xaf6o\x7f|\xda" +
+	"\x07\xab7ze\x0d\x92CH\x83\x01b\x0f.\x8b\x05" +
+	"\xc8\xf9}\x07\xbe\x9d\xbagp\xa3\xc9h\x0a\x9a\x1d\xd8" +
+	"\x87\xe03d\xe9\xd6\xf7\xe4\x9f>\xb4\x89\xaf\xe1\x90f" +
+	"\x04\xde\xe0kD\x02a@\xe3\xd4s\xdb\xd6\xee\xfc\xe4" +
+	"\xb3M\xc0jP\xf0XG\xb8Ir\xe0\x08M\x05\xf8" +
+	"7\xc9\x80\x81\x80\xc6\xf4\xf6\x9b>\xfc\xdf\x86W7\xdb" +
+	"\xe2,\x95\xd6\x97=\xc9\xe5=X\xc6un?\xd4\xf5" +
+	"\xf5\xfbG\xef\xbf\xdf\xab\xf3\x99\xb2\xb7\x90\x06\xcb\x89=" +
+	"L\x9d\xcb\x89\x11\xa0\xbf\xf8\xe1\xca\xc6\xae\xad^\xd6\xd9" +
+	"\xe5\x1b\xb8\xacE\xe5\\\xb7\xb7/\xfb\xf7\x8aU\xe7\xa7" +
+	"<\xe1eH\x95?\xc7\x19n3\x19~\xd0\xbb\xfd\xab" +
+	"n|\xe9I/\xc3c\xe5\xa6u;L\x86qme" +
+	"\xff\xf5\xc9\xa2]O\x15l\x9d\xc9\xf8~\xf9x\xa4\x9f" +
+	"\x96s;\x0f\x9a\xcc\xd9m\xdb_X\xf8\xe2\x84g\xb9" +
+	"+\xc4\x1c\xb7\xe9\xb3\xb3\xe5\x02R\x7f\x05\xb1\xc7\x17\x00" +
+	"T\x0a\x927#\xda\x0d\xe5\xf7\x1f\xde\xcej\xf0\x82\xc8" +
+	"\x18\xaa(C\x1a\x0c\x12{\x98_T\x12\xe3\xe4\xefn" +
+	"zGYp\xfa\x15\xaf\xcaC\xc1}H\xeb*\x89=" +
+	"\xb8\x7f\x96T\x12\xe3\xbdo\xe6&\xbe\xdd\xdb\xf3\x1a\x14" +
+	"\x88\xe7\xdf\xd0H\xe59\xba\xa8\x92\xff\x9a_\xc9\xfd>" +
+	"\xb7bmf\xe8'\x7f}\xadH\x90\xd2g*\x0f\xd1" +
+	"\x97L\xde\x1d\x95<F;\x7fy>T\x7fM\xef\xae" +
+	"\x02^\xd3\xd0\xf9U-H\x97Uq\xe6%U_\x00" +
+	"\x1a\xcfv\xad{\xe5\xc4\x07\xf7\xec\xe2Z\xf8<\xdc<" +
+	"\xe6gN\xabnF:\xbb\x9a\xff\xbc\xb6:#\x00\x1a" +
+	"\x8fm}s\xe6\x8f\x1e\xef\xfc\xb5\xd7\xbe\x89\xf4\x00\xd2" +
+	"\xd9\x94\xd8\x83\xdbw\x07%\xc6\xd43M_\xed82" +
+	"aw\x91\xcd\xa1)\xba\x8f\xf6S\xfe\xab\x87\x86\x01\xcf" +
+	"OI\xdc\xfd?Z\xf7\xfb\x1e\xa1\xcf\xd0\x97\xf9>\xef" +
+	"\xe4dcA\xd3o_~\xdd\xf7\x87\xdf\x17\xbaJ\xe0" +
+	"\x9c\x1f\xd3v\xa4\xc7)\xb1\xc7\x0b\xdc#!b\xbc\xb9" +
+	"[y{\xcfk\xff\xb2\xdf\xab\xe9\x03\xa1\x03Hw\x84" +
+	"\x88=\xb8\xa6\x83!b\xbc\xfa\xcaG\xc1[>\xf9\xff" +
+	"\xfdEw\xe2\xd3\xd0!z<D\xec\xd1\x0b@\xfbk" +
+	"\x88\xf1z\xfb\x96\xc9\xf2\x86?}\x98\x97)J\x8d\xa9" +
+	"sO\x0d\xdf\xb1\xad\xe9\xef\x8e?un\xcfg\x85\x99" +
+	"gn\xc3\xc75\x97!\x1d\xa8!|\xcc\x1c\xa8\xd9\x8c" +
+	"|\xa1q\xc4x|\xed\xdel\xf6i\xf6g\xaf\xd2{" +
+	"\xc7\x1d@:0\x8e\xd8\x83+=\xb1\x96\x18K\xdfz" +
+	"O\x88l\xfc\xfcX\x81{\xb9Kh\xb0\xf6\x04\xad\xab" +
+	"\xe5\xbfjj\xb9.\xe7\xbe;\xb7\xfa\x86M\xffw\xc2" +
+	"+ve\xed!\xa4\x1bk\x89=\xb8\xd8\xbd\xb5\xc4x" +
+	"\xca\xf8\xf1\xf3\x1b\xd7\xd5\x9f,\x96R;k\x9b\x90\xbe" +
+	"c\xca\xdd]\xcb\xf7eS\xc3\xe7]\xdf,<\xf8\x97" +
+	"</\x1c\xae\xdd\xc2\xbd0h\xae|\xcd\xf6P\xc3\xe6" +
+	"y%\x83\xc5v\x8e\xb2\xba#\xf4\xe6:\xfekY\x1d" +
+	"\x0f\xdc\xfa\xcb\x7f\xbe\xee\xd1\xb6cg\xbcj\x96\x8e?" +
+	"\xc0\xa55\x8c\xe7\xeb\x1d]\xfba\xf0\xf9#%gA" +
+	"\xaa\xf1d0\xe0\xcc\xd9\xe3\x05\xa4\xf3\xc7\xf3\x0f\xae\x1f" +
+	"\xbfG\xa0O\xd4\x13\x00\xa3f!\xe9\xfaz\xff\x9c\xbf" +
+	"y\xe5\xdd[\xff\x08\x97\xf7p=\x97w\xb0\xea\xdc1" +
+	"\xfd\xee\x7f;\xefex\xbd\xfe\x0d\xa4\xfb\xeb\x89=\xb8" +
+	"_\x82\x0d\xc4\xd8\x15\x0e>0\xf4\xb3\xe7\x0d/\xeb\xd9" +
+	"\xfa#Hk\x1a\x88=8\xeb\xb2\x06b\xf4\xf6\xf6^" +
+	"\x15\x97\xb3i1{\xd5jY\xed\xb8\x92\xff\xce\xb6\xcc" +
+	"\xe9\xce\xc4\xbb\xa2\x8a\xd6\xd3\xad\x03\xb4!\xb2\x0a\xd1\x07" +
+	"\xe0C\x00\xe9\xfa&\x00\xd6*\"[(\xa0\x84\x18B" +
+	">9\x9fO\xce\x15\x91\xb5\x09(\x09B\x08\x05\x00i" +
+	"Q3\x00\x9b'\"[,`U\xa7\xacub\x10\x04" +
+	"\x0c\x02V%d]v\xfei\xec\xe0kau\x0e5" +
+	"\x00\xb1\x1a\xd0\xd5\xac$O\xb3vY\xed\x88)\xea*" +
+	"E\xbd2\xa1\xf6G{\xd2\x8bU9\xad\xc9q=\x99" +
+	"IOj\x93U9\xa5\x99\x1a\xb7\xa1\xc0|\xae\xd2\xc1" +
+	"f)HX\x85\x88\xacV\xc0FU\xee]\xdc\xd7\x86" +
+	"\x82\xa3Bkn1\xffp\x8b\xadPt\xd3)\xce\x1a" +
+	",\xe0\x0a\x9f\xda\x02\xc0&\x89\xc8\xa6s\x8f\x08\x96G" +
+	"\xa6E\x01\xd8\x15\"\xb2\xff\x140\xdc\xa9$Wt\xea" +
+	"X\x0a\x02\x96\x02\x1a\xab\x14\xb5#\xa3%u\xc0~\xf4" +
+	"\x81\x80\xbe\xd1X\xab)\xe9DT\xee\xf5\x9akn\x90" +
+	"\xa8k\x17\x9a\xdb\xe2\x98;A\xc0\xb0j\xee#\xb7\xb7" +
+	":\x07N\x00\xad(!i\x13Lg\xb7\x8eB\x01\xc7" +
+	"\x03\xf1N9\x99\x9e\x9f^\x9eq\xdd=z\xef\xcd3" +
+	"\x1d1)\x1a6U\xd2\xf2T\x06\xeeSd!Wc" +
+	"\xd7_\x8ex!O\xfc<E&\x09E\xe5\xd19\xc9" +
+	"\x15\xf3\xe5\x1c\x00vLDv\xda\x13\x9d\x83<:O" +
+	"\x8a\xc8\xbe\x15\x10\xed\xe0<\xa3\x02\xb0\xd3\"FQ@" +
+	"I\xc4\x10\x8a\x00\xd2\x10\xdf\xb4\xefD\x8c\x05\xf8\xacO" +
+	"\x08\xa1\x0f\x80\xfaq\x0e@\x14E\x8cU\xf0i\xbf\x18" +
+	"B?\x00-\xc5\x0d\x00\xb1\x0a>_\xcb\xe7K|!" +
+	",\xe1(\x86\xab\x01b!>?\x81\xcf\x13\x7f\xc8\xc4" +
+	"\xe6\x06s\xbe\x9e\xcfO\xe1\xf3\x81\x92\x10\x06\x00\xe8d" +
+	"\\\x07\x10\x9b\xc4\xe7\xa7\xa3\x80kW)\xaa\x96\xcc\xa4" +
+	"\x9d\xc8\xa8\xd2\x93)\x05\xfd \xa0\x1f\xd0\xe8\x965\xd3" +
+	"\x93\xd0\xd85\xcf\x93Q\x86\xa6\xcb\xba2O\xd6\x00\xdd" +
+	"\xb9\xb5z\x9f\x96\xc7\xc3\xbf\x8d*q\x05\x93Y\xdd\xa4" +
+	"\x00\xe4\xd1\xae\xcb\xa4R\x10N\xeay\x1f\xc53\xa9T" +
+	"R\xd7\x15\x08\xab\xf9\xd2\xb2j&\x9b\xd1\x14\x15#\x89" +
+	"\x84\xaahZN\xd6h\xa2\xe1F\xb9;\x99\x90\xf5\x8c" +
+	"\xca\x83\x88\xc8)\xcd\x9bOsr\xf9\x84xa:\xad" +
+	"\x95\xad\x15\xdd\x05/)\x9fV(z$\x1e\xcf\xf4\xa4" +
+	"\xf565\x93Yn%\x93\xae\xc1\xe8\x93\xc9\xe9\xb8\x86" +
+	"M\xa5\x91\xc1\xc4Y\x14F\xc8\x84\xea\\\xe3_\x80\x8e" +
+	"\xa3srT\xd1\xaaF\x91p\xd5\xb9\x8a:\x86e\xf2" +
+	" it\x99]\x9d\xeb\xfb\x0a\x16\xca\xafC\xf6\x0eq" +
+	"7\x89\xdc\xeb\xe8\x95\xdb\x94\x93\x9bWQ\x86\x91\x15\xeb" +
+	"O\xc7c\xba\xac\xf7\xb8{\\\xed\xca\x92\xdb%\x85\xb0" +
+	"\x84\x88,\xeb\xc1\x8dT\xbb\xb4\x92\xb0\xac\x88l\x0d/" +
+	"kh!G\x7fT\xba\x8d\xb05\"\xb2{8t\xdc" +
+	"nA\xc7\xfa\x05\xd2\xbd\x84\xdd#\"\xdb*\xa0\x91U" +
+	"\x14U\xbb.\xd3\x03b\xda\x8c\x15'*\xd3\x99\x84R" +
+	"l>%\xf7\x99\xd0\x08hN;\xc0\x97\xd4\xb8\xd2J" +
+	"\x02\x00\xf84\x02\x1f\xd8:\x9c\x85f {\xaav\x81" +
+	"\x8dM\x92L\xd8-\"\xb2n\x8f\x8d\xc9f)IX" +
+	"\xa7c\xa3\x8d\x8e\xfdMR?a}\"\xb2\xbb\x04D" +
+	"\xd12\xf1\x8e\x16\xe9\x0e\xc2n\x17\x91\xddg{\xdcS" +
+	"C\x1b\xb3|q\xab\xc8\xb8-j~fT\xa9\x99\x8c" +
+	"\xee\xf9\xc4.\x8b\x1e\x83GY\x86\xdc\xb8\xb62w\xf8" +
+	"\xaa?\xc7S\xf5\x1d\xd4(^\xf7\xf3K\xcbu\x99T" +
+	"\xd8D=\xb3\xba\x8c\x80L<\xbc\xa7\x88\xc8f]\x88" +
+	"La\xcd\x8c\xb7\x0b@I(l\xb6\xc4xWA\x97" +
+	"\xd5R\xac\xcbj\xcf5T\xeeV\xb1\x7f\x05`\x0bE" +
+	"dK\xcdFCN(*V\xe7\x8e\xd2vz\xb9\xf8" +
+	".&y\xf6\xb9\x9d\xaeE&z\x9f\x86\xd5\xb9C\xd0" +
+	"Es\xd2\x93\xee\xe1\xa8\x8d\x86\x98\x17g\x00\xec&\x11" +
+	"Y\xa7Gu\x85O:\xb1\xe7\xa8\x9e\xec\x000CO" +
+	"\xe7\x89dG\xd9J\xee\xe3n\x11Y\xdf\xc5\xbaFC" +
+	"\xb7\xb5\x00\xc2k\xa5S\xf2T%\xae$\xb3\xdcB\xf7" +
+	"\xc4qQ[r\x0dM\xb4\xd15\xe5\x0a\xc7\x14^\x9a" +
+	"\xe9T$\xb1)\xbc8\xcfBw\xd3\xe9\x0cT\xe9\xd5" +
+	"Hb\xb38\xa1\x15s\xe8@g\xa3J#Hb\xad" +
+	"\x9c\xb2\xd0\xd3\\\xd0\xf9\xd8A\x17!\x89-\xe4\x94\xa5" +
+	"\xde\x06c\x09F\xe92$\xb1\xa5\x9c\x92\xe0\x14\xbf\xcf" +
+	"\xea1dT\xa9\x82$\x96\xe0\x94\xac\xd9e\xf8\xad." +
+	"#\x85\xeb\xe8J$\xb1,\xa7\xac\xe1\x14\"Z}F" +
+	"?\xb6\xd3\xdb\x90\xc4\xd6p\xca6\xb3\xd3\xf0Y\x9d\xc6" +
+	"\x83\xb8\x80>\x8c$\xb6\x8dS\x9e\xe6\x94\xd2\x92\x10\x96" +
+	"\x02\xd0'\xb0\x9d>\x83$\xf64\xa7\xbc\xc8)e\xfe" +
+	"\x10\x96\xf1S:\xb6\xd3\x97\x90\xc4^\xe4\x94\xb7Q\xc8" +
+	"\xb5 hws\xe0E\xac\x82\xfe\xc4\x93l^\xd2\xe2" +
+	"dJ\xe1$\xa7\xabY\xa1\xa4\x15-\xa9\xcd\x03R\xf0" +
+	"\x8d\xb957\xc8)@\x93\xbf\x02\xf8@C\xcf\xe8r" +
+	"w$\x1e\x87F^\x1d4/\x92\x9a\xa4\x1b\xe5n\xb4" +
+	"\x10B\x03/q\x95\x0d\x1c \xaa\xe6G\x95\x80m\"" +
+	"\x9a\xabUzZ\x1a\x1bn\xddP\xebk\xcbd\xbac" +
+	"I\x10W+^i\x9a]P@\xec\xd1,\xe0so" +
+	"m\xf2\x81\xefR\x8e2Vf\xe1Xz{\xf7\x10?" +
+	"lC\x92\x8f;\x8b\xfb,\xd0,V\xab'q0\x91" +
+	"\xb5NE+t\xd2X{\xfd\xb6F\x13\x9e\x87+\xdc" +
+	"\xde\x0cwe\xfb\xf2\x0b\xb7\x92Nx\\\x93k\x06\xb8" +
+	"c<\x909^\xba\x9e8\x87P\x07w\x16\xb5H\x8b" +
+	"\x88\x03\x8fn\x09_\xd2,-!l\xb1\x88\xec\x16\x01" +
+	"\xc5d\xc2[\x94,\xc8\xf6\xecs\xa3\xa2\xaa\x19\xd5\x13" +
+	"~\x97V\xa5\xc6\xbe\xa1#\xf6\x97Ba\xf5\x173\xcb" +
+	"m\xf1\x9e\x9a\xd5,M%N\x81r\xdc2c\x81t" +
+	"5a\xb3,_5&\xd3\x09\xa5\xcf\x9b\xc5Z\xb2\xa3" +
+	";\x99^\xa1\xd9\x89\x90\x1f\x01\xadc\xef\x0a\x9d\x18\xf0" +
+	"h\xd5t\xf1\x1e??..\xd6\xe0\xe7\x03\xfa\\3" +
+	"\x91L_\xa3\x13\"\xf5\xee\xaa;\xe7H;\x09{U" +
+	"D\xf6nn\xd9w:\xa4\xbd\x84\xbd+\"\xfb\xc8S" +
+	"\x9b\xf6o\x90\x0e\x12\xf6G\x11\xd9Q\xcf\x01\xf1\xf0\x02" +
+	"i\x80\xb0\xa3\xf6\xb1\xd1\xc6oi\xa8\x9d\"\x12\xf3\x84" +
+	"X\xef=!\xd6a\x0b\xadC\x12\xab\xe5\x94I&z" +
+	"\x8b\x16zO\xc4f:\x11Il\x02\xa7\\\x81\x82[" +
+	"\xb9\xacL\xce\x15\xaf|\x1cQ\xfa\xb2J\\W\x12@" +
+	"\xfe[\xc9\x03P\x87\x801ee\x8f\x92\x8e+\x90\x87" +
+	"{\xb2\xd5F\x17\xdf\xcf\x11P\xf1{J\x88\"\xd7\x17" +
+	"f\xdf\x86\xda\xa5_\xd6\xe4\xc7\x7fT\x89WY.\xc4" +
+	"a\x02\xcd\xedF\xa65\xe5z\xb6\x8b\xf4\x18\xa3\xc9r" +
+	"\x8fA\xb9nTL\x15\xb1\xaa\xc9c\x95\xb9hq\xa3" +
+	"\xf2\xe3\xd9\x05\x91\xa8R\xe5\xf4'c9\x02\xf9G>" +
+	"\xfe:\xfb\xf0\xcf:\x81_\xd0Z\x93TR/\xe8v" +
+	"\x9bs\xdd\xae\xdb\xecF\xbd\xcd\xae\x0d\xdc\x8cw\xc0m" +
+	"V\x1b\xd9\xa8fz\xd2\x09\x0c\x80\x80\x01\x13\xb3V\xa4" +
+	"e\xbdG\x05T.\xbc\xbc\x10U\xb7\x8eU\xe7\xde2" +
+	"\x00\xb1r\x94\xdb\\p\xf3\xe5\x80\xf9\x88\xc7\\\xf7\xc5" +
+	"\xe2\xa2mhn-\xb0\x1aP?\x80\xf3\x8c\x96\xbbh" +
+	"\xa6\x0c\xb7\x80\xc0{HD\xf7\xc1\x0e\x9d\x97+\x1a\xc1" +
+	"\x05 \xd0k\x91\xa0\xe0\xde\xf8\xa3\xf3*E\xa7\xe1j" +
+	"\x10\xe8d$(:\x0f\x18\xb9\x079ZgR%$" +
+	"\xe8s\xef\xc9\xd1\xb9\x91\xa6~l\x07A\x1a\"\xe8w" +
+	"\x1f\xdd\xd0y\x0f\x93\x06o\x05A:N\xb0\xc4}\x0f" +
+	"@\xe7\xadP\xfa\xf4\x11i\x80D\x8eb\xe4\x18J_" +
+	"\x12$\xee\x8b$:O3\xd2\xe1-\xd2q\x129\x86" +
+	"\x91\x93(\x0d\x12\x0c\xb8\xd7\xdc\xe8\xbc\xdeI\x03\xeb\xf2" +
+	"XJ\xddw+t\x9eM\xa4\x81-\xd2\x97$r\x12" +
+	"#\xa7P:C\xb0\xcc}D@\xe7*\\:\xfe\xa4" +
+	"4H\"\xa70r\x1a\xa5\xb3\xc4p\xf6\x14\x9dM\xc5" +
+	"L+\xba\xb3&\xf0\x1aNzC\xd8Jp/C\xd8" +
+	"jo\xac)3\x93\xf8\x99\xde\xfa\xd7LZ\xa8\xe2i" +
+	"\xdb\x8a\x86\x83|\xe8 \x85\x98I\xb7\xa1`\xc1\xba\xf5" +
+	"\xb7\x15\x0d\xa7\x03D\x07N\xb0\x08\x93\xb3\x14\xda\x97V" +
+	"P\x94\xc5\\\x1e\x9d\xce\x03\x97\x17e2\x0dC\x07\xb8" +
+	"\x88uv\xcfgk\xc3\xb1\xe1\x88s\x1d=bR\xb8" +
+	"/\xa1c\xb8d\xca\xbb\xab\x0b[G\xfe\x7f\xe4\xc0?" +
+	"&L\xe7\x96\x91n\xfd{\xe8\xdd\xfe>\x00\xb3\xce\x07" +
+	"\x88"
 
 func init() {
 	schemas.Register(schema_84b56bd0975dfd33,
 		0x83143f06598cf9e8,
+		0x8317eae56a55f0ba,
 		0x85252b1ec1c352d2,
+		0x8d7ad02d9eab8fb7,
 		0x8df1c729f8d2ca00,
 		0x8e979661cc6a1161,
 		0x8ededcb57f98aaf0,
 		0x8fb41d4bd35c5a30,
+		0x9090e4cdf26bda5a,
 		0x946b1f715eac1308,
 		0xa128fe760c2612c4,
 		0xa2b1016cefab775b,
 		0xa3bd4ddc3e0a5017,
+		0xa920b04cafab9870,
+		0xab00e1900b4e7341,
+		0xb3f44a65c55cceec,
 		0xb875c9f86444f7cc,
 		0xb8f393fd6f7f0c44,
 		0xbd77371c14feb668,
 		0xbd88d0eab3826ba9,
+		0xc0689e5f33bf949d,
 		0xc120e2adef2af529,
 		0xcd6c734787642800,
+		0xcfd704b9b2c62a4a,
+		0xd116b8c7c465c1bf,
+		0xd157dc600dd4b3b4,
 		0xd3df8a6125925ab9,
+		0xdec7faa3e9fc6e94,
+		0xe051a47070c97f9e,
 		0xe8e68d4102ccc258,
+		0xea4f8e4e7afafcfa,
 		0xec1c828dae8bffa3,
 		0xeed94cf76be61d8e,
 		0xf106488f1d14ab37,
 		0xf5e8509c82a71e1c,
 		0xf906e2ae0dd37fe4,
-		0xfb42d1f26b074c15)
+		0xfb42d1f26b074c15,
+		0xfe238774e8fa0fd9,
+		0xffae9bfd910d3fbd)
 }
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/validator"
+	"github.com/zarbchain/zarb-go/www/capnp"
+)
+
+func (s *Server) GetBlockchainInfoHandler(w http.ResponseWriter, r *http.Request) {
+	b := s.server.GetBlockchainInfo(s.ctx, func(p capnp.ZarbServer_getBlockchainInfo_Params) error {
+		return nil
+	})
+
+	a, err := b.Struct()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	res, _ := a.Result()
+	out := new(BlockchainResult)
+	out.LastBlockHeight = int(res.LastBlockHeight())
+	lastBlockHash, _ := res.LastBlockHash()
+	out.LastBlockHash, _ = crypto.HashFromRawBytes(lastBlockHash)
+	out.LastBlockTime = time.Unix(res.LastBlockTime(), 0)
+	genesisHash, _ := res.GenesisHash()
+	out.GenesisHash, _ = crypto.HashFromRawBytes(genesisHash)
+	out.ChainName, _ = res.ChainName()
+	out.TotalAccounts = int(res.TotalAccounts())
+	out.TotalValidators = int(res.TotalValidators())
+	proposer, _ := res.Proposer()
+	out.Proposer, _ = crypto.AddressFromRawBytes(proposer)
+	out.TxPoolSize = int(res.TxPoolSize())
+
+	vals, _ := res.Validators()
+	out.Validators = make([]validator.Validator, vals.Len())
+	for i := 0; i < vals.Len(); i++ {
+		d, _ := vals.At(i)
+		if err := out.Validators[i].Decode(d); err != nil {
+			s.writeError(w, err)
+			return
+		}
+	}
+
+	st, _ := res.SyncStatus()
+	out.SyncStatus.PeersCount = int(st.PeersCount())
+	out.SyncStatus.NodesCount = int(st.NodesCount())
+	out.SyncStatus.MaxHeight = int(st.MaxHeight())
+	out.SyncStatus.IsSynced = st.IsSynced()
+
+	s.writeJSON(w, out)
+}
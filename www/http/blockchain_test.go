@@ -0,0 +1,26 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestBlockchainInfo(t *testing.T) {
+	setup(t)
+
+	t.Run("Shall return blockchain info", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		tHTTPServer.GetBlockchainInfoHandler(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		assert.Assert(t, strings.Contains(w.Body.String(), tMockState.GenHash.String()))
+		assert.Assert(t, strings.Contains(w.Body.String(), tMockState.LastBlockHash().String()))
+		fmt.Println(w.Body)
+	})
+}
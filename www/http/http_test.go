@@ -9,6 +9,7 @@ import (
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/state"
+	"github.com/zarbchain/zarb-go/sync/stats"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/txpool"
 	"github.com/zarbchain/zarb-go/validator"
@@ -16,7 +17,7 @@ import (
 )
 
 var tMockState *state.MockState
-var tMockPool txpool.TxPoolReader
+var tMockPool *txpool.MockTxPool
 var tCapnpServer *capnp.Server
 var tHTTPServer *Server
 var tAccTestAddr crypto.Address
@@ -38,9 +39,11 @@ func setup(t *testing.T) {
 
 	tTxTestHash = txs[0].ID()
 
+	receipt := txs[0].GenerateReceipt(tx.Ok, b1.Hash())
+	receipt.SetExecutionResult(1, 0, txs[0].Fee(), []tx.BalanceChange{{Address: crypto.TreasuryAddress, Balance: -1}})
 	tMockState.Store.Transactions[tTxTestHash] = &tx.CommittedTx{
 		Tx:      txs[0],
-		Receipt: txs[0].GenerateReceipt(0, b1.Hash()),
+		Receipt: receipt,
 	}
 
 	a, _ := account.GenerateTestAccount(888)
@@ -55,7 +58,7 @@ func setup(t *testing.T) {
 	logger.InitLogger(loggerConfig)
 
 	var err error
-	tCapnpServer, err = capnp.NewServer(capnp.TestConfig(), tMockState, tMockPool)
+	tCapnpServer, err = capnp.NewServer(capnp.TestConfig(), tMockState, stats.NewStats(tMockState.GenHash), tMockPool)
 	assert.NoError(t, err)
 	assert.NoError(t, tCapnpServer.StartServer())
 
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"encoding/hex"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zarbchain/zarb-go/crypto"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/www/capnp"
+)
+
+// GetAccountProofHandler returns the account and its inclusion proof against the state hash
+func (s *Server) GetAccountProofHandler(w http.ResponseWriter, r *http.Request) {
+	b := s.server.GetAccountProof(s.ctx, func(p capnp.ZarbServer_getAccountProof_Params) error {
+		vars := mux.Vars(r)
+		return p.SetAddress([]byte(vars["address"]))
+	})
+
+	a, err := b.Struct()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	res, _ := a.Result()
+	s.writeProofResult(w, res)
+}
+
+// GetValidatorProofHandler returns the validator and its inclusion proof against the state hash
+func (s *Server) GetValidatorProofHandler(w http.ResponseWriter, r *http.Request) {
+	b := s.server.GetValidatorProof(s.ctx, func(p capnp.ZarbServer_getValidatorProof_Params) error {
+		vars := mux.Vars(r)
+		return p.SetAddress([]byte(vars["address"]))
+	})
+
+	a, err := b.Struct()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	res, _ := a.Result()
+	s.writeProofResult(w, res)
+}
+
+// GetTransactionProofHandler returns the transaction and its inclusion proof against the transactions hash of its block
+func (s *Server) GetTransactionProofHandler(w http.ResponseWriter, r *http.Request) {
+	b := s.server.GetTransactionProof(s.ctx, func(p capnp.ZarbServer_getTransactionProof_Params) error {
+		vars := mux.Vars(r)
+		return p.SetHash([]byte(vars["hash"]))
+	})
+
+	a, err := b.Struct()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	res, _ := a.Result()
+	s.writeProofResult(w, res)
+}
+
+func (s *Server) writeProofResult(w http.ResponseWriter, res capnp.ProofResult) {
+	out := new(ProofResult)
+	d, _ := res.Data()
+	out.Data = hex.EncodeToString(d)
+	out.Height = int(res.Height())
+
+	rootData, _ := res.Root()
+	root, err := crypto.HashFromRawBytes(rootData)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	out.Root = root
+
+	p, _ := res.Proof()
+	siblings, _ := p.Siblings()
+	out.Proof = simpleMerkle.Proof{
+		Index:    int(p.Index()),
+		Siblings: make([]crypto.Hash, siblings.Len()),
+	}
+	for i := 0; i < siblings.Len(); i++ {
+		sd, _ := siblings.At(i)
+		out.Proof.Siblings[i], err = crypto.HashFromRawBytes(sd)
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+	}
+
+	s.writeJSON(w, out)
+}
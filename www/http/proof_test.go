@@ -0,0 +1,87 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"gotest.tools/assert"
+)
+
+func TestAccountProof(t *testing.T) {
+	setup(t)
+
+	t.Run("Shall return an account proof", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"address": tAccTestAddr.String()})
+		tHTTPServer.GetAccountProofHandler(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		fmt.Println(w.Body)
+	})
+
+	t.Run("Shall return an error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"address": "invalid-address"})
+		tHTTPServer.GetAccountProofHandler(w, r)
+
+		assert.Equal(t, w.Code, 400)
+		fmt.Println(w.Body)
+	})
+}
+
+func TestValidatorProof(t *testing.T) {
+	setup(t)
+
+	t.Run("Shall return a validator proof", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"address": tValTestAddr.String()})
+		tHTTPServer.GetValidatorProofHandler(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		fmt.Println(w.Body)
+	})
+
+	t.Run("Shall return an error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"address": tAccTestAddr.String()})
+		tHTTPServer.GetValidatorProofHandler(w, r)
+
+		assert.Equal(t, w.Code, 400)
+		fmt.Println(w.Body)
+	})
+}
+
+func TestTransactionProof(t *testing.T) {
+	setup(t)
+
+	t.Run("Shall return a transaction proof", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"hash": tTxTestHash.String()})
+		tHTTPServer.GetTransactionProofHandler(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		res := new(ProofResult)
+		assert.NilError(t, json.Unmarshal(w.Body.Bytes(), res))
+		assert.Assert(t, res.Proof.Verify(tTxTestHash, tMockState.Store.Blocks[1].Header().TxIDsHash()))
+		assert.Equal(t, res.Root, tMockState.Store.Blocks[1].Header().TxIDsHash())
+	})
+
+	t.Run("Shall return an error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"hash": "invalid-hash"})
+		tHTTPServer.GetTransactionProofHandler(w, r)
+
+		assert.Equal(t, w.Code, 400)
+		fmt.Println(w.Body)
+	})
+}
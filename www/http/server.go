@@ -48,11 +48,17 @@ func (s *Server) StartServer(capnpServer string) error {
 	s.server = capnp.ZarbServer{Client: conn.Bootstrap(s.ctx)}
 	s.router = mux.NewRouter()
 	s.router.HandleFunc("/", s.RootHandler)
+	s.router.HandleFunc("/blockchain", s.GetBlockchainInfoHandler)
 	s.router.HandleFunc("/block/height/{height}", s.GetBlockHandler)
 	s.router.HandleFunc("/block_height/hash/{hash}", s.GetBlockHeightHandler)
 	s.router.HandleFunc("/transaction/hash/{hash}", s.GetTransactionHandler)
 	s.router.HandleFunc("/account/address/{address}", s.GetAccountHandler)
 	s.router.HandleFunc("/validator/address/{address}", s.GetValidatorHandler)
+	s.router.HandleFunc("/account_proof/address/{address}", s.GetAccountProofHandler)
+	s.router.HandleFunc("/validator_proof/address/{address}", s.GetValidatorProofHandler)
+	s.router.HandleFunc("/transaction_proof/hash/{hash}", s.GetTransactionProofHandler)
+	s.router.HandleFunc("/send_raw_transaction", s.SendRawTransactionHandler).Methods("POST")
+	s.router.HandleFunc("/dry_run_transaction", s.DryRunTransactionHandler).Methods("POST")
 	http.Handle("/", handlers.RecoveryHandler()(s.router))
 
 	l, err := net.Listen("tcp", s.config.Address)
@@ -118,6 +124,14 @@ func (s *Server) writeJSON(w http.ResponseWriter, val interface{}) int {
 	return n
 }
 
+func (s *Server) writeJSONError(w http.ResponseWriter, val interface{}) int {
+	j, _ := json.MarshalIndent(val, "", "  ")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	n, _ := io.WriteString(w, string(j))
+	return n
+}
+
 func (s *Server) writePlainText(w http.ResponseWriter, val string) int {
 	w.Header().Set("Content-Type", "text/plain")
 	w.WriteHeader(http.StatusOK)
@@ -3,10 +3,16 @@ package http
 import (
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/mux"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
 	"github.com/zarbchain/zarb-go/www/capnp"
 )
 
@@ -54,3 +60,117 @@ func (s *Server) GetTransactionHandler(w http.ResponseWriter, r *http.Request) {
 
 	s.writeJSON(w, out)
 }
+
+// maxRawTxBodySize is the maximum size of the hex encoded transaction in the request body
+const maxRawTxBodySize = 1024 * 1024
+
+// readRawTx reads the hex encoded transaction from the request body
+func readRawTx(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRawTxBodySize))
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(strings.TrimSpace(string(body)))
+}
+
+// SendRawTransactionHandler expects the hex encoded transaction in the request body
+func (s *Server) SendRawTransactionHandler(w http.ResponseWriter, r *http.Request) {
+	rawTx, err := readRawTx(w, r)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	b := s.server.SendRawTransaction(s.ctx, func(p capnp.ZarbServer_sendRawTransaction_Params) error {
+		return p.SetRawTx(rawTx)
+	})
+
+	t, err := b.Struct()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	res, _ := t.Result()
+	if res.Status() != errors.ErrNone {
+		msg, _ := res.Error()
+		s.writeJSONError(w, ErrorResult{Code: int(res.Status()), Message: msg})
+		return
+	}
+
+	idData, _ := res.Id()
+	id, err := crypto.HashFromRawBytes(idData)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	s.writeJSON(w, SendTransactionResult{ID: id})
+}
+
+// DryRunTransactionHandler expects the hex encoded transaction in the request body.
+// The transaction can be unsigned.
+func (s *Server) DryRunTransactionHandler(w http.ResponseWriter, r *http.Request) {
+	rawTx, err := readRawTx(w, r)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	b := s.server.DryRunTransaction(s.ctx, func(p capnp.ZarbServer_dryRunTransaction_Params) error {
+		return p.SetRawTx(rawTx)
+	})
+
+	t, err := b.Struct()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	res, _ := t.Result()
+	if !res.HasReceipt() {
+		msg, _ := res.Error()
+		s.writeJSONError(w, ErrorResult{Code: int(res.Status()), Message: msg})
+		return
+	}
+
+	out := new(DryRunResult)
+	rec, _ := res.Receipt()
+	receiptData, _ := rec.Data()
+	receipt := new(tx.Receipt)
+	if err := receipt.Decode(receiptData); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	out.Receipt.Hash = receipt.Hash()
+	out.Receipt.Data = hex.EncodeToString(receiptData)
+	out.Receipt.Receipt = *receipt
+	out.ExpectedFee = res.ExpectedFee()
+	out.ExpectedSequence = int(res.ExpectedSequence())
+
+	accs, _ := res.Accounts()
+	out.Accounts = make([]account.Account, accs.Len())
+	for i := 0; i < accs.Len(); i++ {
+		d, _ := accs.At(i)
+		if err := out.Accounts[i].Decode(d); err != nil {
+			s.writeError(w, err)
+			return
+		}
+	}
+	vals, _ := res.Validators()
+	out.Validators = make([]validator.Validator, vals.Len())
+	for i := 0; i < vals.Len(); i++ {
+		d, _ := vals.At(i)
+		if err := out.Validators[i].Decode(d); err != nil {
+			s.writeError(w, err)
+			return
+		}
+	}
+
+	if res.Status() != errors.ErrNone {
+		msg, _ := res.Error()
+		out.Error = &ErrorResult{Code: int(res.Status()), Message: msg}
+	}
+
+	s.writeJSON(w, out)
+}
@@ -1,12 +1,15 @@
 package http
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/mux"
+	"github.com/zarbchain/zarb-go/tx"
 	"gotest.tools/assert"
 )
 
@@ -20,6 +23,8 @@ func TestTransaction(t *testing.T) {
 		tHTTPServer.GetTransactionHandler(w, r)
 
 		assert.Equal(t, w.Code, 200)
+		assert.Assert(t, strings.Contains(w.Body.String(), `"Height": 1`))
+		assert.Assert(t, strings.Contains(w.Body.String(), `"Balance": -1`))
 		fmt.Println(w.Body)
 	})
 
@@ -33,3 +38,76 @@ func TestTransaction(t *testing.T) {
 	})
 
 }
+
+func TestSendRawTransaction(t *testing.T) {
+	setup(t)
+
+	t.Run("Shall send a transaction", func(t *testing.T) {
+		trx, _ := tx.GenerateTestSendTx()
+		data, _ := trx.Encode()
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/send_raw_transaction", strings.NewReader(hex.EncodeToString(data)))
+		tHTTPServer.SendRawTransactionHandler(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		assert.Assert(t, strings.Contains(w.Body.String(), trx.ID().String()))
+		assert.Assert(t, tMockPool.HasTx(trx.ID()))
+		fmt.Println(w.Body)
+	})
+
+	t.Run("Shall return an error for invalid transaction", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/send_raw_transaction", strings.NewReader("0102"))
+		tHTTPServer.SendRawTransactionHandler(w, r)
+
+		assert.Equal(t, w.Code, 400)
+		assert.Assert(t, strings.Contains(w.Body.String(), "Code"))
+		fmt.Println(w.Body)
+	})
+
+	t.Run("Shall return an error for invalid hex", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/send_raw_transaction", strings.NewReader("not-hex"))
+		tHTTPServer.SendRawTransactionHandler(w, r)
+
+		assert.Equal(t, w.Code, 400)
+		fmt.Println(w.Body)
+	})
+
+	t.Run("Shall return an error for too large body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/send_raw_transaction", strings.NewReader(strings.Repeat("00", maxRawTxBodySize)))
+		tHTTPServer.SendRawTransactionHandler(w, r)
+
+		assert.Equal(t, w.Code, 400)
+		assert.Assert(t, strings.Contains(w.Body.String(), "too large"))
+	})
+}
+
+func TestDryRunTransaction(t *testing.T) {
+	setup(t)
+
+	t.Run("Shall dry-run an unsigned transaction", func(t *testing.T) {
+		trx, _ := tx.GenerateTestSendTx()
+		trx.SetPublicKey(nil)
+		trx.SetSignature(nil)
+		data, _ := trx.Encode()
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/dry_run_transaction", strings.NewReader(hex.EncodeToString(data)))
+		tHTTPServer.DryRunTransactionHandler(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		assert.Assert(t, strings.Contains(w.Body.String(), trx.ID().String()))
+		assert.Assert(t, !tMockPool.HasTx(trx.ID()))
+		fmt.Println(w.Body)
+	})
+
+	t.Run("Shall return an error for invalid transaction", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/dry_run_transaction", strings.NewReader("0102"))
+		tHTTPServer.DryRunTransactionHandler(w, r)
+
+		assert.Equal(t, w.Code, 400)
+		fmt.Println(w.Body)
+	})
+}
@@ -3,11 +3,35 @@ package http
 import (
 	"time"
 
+	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
+	simpleMerkle "github.com/zarbchain/zarb-go/libs/merkle"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
 )
 
+type SyncStatusResult struct {
+	PeersCount int
+	NodesCount int
+	MaxHeight  int
+	IsSynced   bool
+}
+
+type BlockchainResult struct {
+	LastBlockHeight int
+	LastBlockHash   crypto.Hash
+	LastBlockTime   time.Time
+	GenesisHash     crypto.Hash
+	ChainName       string
+	TotalAccounts   int
+	TotalValidators int
+	Validators      []validator.Validator
+	Proposer        crypto.Address
+	TxPoolSize      int
+	SyncStatus      SyncStatusResult
+}
+
 type BlockResult struct {
 	Hash  crypto.Hash
 	Time  time.Time
@@ -26,3 +50,32 @@ type TransactionResult struct {
 	Tx      tx.Tx
 	Receipt ReceiptResult
 }
+
+type SendTransactionResult struct {
+	ID crypto.Hash
+}
+
+// ProofResult contains the encoded data and its inclusion proof.
+// For accounts and validators, the root is the state hash after the given height,
+// which is committed in the header of the next block.
+// For transactions, the root is the transactions hash of the block at the given height.
+type ProofResult struct {
+	Data   string
+	Proof  simpleMerkle.Proof
+	Root   crypto.Hash
+	Height int
+}
+
+type ErrorResult struct {
+	Code    int
+	Message string
+}
+
+type DryRunResult struct {
+	Receipt          ReceiptResult
+	ExpectedFee      int64
+	ExpectedSequence int
+	Accounts         []account.Account
+	Validators       []validator.Validator
+	Error            *ErrorResult `json:",omitempty"`
+}